@@ -7,6 +7,7 @@ var services = mapOf(
         "approleassignments" to "App Role Assignments",
         "applications" to "Applications",
         "conditionalaccess" to "Conditional Access",
+        "crosstenantaccess" to "Cross-Tenant Access",
         "directoryobjects" to "Directory Objects",
         "directoryroles" to "Directory Roles",
         "domains" to "Domains",
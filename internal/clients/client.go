@@ -20,6 +20,7 @@ import (
 	applications "github.com/hashicorp/terraform-provider-azuread/internal/services/applications/client"
 	approleassignments "github.com/hashicorp/terraform-provider-azuread/internal/services/approleassignments/client"
 	conditionalaccess "github.com/hashicorp/terraform-provider-azuread/internal/services/conditionalaccess/client"
+	crosstenantaccess "github.com/hashicorp/terraform-provider-azuread/internal/services/crosstenantaccess/client"
 	directoryroles "github.com/hashicorp/terraform-provider-azuread/internal/services/directoryroles/client"
 	domains "github.com/hashicorp/terraform-provider-azuread/internal/services/domains/client"
 	groups "github.com/hashicorp/terraform-provider-azuread/internal/services/groups/client"
@@ -48,6 +49,7 @@ type Client struct {
 	Applications        *applications.Client
 	AppRoleAssignments  *approleassignments.Client
 	ConditionalAccess   *conditionalaccess.Client
+	CrossTenantAccess   *crosstenantaccess.Client
 	DirectoryRoles      *directoryroles.Client
 	Domains             *domains.Client
 	Groups              *groups.Client
@@ -68,6 +70,7 @@ func (client *Client) build(ctx context.Context, o *common.ClientOptions) error
 	client.AppRoleAssignments = approleassignments.NewClient(o)
 	client.Domains = domains.NewClient(o)
 	client.ConditionalAccess = conditionalaccess.NewClient(o)
+	client.CrossTenantAccess = crosstenantaccess.NewClient(o)
 	client.DirectoryRoles = directoryroles.NewClient(o)
 	client.Groups = groups.NewClient(o)
 	client.IdentityGovernance = identitygovernance.NewClient(o)
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+const (
+	CredentialTypeCertificate = "certificate"
+	CredentialTypePassword    = "password"
+)
+
+// appManagementPolicyRestrictionTypes lists the restriction types of application management policies, which may be
+// named in API errors when a credential is rejected
+var appManagementPolicyRestrictionTypes = []string{
+	"asymmetricKeyLifetime",
+	"customPasswordAddition",
+	"passwordAddition",
+	"passwordLifetime",
+	"symmetricKeyAddition",
+	"symmetricKeyLifetime",
+	"trustedCertificateAuthority",
+}
+
+// AppManagementPolicyViolation inspects an API error returned when adding a credential, and returns the name of the
+// application management policy restriction which prevented the credential from being added. The name is empty when
+// the error does not identify the restriction. The second return value is false when the error was not caused by an
+// application management policy.
+func AppManagementPolicyViolation(err error) (string, bool) {
+	if err == nil {
+		return "", false
+	}
+
+	message := strings.ToLower(err.Error())
+	if !strings.Contains(message, "asperapppolicy") && !strings.Contains(message, "as per assigned policy") && !strings.Contains(message, "app management policy") {
+		return "", false
+	}
+
+	for _, restrictionType := range appManagementPolicyRestrictionTypes {
+		if strings.Contains(message, strings.ToLower(restrictionType)) {
+			return restrictionType, true
+		}
+	}
+
+	return "", true
+}
+
+// CredentialErrorDiag returns a diagnostic for an error encountered when adding a credential. When the error was
+// caused by an application management policy, the diagnostic names the violated restriction when the error identifies it.
+func CredentialErrorDiag(err error, credentialType string, format string, a ...interface{}) diag.Diagnostics {
+	restrictionType, ok := AppManagementPolicyViolation(err)
+	if !ok {
+		return tf.ErrorDiagF(err, format, a...)
+	}
+
+	restriction := "an app management policy restriction"
+	if restrictionType != "" {
+		restriction = fmt.Sprintf("the %q restriction of an application management policy", restrictionType)
+	}
+
+	var explanation string
+	switch restrictionType {
+	case "":
+		explanation = fmt.Sprintf("The %s was rejected by an application management policy. Refer to the API error below for details of the restriction.", credentialType)
+	case "asymmetricKeyLifetime", "passwordLifetime", "symmetricKeyLifetime":
+		explanation = fmt.Sprintf("The %s would be valid for longer than the maximum lifetime permitted by the `%s` restriction. Specify an earlier `end_date` or a shorter `end_date_relative`.", credentialType, restrictionType)
+	case "trustedCertificateAuthority":
+		explanation = fmt.Sprintf("The %s was not issued by a certificate authority trusted by the `%s` restriction.", credentialType, restrictionType)
+	default:
+		explanation = fmt.Sprintf("The `%s` restriction does not permit %s credentials to be added. Consider using a certificate or a federated identity credential instead.", restrictionType, credentialType)
+	}
+
+	return diag.Diagnostics{diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("%s: blocked by %s", fmt.Sprintf(format, a...), restriction),
+		Detail:   fmt.Sprintf("%s The restriction is enforced by the tenant default policy (`azuread_tenant_app_management_policy`) or by a policy assigned to the application or service principal (`azuread_application_management_policy_assignment`).\n\nAPI error: %v", explanation, err),
+	}}
+}
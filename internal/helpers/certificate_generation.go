@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+const (
+	CertificateKeyAlgorithmECDSA = "ECDSA"
+	CertificateKeyAlgorithmRSA   = "RSA"
+)
+
+// GeneratedCertificate holds a self-signed certificate and its private key, in the encodings exposed by resources
+type GeneratedCertificate struct {
+	CertificatePem string
+	PrivateKeyPem  string
+	PfxBase64      string
+	StartDate      time.Time
+	EndDate        time.Time
+}
+
+// DefaultGeneratedCertificateValidity is the validity period of a generated certificate when neither `end_date` nor
+// `end_date_relative` are specified
+const DefaultGeneratedCertificateValidity = 365 * 24 * time.Hour
+
+// GenerateCertificateForResource generates a self-signed certificate according to the `generate_certificate` block of
+// a certificate resource. The validity period is determined in the same way as for uploaded certificates, using the
+// `start_date`, `end_date` and `end_date_relative` properties.
+func GenerateCertificateForResource(d *pluginsdk.ResourceData) (*GeneratedCertificate, error) {
+	raw := d.Get("generate_certificate").([]interface{})
+	if len(raw) == 0 || raw[0] == nil {
+		return nil, nil
+	}
+	config := raw[0].(map[string]interface{})
+
+	startDate := time.Now()
+	if v, ok := d.GetOk("start_date"); ok {
+		var err error
+		if startDate, err = time.Parse(time.RFC3339, v.(string)); err != nil {
+			return nil, CredentialError{str: fmt.Sprintf("Unable to parse the provided start date %q: %+v", v, err), attr: "start_date"}
+		}
+	}
+
+	endDate := startDate.Add(DefaultGeneratedCertificateValidity)
+	if v, ok := d.GetOk("end_date"); ok && v.(string) != "" {
+		var err error
+		if endDate, err = time.Parse(time.RFC3339, v.(string)); err != nil {
+			return nil, CredentialError{str: fmt.Sprintf("Unable to parse the provided end date %q: %+v", v, err), attr: "end_date"}
+		}
+	} else if v, ok := d.GetOk("end_date_relative"); ok && v.(string) != "" {
+		duration, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return nil, CredentialError{str: fmt.Sprintf("Unable to parse `end_date_relative` (%q) as a duration", v), attr: "end_date_relative"}
+		}
+		endDate = startDate.Add(duration)
+	}
+
+	return GenerateSelfSignedCertificate(config["subject"].(string), config["key_algorithm"].(string), config["rsa_bits"].(int), config["ecdsa_curve"].(string), startDate, endDate, config["pfx_password"].(string))
+}
+
+// GenerateSelfSignedCertificate creates a key pair using the specified algorithm, and a self-signed X.509 certificate
+// for the specified subject, which is valid between the specified dates. The certificate and private key are also
+// bundled as a PKCS#12 archive protected with the specified password.
+func GenerateSelfSignedCertificate(subject, keyAlgorithm string, rsaBits int, ecdsaCurve string, startDate, endDate time.Time, pfxPassword string) (*GeneratedCertificate, error) {
+	name, err := ParseCertificateSubject(subject)
+	if err != nil {
+		return nil, CredentialError{str: err.Error(), attr: "generate_certificate.0.subject"}
+	}
+
+	if !endDate.After(startDate) {
+		return nil, CredentialError{str: fmt.Sprintf("the end date (%s) must be after the start date (%s)", endDate.Format(time.RFC3339), startDate.Format(time.RFC3339)), attr: "end_date"}
+	}
+
+	var privateKey crypto.Signer
+	keyUsage := x509.KeyUsageDigitalSignature
+
+	switch keyAlgorithm {
+	case CertificateKeyAlgorithmECDSA:
+		var curve elliptic.Curve
+		switch ecdsaCurve {
+		case "P256":
+			curve = elliptic.P256()
+		case "P384":
+			curve = elliptic.P384()
+		case "P521":
+			curve = elliptic.P521()
+		default:
+			return nil, CredentialError{str: fmt.Sprintf("unsupported ECDSA curve %q", ecdsaCurve), attr: "generate_certificate.0.ecdsa_curve"}
+		}
+		if privateKey, err = ecdsa.GenerateKey(curve, rand.Reader); err != nil {
+			return nil, fmt.Errorf("generating ECDSA private key: %+v", err)
+		}
+
+	case CertificateKeyAlgorithmRSA:
+		if privateKey, err = rsa.GenerateKey(rand.Reader, rsaBits); err != nil {
+			return nil, fmt.Errorf("generating RSA private key: %+v", err)
+		}
+		keyUsage |= x509.KeyUsageKeyEncipherment
+
+	default:
+		return nil, CredentialError{str: fmt.Sprintf("unsupported key algorithm %q", keyAlgorithm), attr: "generate_certificate.0.key_algorithm"}
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("generating certificate serial number: %+v", err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               *name,
+		NotBefore:             startDate.UTC(),
+		NotAfter:              endDate.UTC(),
+		KeyUsage:              keyUsage,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, privateKey.Public(), privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("creating self-signed certificate: %+v", err)
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("parsing generated certificate: %+v", err)
+	}
+
+	keyDer, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("encoding private key: %+v", err)
+	}
+
+	pfx, err := pkcs12.Modern.Encode(privateKey, certificate, nil, pfxPassword)
+	if err != nil {
+		return nil, fmt.Errorf("encoding PKCS#12 archive: %+v", err)
+	}
+
+	return &GeneratedCertificate{
+		CertificatePem: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		PrivateKeyPem:  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer})),
+		PfxBase64:      base64.StdEncoding.EncodeToString(pfx),
+		StartDate:      certificate.NotBefore,
+		EndDate:        certificate.NotAfter,
+	}, nil
+}
+
+// ParseCertificateSubject parses a distinguished name such as `CN=example,O=Example Corp,C=GB` into a pkix.Name,
+// supporting the CN, O, OU, L, ST, C and SERIALNUMBER attributes
+func ParseCertificateSubject(subject string) (*pkix.Name, error) {
+	name := pkix.Name{}
+
+	for _, rdn := range strings.Split(subject, ",") {
+		rdn = strings.TrimSpace(rdn)
+		if rdn == "" {
+			continue
+		}
+
+		parts := strings.SplitN(rdn, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[1]) == "" {
+			return nil, fmt.Errorf("invalid attribute %q in certificate subject %q, expected the form `TYPE=value`", rdn, subject)
+		}
+		value := strings.TrimSpace(parts[1])
+
+		switch strings.ToUpper(strings.TrimSpace(parts[0])) {
+		case "CN":
+			name.CommonName = value
+		case "O":
+			name.Organization = append(name.Organization, value)
+		case "OU":
+			name.OrganizationalUnit = append(name.OrganizationalUnit, value)
+		case "L":
+			name.Locality = append(name.Locality, value)
+		case "ST", "S":
+			name.Province = append(name.Province, value)
+		case "C":
+			name.Country = append(name.Country, value)
+		case "SERIALNUMBER":
+			name.SerialNumber = value
+		default:
+			return nil, fmt.Errorf("unsupported attribute type %q in certificate subject %q", parts[0], subject)
+		}
+	}
+
+	if name.CommonName == "" {
+		return nil, fmt.Errorf("certificate subject %q must include a common name (CN)", subject)
+	}
+
+	return &name, nil
+}
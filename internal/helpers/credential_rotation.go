@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// DefaultPasswordRotationOverlap is the duration for which the previous password credential is retained following
+// rotation, when `rotation_overlap` is not specified
+const DefaultPasswordRotationOverlap = "24h"
+
+// PasswordRotationDue determines whether a password credential with the specified start and end dates should be
+// rotated, according to the `rotation_days` and `rotate_before_expiry` settings of a password resource
+func PasswordRotationDue(now time.Time, startDate, endDate string, rotationDays int, rotateBeforeExpiry string) (bool, error) {
+	if rotationDays > 0 && startDate != "" {
+		start, err := time.Parse(time.RFC3339, startDate)
+		if err != nil {
+			return false, CredentialError{str: fmt.Sprintf("Unable to parse the start date %q: %+v", startDate, err), attr: "start_date"}
+		}
+		if !now.Before(start.AddDate(0, 0, rotationDays)) {
+			return true, nil
+		}
+	}
+
+	if rotateBeforeExpiry != "" && endDate != "" {
+		d, err := time.ParseDuration(rotateBeforeExpiry)
+		if err != nil {
+			return false, CredentialError{str: fmt.Sprintf("Unable to parse `rotate_before_expiry` (%q) as a duration", rotateBeforeExpiry), attr: "rotate_before_expiry"}
+		}
+		end, err := time.Parse(time.RFC3339, endDate)
+		if err != nil {
+			return false, CredentialError{str: fmt.Sprintf("Unable to parse the end date %q: %+v", endDate, err), attr: "end_date"}
+		}
+		if !now.Before(end.Add(-d)) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// PasswordRotationOverlapElapsed determines whether the previous password credential should be removed, which is the
+// case once the overlap period following the start of the current credential has passed
+func PasswordRotationOverlapElapsed(now time.Time, startDate, overlap string) (bool, error) {
+	if startDate == "" {
+		return false, nil
+	}
+
+	start, err := time.Parse(time.RFC3339, startDate)
+	if err != nil {
+		return false, CredentialError{str: fmt.Sprintf("Unable to parse the start date %q: %+v", startDate, err), attr: "start_date"}
+	}
+
+	if overlap == "" {
+		overlap = DefaultPasswordRotationOverlap
+	}
+
+	d, err := time.ParseDuration(overlap)
+	if err != nil {
+		return false, CredentialError{str: fmt.Sprintf("Unable to parse `rotation_overlap` (%q) as a duration", overlap), attr: "rotation_overlap"}
+	}
+
+	return !now.Before(start.Add(d)), nil
+}
+
+// PasswordRotationCustomizeDiff plans the rotation of a password credential when it falls within the configured
+// rotation window, and plans the removal of the previous password credential once the overlap period has passed
+func PasswordRotationCustomizeDiff(_ context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	now := time.Now()
+	startDate := diff.Get("start_date").(string)
+
+	rotate, err := PasswordRotationDue(now, startDate, diff.Get("end_date").(string), diff.Get("rotation_days").(int), diff.Get("rotate_before_expiry").(string))
+	if err != nil {
+		return err
+	}
+
+	if rotate {
+		for _, key := range []string{"key_id", "value", "previous_key_id", "previous_value"} {
+			if err = diff.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if diff.Get("previous_key_id").(string) != "" {
+		elapsed, err := PasswordRotationOverlapElapsed(now, startDate, diff.Get("rotation_overlap").(string))
+		if err != nil {
+			return err
+		}
+
+		if elapsed {
+			if err = diff.SetNew("previous_key_id", ""); err != nil {
+				return err
+			}
+			if err = diff.SetNew("previous_value", ""); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// PasswordCredentialForRotation builds a replacement password credential for a password resource, which starts
+// immediately and honours `end_date_relative` when specified
+func PasswordCredentialForRotation(d *pluginsdk.ResourceData) (*msgraph.PasswordCredential, error) {
+	data := make(map[string]interface{})
+
+	if v, ok := d.GetOk("display_name"); ok {
+		data["display_name"] = v
+	}
+
+	if v, ok := d.GetOk("end_date_relative"); ok && v.(string) != "" {
+		data["end_date_relative"] = v
+	}
+
+	return PasswordCredential(data)
+}
@@ -208,6 +208,17 @@ func PasswordCredential(in map[string]interface{}) (*msgraph.PasswordCredential,
 		}
 
 		credential.EndDateTime = &expiry
+	} else if v, ok := in["end_date_relative"]; ok && v.(string) != "" {
+		d, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return nil, CredentialError{str: fmt.Sprintf("Unable to parse `end_date_relative` (%q) as a duration", v), attr: "end_date_relative"}
+		}
+
+		if credential.StartDateTime == nil {
+			credential.EndDateTime = pointer.To(time.Now().Add(d))
+		} else {
+			credential.EndDateTime = pointer.To(credential.StartDateTime.Add(d))
+		}
 	}
 
 	if v, ok := in["key_id"]; ok && v.(string) != "" {
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// apiClientIdForName returns the client ID of a first-party API, given its name as listed by the
+// `azuread_application_published_app_ids` data source, e.g. `MicrosoftGraph`
+func apiClientIdForName(name string) (string, error) {
+	if clientId, ok := environments.PublishedApis[name]; ok {
+		return clientId, nil
+	}
+
+	for k, clientId := range environments.PublishedApis {
+		if strings.EqualFold(k, name) {
+			return clientId, nil
+		}
+	}
+
+	return "", fmt.Errorf("%q is not a known published API name, refer to the `azuread_application_published_app_ids` data source for supported names", name)
+}
+
+// apiNameForClientId returns the name of a first-party API given its client ID, or an empty string when the API is not
+// a known published API
+func apiNameForClientId(clientId string) string {
+	names := make([]string, 0)
+	for name, v := range environments.PublishedApis {
+		if strings.EqualFold(v, clientId) {
+			names = append(names, name)
+		}
+	}
+
+	if len(names) == 0 {
+		return ""
+	}
+
+	// Some APIs are published under more than one name, so pick one consistently
+	sort.Strings(names)
+	return names[0]
+}
+
+// apiPermissionResolver translates between the values and IDs of app roles and delegated permission scopes published by
+// APIs, caching the service principal for each API so that it is only retrieved once
+type apiPermissionResolver struct {
+	client            *msgraph.ServicePrincipalsClient
+	servicePrincipals map[string]*msgraph.ServicePrincipal
+}
+
+func newApiPermissionResolver(client *msgraph.ServicePrincipalsClient) *apiPermissionResolver {
+	return &apiPermissionResolver{
+		client:            client,
+		servicePrincipals: make(map[string]*msgraph.ServicePrincipal),
+	}
+}
+
+// servicePrincipal returns the service principal for the API with the specified client ID, returning an error when
+// the API has no service principal in the tenant
+func (r *apiPermissionResolver) servicePrincipal(ctx context.Context, apiClientId string) (*msgraph.ServicePrincipal, error) {
+	servicePrincipal, err := r.findServicePrincipal(ctx, apiClientId)
+	if err != nil {
+		return nil, err
+	}
+	if servicePrincipal == nil {
+		return nil, fmt.Errorf("no service principal was found for the API with client ID %q, permissions can only be referenced by value for APIs that have a service principal in the tenant", apiClientId)
+	}
+
+	return servicePrincipal, nil
+}
+
+// findServicePrincipal returns the service principal with the specified client ID, or nil when there is no service
+// principal for it in the tenant
+func (r *apiPermissionResolver) findServicePrincipal(ctx context.Context, clientId string) (*msgraph.ServicePrincipal, error) {
+	key := strings.ToLower(clientId)
+	if servicePrincipal, ok := r.servicePrincipals[key]; ok {
+		return servicePrincipal, nil
+	}
+
+	result, _, err := r.client.List(ctx, odata.Query{Filter: fmt.Sprintf("appId eq '%s'", odata.EscapeSingleQuote(clientId))})
+	if err != nil {
+		return nil, fmt.Errorf("listing service principals for client ID %q: %+v", clientId, err)
+	}
+
+	var servicePrincipal *msgraph.ServicePrincipal
+	if result != nil {
+		for i := range *result {
+			if (*result)[i].Id != nil && strings.EqualFold(pointer.From((*result)[i].AppId), clientId) {
+				servicePrincipal = &(*result)[i]
+				break
+			}
+		}
+	}
+
+	r.servicePrincipals[key] = servicePrincipal
+	return servicePrincipal, nil
+}
+
+// id returns the ID of the app role (when `permissionType` is `Role`) or delegated permission scope (when
+// `permissionType` is `Scope`) with the specified value, as published by the API with the specified client ID
+func (r *apiPermissionResolver) id(ctx context.Context, apiClientId, permissionType, value string) (string, error) {
+	servicePrincipal, err := r.servicePrincipal(ctx, apiClientId)
+	if err != nil {
+		return "", err
+	}
+
+	for id, v := range apiPermissionValues(servicePrincipal, permissionType) {
+		if v == value {
+			return id, nil
+		}
+	}
+
+	switch permissionType {
+	case msgraph.ResourceAccessTypeRole:
+		return "", fmt.Errorf("the API with client ID %q does not publish an app role with the value %q", apiClientId, value)
+	default:
+		return "", fmt.Errorf("the API with client ID %q does not publish a delegated permission scope with the value %q", apiClientId, value)
+	}
+}
+
+// value returns the value of the app role or delegated permission scope with the specified ID, as published by the API
+// with the specified client ID, or an empty string when no such permission is published
+func (r *apiPermissionResolver) value(ctx context.Context, apiClientId, permissionType, id string) (string, error) {
+	servicePrincipal, err := r.servicePrincipal(ctx, apiClientId)
+	if err != nil {
+		return "", err
+	}
+
+	return apiPermissionValues(servicePrincipal, permissionType)[strings.ToLower(id)], nil
+}
+
+// apiPermissionValues returns a map of lowercased IDs to values for the app roles or delegated permission scopes
+// published by the specified service principal
+func apiPermissionValues(servicePrincipal *msgraph.ServicePrincipal, permissionType string) map[string]string {
+	result := make(map[string]string)
+
+	switch permissionType {
+	case msgraph.ResourceAccessTypeRole:
+		if servicePrincipal.AppRoles != nil {
+			for _, role := range *servicePrincipal.AppRoles {
+				if role.ID != nil && role.Value != nil {
+					result[strings.ToLower(*role.ID)] = *role.Value
+				}
+			}
+		}
+
+	case msgraph.ResourceAccessTypeScope:
+		if servicePrincipal.OAuth2PermissionScopes != nil {
+			for _, scope := range *servicePrincipal.OAuth2PermissionScopes {
+				if scope.ID != nil && scope.Value != nil {
+					result[strings.ToLower(*scope.ID)] = *scope.Value
+				}
+			}
+		}
+	}
+
+	return result
+}
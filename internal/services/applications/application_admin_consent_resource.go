@@ -0,0 +1,816 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+type ApplicationAdminConsentModel struct {
+	ApplicationId          string                                       `tfschema:"application_id"`
+	Triggers               map[string]string                            `tfschema:"triggers"`
+	ServicePrincipalId     string                                       `tfschema:"service_principal_id"`
+	RequiredResourceAccess []ApplicationAdminConsentResourceAccessModel `tfschema:"required_resource_access"`
+	GrantedResourceAccess  []ApplicationAdminConsentResourceAccessModel `tfschema:"granted_resource_access"`
+	ManagedResourceAccess  []ApplicationAdminConsentResourceAccessModel `tfschema:"managed_resource_access"`
+}
+
+type ApplicationAdminConsentResourceAccessModel struct {
+	ResourceAppId string   `tfschema:"resource_app_id"`
+	RoleIds       []string `tfschema:"role_ids"`
+	ScopeIds      []string `tfschema:"scope_ids"`
+}
+
+var _ sdk.ResourceWithUpdate = ApplicationAdminConsentResource{}
+var _ sdk.ResourceWithCustomizeDiff = ApplicationAdminConsentResource{}
+
+type ApplicationAdminConsentResource struct{}
+
+func (r ApplicationAdminConsentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.ValidateAdminConsentID
+}
+
+func (r ApplicationAdminConsentResource) ResourceType() string {
+	return "azuread_application_admin_consent"
+}
+
+func (r ApplicationAdminConsentResource) ModelObject() interface{} {
+	return &ApplicationAdminConsentModel{}
+}
+
+func (r ApplicationAdminConsentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"application_id": {
+			Description:  "The resource ID of the application for which admin consent should be granted",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: parse.ValidateApplicationID,
+		},
+
+		"triggers": {
+			Description: "A map of arbitrary values that, when changed, will cause admin consent to be re-converged with the application's required API permissions",
+			Type:        pluginsdk.TypeMap,
+			Optional:    true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ApplicationAdminConsentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"service_principal_id": {
+			Description: "The object ID of the service principal for the application, to which consent is granted",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+
+		"required_resource_access": {
+			Description: "The API permissions required by the application",
+			Type:        pluginsdk.TypeList,
+			Computed:    true,
+			Elem:        applicationAdminConsentResourceAccessSchema(),
+		},
+
+		"granted_resource_access": {
+			Description: "The API permissions for which admin consent has been granted",
+			Type:        pluginsdk.TypeList,
+			Computed:    true,
+			Elem:        applicationAdminConsentResourceAccessSchema(),
+		},
+
+		"managed_resource_access": {
+			Description: "The API permissions for which admin consent was granted by this resource, and which will be revoked when no longer required",
+			Type:        pluginsdk.TypeList,
+			Computed:    true,
+			Elem:        applicationAdminConsentResourceAccessSchema(),
+		},
+	}
+}
+
+func applicationAdminConsentResourceAccessSchema() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_app_id": {
+				Description: "The client ID of the API",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"role_ids": {
+				Description: "A set of app role IDs published by the API",
+				Type:        pluginsdk.TypeSet,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"scope_ids": {
+				Description: "A set of delegated permission scope IDs published by the API",
+				Type:        pluginsdk.TypeSet,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func (r ApplicationAdminConsentResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if metadata.ResourceDiff.Id() == "" {
+				return nil
+			}
+
+			var state ApplicationAdminConsentModel
+			if err := metadata.DecodeDiff(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// When a required permission has not been granted, when consent granted by this resource is no longer
+			// required, or when the triggers have changed, plan an update so that consent can be re-converged
+			if metadata.ResourceDiff.HasChange("triggers") ||
+				!applicationAdminConsentAccessContains(state.GrantedResourceAccess, state.RequiredResourceAccess) ||
+				!applicationAdminConsentAccessContains(state.RequiredResourceAccess, state.ManagedResourceAccess) {
+				for _, key := range []string{"required_resource_access", "granted_resource_access", "managed_resource_access"} {
+					if err := metadata.ResourceDiff.SetNewComputed(key); err != nil {
+						return fmt.Errorf("setting %q as computed: %+v", key, err)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationAdminConsentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ApplicationAdminConsentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			applicationId, err := parse.ParseApplicationID(model.ApplicationId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewAdminConsentID(applicationId.ApplicationId)
+
+			resolver := newApiPermissionResolver(metadata.Client.Applications.ServicePrincipalsClient)
+
+			servicePrincipal, required, err := r.requiredAccessForApplication(ctx, metadata, resolver, *applicationId)
+			if err != nil {
+				return err
+			}
+
+			managed, err := r.converge(ctx, metadata, resolver, *servicePrincipal.Id, required, nil)
+			if err != nil {
+				// Persist any consent granted before the failure, so that it can be revoked later
+				if len(managed) > 0 {
+					metadata.SetID(id)
+					model.ManagedResourceAccess = managed
+					if encodeErr := metadata.Encode(&model); encodeErr != nil {
+						return fmt.Errorf("encoding partially granted %s: %+v", id, encodeErr)
+					}
+				}
+				return fmt.Errorf("granting %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			model.ManagedResourceAccess = managed
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ApplicationAdminConsentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			applicationsClient := metadata.Client.Applications.ApplicationsClient
+
+			id, err := parse.ParseAdminConsentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationAdminConsentModel
+			if err = metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			applicationId := parse.NewApplicationID(id.ApplicationId)
+
+			application, status, err := applicationsClient.Get(ctx, id.ApplicationId, odata.Query{})
+			if err != nil {
+				if status == http.StatusNotFound {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", applicationId, err)
+			}
+			if application == nil || application.AppId == nil {
+				return fmt.Errorf("retrieving %s: result or appId was nil", applicationId)
+			}
+
+			resolver := newApiPermissionResolver(metadata.Client.Applications.ServicePrincipalsClient)
+
+			servicePrincipal, err := resolver.findServicePrincipal(ctx, *application.AppId)
+			if err != nil {
+				return err
+			}
+			if servicePrincipal == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			required := applicationAdminConsentRequiredAccess(application)
+
+			// Report on APIs for which this resource granted consent as well as required APIs, so that any remaining
+			// consent for APIs no longer required by the application is detected
+			granted, err := r.grantedAccess(ctx, metadata, resolver, *servicePrincipal.Id, applicationAdminConsentResourceAppIds(required, model.ManagedResourceAccess))
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := ApplicationAdminConsentModel{
+				ApplicationId:          applicationId.ID(),
+				Triggers:               model.Triggers,
+				ServicePrincipalId:     pointer.From(servicePrincipal.Id),
+				RequiredResourceAccess: required,
+				GrantedResourceAccess:  granted,
+				ManagedResourceAccess:  model.ManagedResourceAccess,
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationAdminConsentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseAdminConsentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resolver := newApiPermissionResolver(metadata.Client.Applications.ServicePrincipalsClient)
+
+			servicePrincipal, required, err := r.requiredAccessForApplication(ctx, metadata, resolver, *parse.NewApplicationID(id.ApplicationId))
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationAdminConsentModel
+			if err = metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// The planned value of `managed_resource_access` is unknown, so refer to prior state to determine which
+			// permissions were previously granted by this resource, in order to revoke any that are no longer required
+			previousManaged, _ := metadata.ResourceData.GetChange("managed_resource_access")
+
+			managed, err := r.converge(ctx, metadata, resolver, *servicePrincipal.Id, required, applicationAdminConsentExpandAccess(previousManaged.([]interface{})))
+			if err != nil {
+				// Persist the consent managed at the point of failure, so that it can be reconciled later
+				model.ManagedResourceAccess = managed
+				if encodeErr := metadata.Encode(&model); encodeErr != nil {
+					return fmt.Errorf("encoding partially updated %s: %+v", id, encodeErr)
+				}
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			model.ManagedResourceAccess = managed
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ApplicationAdminConsentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseAdminConsentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationAdminConsentModel
+			if err = metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if model.ServicePrincipalId == "" {
+				return nil
+			}
+
+			// Revoke only the consent that was granted by this resource
+			if _, err = r.converge(ctx, metadata, newApiPermissionResolver(metadata.Client.Applications.ServicePrincipalsClient), model.ServicePrincipalId, nil, model.ManagedResourceAccess); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// requiredAccessForApplication retrieves the specified application, along with its service principal and the API
+// permissions it requires
+func (r ApplicationAdminConsentResource) requiredAccessForApplication(ctx context.Context, metadata sdk.ResourceMetaData, resolver *apiPermissionResolver, applicationId parse.ApplicationId) (*msgraph.ServicePrincipal, []ApplicationAdminConsentResourceAccessModel, error) {
+	client := metadata.Client.Applications.ApplicationsClient
+
+	application, _, err := client.Get(ctx, applicationId.ApplicationId, odata.Query{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("retrieving %s: %+v", applicationId, err)
+	}
+	if application == nil || application.AppId == nil {
+		return nil, nil, fmt.Errorf("retrieving %s: result or appId was nil", applicationId)
+	}
+
+	servicePrincipal, err := resolver.findServicePrincipal(ctx, *application.AppId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if servicePrincipal == nil {
+		return nil, nil, fmt.Errorf("no service principal was found for %s with client ID %q, a service principal must exist before admin consent can be granted", applicationId, *application.AppId)
+	}
+
+	return servicePrincipal, applicationAdminConsentRequiredAccess(application), nil
+}
+
+// converge grants consent for any `desired` API permissions which have not already been granted to the specified client
+// service principal, and revokes consent for any `previousManaged` permissions which are no longer desired. Consent
+// which was not granted by this resource is left untouched. Returns the permissions for which consent is now managed by
+// this resource.
+func (r ApplicationAdminConsentResource) converge(ctx context.Context, metadata sdk.ResourceMetaData, resolver *apiPermissionResolver, clientServicePrincipalId string, desired, previousManaged []ApplicationAdminConsentResourceAccessModel) ([]ApplicationAdminConsentResourceAccessModel, error) {
+	servicePrincipalsClient := metadata.Client.Applications.ServicePrincipalsClient
+	grantsClient := metadata.Client.Applications.DelegatedPermissionGrantsClient
+
+	assignments, grants, err := r.listConsent(ctx, metadata, clientServicePrincipalId)
+	if err != nil {
+		return previousManaged, err
+	}
+
+	desiredByAppId := make(map[string]ApplicationAdminConsentResourceAccessModel)
+	for _, access := range desired {
+		desiredByAppId[strings.ToLower(access.ResourceAppId)] = access
+	}
+
+	previousByAppId := make(map[string]ApplicationAdminConsentResourceAccessModel)
+	for _, access := range previousManaged {
+		previousByAppId[strings.ToLower(access.ResourceAppId)] = access
+	}
+
+	managed := make([]ApplicationAdminConsentResourceAccessModel, 0)
+
+	resourceAppIds := applicationAdminConsentResourceAppIds(desired, previousManaged)
+	for i, resourceAppId := range resourceAppIds {
+		access, isDesired := desiredByAppId[strings.ToLower(resourceAppId)]
+		previous := previousByAppId[strings.ToLower(resourceAppId)]
+
+		// Track the permissions managed for this API as they are changed, so that when a failure occurs part way
+		// through, the permissions granted so far can be returned along with the error and persisted in state
+		inProgress := ApplicationAdminConsentResourceAccessModel{
+			ResourceAppId: resourceAppId,
+			RoleIds:       append(make([]string, 0), previous.RoleIds...),
+			ScopeIds:      append(make([]string, 0), previous.ScopeIds...),
+		}
+		failed := func(err error) ([]ApplicationAdminConsentResourceAccessModel, error) {
+			result := append(managed, inProgress)
+			for _, remainingAppId := range resourceAppIds[i+1:] {
+				if remaining, ok := previousByAppId[strings.ToLower(remainingAppId)]; ok {
+					result = append(result, remaining)
+				}
+			}
+			return applicationAdminConsentNonEmptyAccess(result), err
+		}
+
+		resourceServicePrincipal, err := resolver.findServicePrincipal(ctx, resourceAppId)
+		if err != nil {
+			return failed(err)
+		}
+		if resourceServicePrincipal == nil {
+			if isDesired {
+				return failed(fmt.Errorf("no service principal was found for the API with client ID %q", resourceAppId))
+			}
+
+			// Consent cannot exist for an API without a service principal
+			continue
+		}
+		resourceId := pointer.From(resourceServicePrincipal.Id)
+
+		managedAccess := ApplicationAdminConsentResourceAccessModel{
+			ResourceAppId: resourceAppId,
+			RoleIds:       make([]string, 0),
+			ScopeIds:      make([]string, 0),
+		}
+
+		// Revoke app role assignments previously granted by this resource which are no longer desired
+		assignedRoleIds := make([]string, 0)
+		for _, assignment := range assignments {
+			if !strings.EqualFold(pointer.From(assignment.ResourceId), resourceId) {
+				continue
+			}
+
+			roleId := strings.ToLower(pointer.From(assignment.AppRoleId))
+			if !applicationAdminConsentContains(access.RoleIds, roleId) && applicationAdminConsentContains(previous.RoleIds, roleId) {
+				if _, err = servicePrincipalsClient.RemoveAppRoleAssignment(ctx, resourceId, pointer.From(assignment.Id)); err != nil {
+					return failed(fmt.Errorf("removing app role assignment %q for app role %q of API %q: %+v", pointer.From(assignment.Id), roleId, resourceAppId, err))
+				}
+				inProgress.RoleIds = applicationAdminConsentRemove(inProgress.RoleIds, roleId)
+				continue
+			}
+
+			assignedRoleIds = append(assignedRoleIds, roleId)
+		}
+
+		// Assign any desired app roles which are not already assigned
+		for _, roleId := range access.RoleIds {
+			if applicationAdminConsentContains(assignedRoleIds, roleId) {
+				if applicationAdminConsentContains(previous.RoleIds, roleId) {
+					managedAccess.RoleIds = append(managedAccess.RoleIds, roleId)
+				}
+				continue
+			}
+
+			if _, _, err = servicePrincipalsClient.AssignAppRoleForResource(ctx, clientServicePrincipalId, resourceId, roleId); err != nil {
+				return failed(fmt.Errorf("assigning app role %q of API %q: %+v", roleId, resourceAppId, err))
+			}
+			if !applicationAdminConsentContains(inProgress.RoleIds, roleId) {
+				inProgress.RoleIds = append(inProgress.RoleIds, roleId)
+			}
+			managedAccess.RoleIds = append(managedAccess.RoleIds, roleId)
+		}
+
+		// Update the tenant-wide delegated permission grant, adding desired scopes and removing scopes previously
+		// granted by this resource which are no longer desired
+		scopeValues := applicationAdminConsentScopeValues(resourceServicePrincipal)
+
+		var grant *msgraph.DelegatedPermissionGrant
+		for i := range grants {
+			if strings.EqualFold(pointer.From(grants[i].ResourceId), resourceId) {
+				grant = &grants[i]
+				break
+			}
+		}
+
+		existingScopes := make([]string, 0)
+		if grant != nil {
+			existingScopes = pointer.From(grant.Scopes)
+		}
+
+		scopes := make([]string, 0)
+		for _, scope := range existingScopes {
+			revoke := false
+			for _, scopeId := range previous.ScopeIds {
+				if strings.EqualFold(scopeValues[strings.ToLower(scopeId)], scope) && !applicationAdminConsentContains(access.ScopeIds, scopeId) {
+					revoke = true
+					break
+				}
+			}
+			if !revoke {
+				scopes = append(scopes, scope)
+			}
+		}
+
+		for _, scopeId := range access.ScopeIds {
+			value, ok := scopeValues[strings.ToLower(scopeId)]
+			if !ok {
+				return failed(fmt.Errorf("the API with client ID %q does not publish a delegated permission scope with ID %q", resourceAppId, scopeId))
+			}
+			if applicationAdminConsentContains(scopes, value) {
+				if applicationAdminConsentContains(previous.ScopeIds, scopeId) {
+					managedAccess.ScopeIds = append(managedAccess.ScopeIds, scopeId)
+				}
+				continue
+			}
+			scopes = append(scopes, value)
+			managedAccess.ScopeIds = append(managedAccess.ScopeIds, scopeId)
+		}
+		sort.Strings(scopes)
+
+		switch {
+		case grant == nil && len(scopes) > 0:
+			properties := msgraph.DelegatedPermissionGrant{
+				ClientId:    pointer.To(clientServicePrincipalId),
+				ConsentType: pointer.To(msgraph.DelegatedPermissionGrantConsentTypeAllPrincipals),
+				ResourceId:  pointer.To(resourceId),
+				Scopes:      &scopes,
+			}
+			if _, _, err = grantsClient.Create(ctx, properties); err != nil {
+				return failed(fmt.Errorf("creating delegated permission grant for API %q: %+v", resourceAppId, err))
+			}
+
+		case grant != nil && len(scopes) == 0:
+			if _, err = grantsClient.Delete(ctx, pointer.From(grant.Id)); err != nil {
+				return failed(fmt.Errorf("deleting delegated permission grant %q for API %q: %+v", pointer.From(grant.Id), resourceAppId, err))
+			}
+
+		case grant != nil && !applicationAdminConsentStringsEqual(existingScopes, scopes):
+			properties := msgraph.DelegatedPermissionGrant{
+				Id:     grant.Id,
+				Scopes: &scopes,
+			}
+			if _, err = grantsClient.Update(ctx, properties); err != nil {
+				return failed(fmt.Errorf("updating delegated permission grant %q for API %q: %+v", pointer.From(grant.Id), resourceAppId, err))
+			}
+		}
+
+		managed = append(managed, managedAccess)
+	}
+
+	return applicationAdminConsentNonEmptyAccess(managed), nil
+}
+
+// grantedAccess returns the API permissions for which consent has been granted to the specified client service
+// principal, for each of the specified APIs
+func (r ApplicationAdminConsentResource) grantedAccess(ctx context.Context, metadata sdk.ResourceMetaData, resolver *apiPermissionResolver, clientServicePrincipalId string, resourceAppIds []string) ([]ApplicationAdminConsentResourceAccessModel, error) {
+	assignments, grants, err := r.listConsent(ctx, metadata, clientServicePrincipalId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]ApplicationAdminConsentResourceAccessModel, 0)
+
+	for _, resourceAppId := range resourceAppIds {
+		resourceServicePrincipal, err := resolver.findServicePrincipal(ctx, resourceAppId)
+		if err != nil {
+			return nil, err
+		}
+		if resourceServicePrincipal == nil {
+			continue
+		}
+		resourceId := pointer.From(resourceServicePrincipal.Id)
+
+		access := ApplicationAdminConsentResourceAccessModel{
+			ResourceAppId: resourceAppId,
+			RoleIds:       make([]string, 0),
+			ScopeIds:      make([]string, 0),
+		}
+
+		for _, assignment := range assignments {
+			if strings.EqualFold(pointer.From(assignment.ResourceId), resourceId) && assignment.AppRoleId != nil {
+				access.RoleIds = append(access.RoleIds, strings.ToLower(*assignment.AppRoleId))
+			}
+		}
+
+		scopeIds := make(map[string]string)
+		for id, value := range applicationAdminConsentScopeValues(resourceServicePrincipal) {
+			scopeIds[strings.ToLower(value)] = id
+		}
+
+		for _, grant := range grants {
+			if !strings.EqualFold(pointer.From(grant.ResourceId), resourceId) || grant.Scopes == nil {
+				continue
+			}
+			for _, scope := range *grant.Scopes {
+				if id, ok := scopeIds[strings.ToLower(scope)]; ok {
+					access.ScopeIds = append(access.ScopeIds, id)
+				}
+			}
+		}
+
+		if len(access.RoleIds) > 0 || len(access.ScopeIds) > 0 {
+			result = append(result, access)
+		}
+	}
+
+	return result, nil
+}
+
+// listConsent retrieves all app role assignments and tenant-wide delegated permission grants for the specified client
+// service principal
+func (r ApplicationAdminConsentResource) listConsent(ctx context.Context, metadata sdk.ResourceMetaData, clientServicePrincipalId string) ([]msgraph.AppRoleAssignment, []msgraph.DelegatedPermissionGrant, error) {
+	assignmentsClient := metadata.Client.Applications.ServicePrincipalsAppRoleAssignmentsClient
+	grantsClient := metadata.Client.Applications.DelegatedPermissionGrantsClient
+
+	assignments, _, err := assignmentsClient.List(ctx, clientServicePrincipalId, odata.Query{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing app role assignments for service principal with object ID %q: %+v", clientServicePrincipalId, err)
+	}
+
+	allGrants, _, err := grantsClient.List(ctx, odata.Query{Filter: fmt.Sprintf("clientId eq '%s'", odata.EscapeSingleQuote(clientServicePrincipalId))})
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing delegated permission grants for service principal with object ID %q: %+v", clientServicePrincipalId, err)
+	}
+
+	grants := make([]msgraph.DelegatedPermissionGrant, 0)
+	if allGrants != nil {
+		for _, grant := range *allGrants {
+			if strings.EqualFold(pointer.From(grant.ConsentType), msgraph.DelegatedPermissionGrantConsentTypeAllPrincipals) {
+				grants = append(grants, grant)
+			}
+		}
+	}
+
+	return pointer.From(assignments), grants, nil
+}
+
+// applicationAdminConsentRequiredAccess flattens the required resource access of an application, merging any
+// duplicate entries for the same API and omitting entries without any permissions
+func applicationAdminConsentRequiredAccess(application *msgraph.Application) []ApplicationAdminConsentResourceAccessModel {
+	result := make([]ApplicationAdminConsentResourceAccessModel, 0)
+	if application.RequiredResourceAccess == nil {
+		return result
+	}
+
+	indexes := make(map[string]int)
+	for _, api := range *application.RequiredResourceAccess {
+		if api.ResourceAppId == nil || api.ResourceAccess == nil || len(*api.ResourceAccess) == 0 {
+			continue
+		}
+
+		key := strings.ToLower(*api.ResourceAppId)
+		i, ok := indexes[key]
+		if !ok {
+			result = append(result, ApplicationAdminConsentResourceAccessModel{
+				ResourceAppId: *api.ResourceAppId,
+				RoleIds:       make([]string, 0),
+				ScopeIds:      make([]string, 0),
+			})
+			i = len(result) - 1
+			indexes[key] = i
+		}
+
+		for _, permission := range *api.ResourceAccess {
+			id := strings.ToLower(pointer.From(permission.ID))
+			switch permission.Type {
+			case msgraph.ResourceAccessTypeRole:
+				if !applicationAdminConsentContains(result[i].RoleIds, id) {
+					result[i].RoleIds = append(result[i].RoleIds, id)
+				}
+			case msgraph.ResourceAccessTypeScope:
+				if !applicationAdminConsentContains(result[i].ScopeIds, id) {
+					result[i].ScopeIds = append(result[i].ScopeIds, id)
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// applicationAdminConsentScopeValues returns a map of delegated permission scope IDs to values, for the scopes
+// published by the specified service principal
+func applicationAdminConsentScopeValues(servicePrincipal *msgraph.ServicePrincipal) map[string]string {
+	result := make(map[string]string)
+	if servicePrincipal.OAuth2PermissionScopes == nil {
+		return result
+	}
+
+	for _, scope := range *servicePrincipal.OAuth2PermissionScopes {
+		if scope.ID != nil && scope.Value != nil {
+			result[strings.ToLower(*scope.ID)] = *scope.Value
+		}
+	}
+
+	return result
+}
+
+// applicationAdminConsentResourceAppIds returns the distinct API client IDs referenced by the provided access lists,
+// followed by any additional client IDs
+func applicationAdminConsentResourceAppIds(a, b []ApplicationAdminConsentResourceAccessModel, additional ...string) []string {
+	result := make([]string, 0)
+	for _, access := range append(append([]ApplicationAdminConsentResourceAccessModel{}, a...), b...) {
+		if !applicationAdminConsentContains(result, access.ResourceAppId) {
+			result = append(result, access.ResourceAppId)
+		}
+	}
+	for _, appId := range additional {
+		if !applicationAdminConsentContains(result, appId) {
+			result = append(result, appId)
+		}
+	}
+	return result
+}
+
+// applicationAdminConsentExpandAccess returns the access list from a raw `managed_resource_access` value
+func applicationAdminConsentExpandAccess(input []interface{}) []ApplicationAdminConsentResourceAccessModel {
+	result := make([]ApplicationAdminConsentResourceAccessModel, 0)
+	for _, raw := range input {
+		access, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		appId, _ := access["resource_app_id"].(string)
+		if appId == "" {
+			continue
+		}
+
+		model := ApplicationAdminConsentResourceAccessModel{
+			ResourceAppId: appId,
+			RoleIds:       make([]string, 0),
+			ScopeIds:      make([]string, 0),
+		}
+		if v, ok := access["role_ids"].(*pluginsdk.Set); ok {
+			model.RoleIds = tf.ExpandStringSlice(v.List())
+		}
+		if v, ok := access["scope_ids"].(*pluginsdk.Set); ok {
+			model.ScopeIds = tf.ExpandStringSlice(v.List())
+		}
+		result = append(result, model)
+	}
+	return result
+}
+
+// applicationAdminConsentAccessContains determines whether all the permissions described by `b` are also described by
+// `a`, irrespective of ordering and case
+func applicationAdminConsentAccessContains(a, b []ApplicationAdminConsentResourceAccessModel) bool {
+	for _, access := range b {
+		var match *ApplicationAdminConsentResourceAccessModel
+		for i := range a {
+			if strings.EqualFold(a[i].ResourceAppId, access.ResourceAppId) {
+				match = &a[i]
+				break
+			}
+		}
+
+		for _, id := range access.RoleIds {
+			if match == nil || !applicationAdminConsentContains(match.RoleIds, id) {
+				return false
+			}
+		}
+		for _, id := range access.ScopeIds {
+			if match == nil || !applicationAdminConsentContains(match.ScopeIds, id) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// applicationAdminConsentStringsEqual determines whether two slices contain the same values, irrespective of ordering
+// and case
+func applicationAdminConsentStringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, v := range a {
+		if !applicationAdminConsentContains(b, v) {
+			return false
+		}
+	}
+	for _, v := range b {
+		if !applicationAdminConsentContains(a, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// applicationAdminConsentNonEmptyAccess returns the specified API permissions, omitting any APIs for which there are
+// no permissions
+func applicationAdminConsentNonEmptyAccess(in []ApplicationAdminConsentResourceAccessModel) []ApplicationAdminConsentResourceAccessModel {
+	result := make([]ApplicationAdminConsentResourceAccessModel, 0)
+	for _, access := range in {
+		if len(access.RoleIds) > 0 || len(access.ScopeIds) > 0 {
+			result = append(result, access)
+		}
+	}
+	return result
+}
+
+// applicationAdminConsentRemove returns the specified values, omitting any which match the specified value
+func applicationAdminConsentRemove(in []string, value string) []string {
+	result := make([]string, 0)
+	for _, v := range in {
+		if !strings.EqualFold(v, value) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func applicationAdminConsentContains(in []string, value string) bool {
+	for _, v := range in {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+)
+
+type ApplicationAdminConsentResource struct{}
+
+func TestAccApplicationAdminConsent_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_admin_consent", "test")
+	r := ApplicationAdminConsentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("service_principal_id").IsUuid(),
+				check.That(data.ResourceName).Key("required_resource_access.#").HasValue("1"),
+				check.That(data.ResourceName).Key("granted_resource_access.#").HasValue("1"),
+				check.That(data.ResourceName).Key("granted_resource_access.0.role_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("granted_resource_access.0.scope_ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("managed_resource_access.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("triggers", "managed_resource_access"),
+	})
+}
+
+func TestAccApplicationAdminConsent_unmanagedConsent(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_admin_consent", "test")
+	r := ApplicationAdminConsentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.unmanagedConsent(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("granted_resource_access.0.role_ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("managed_resource_access.0.role_ids.#").HasValue("1"),
+				check.That("azuread_app_role_assignment.test").Key("id").Exists(),
+			),
+		},
+		{
+			// Consent granted outside of this resource should not cause a diff
+			Config:   r.unmanagedConsent(data),
+			PlanOnly: true,
+		},
+	})
+}
+
+func TestAccApplicationAdminConsent_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_admin_consent", "test")
+	r := ApplicationAdminConsentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("granted_resource_access.0.role_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("granted_resource_access.0.scope_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("triggers", "managed_resource_access"),
+		{
+			Config: r.reduced(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("granted_resource_access.#").HasValue("1"),
+				check.That(data.ResourceName).Key("granted_resource_access.0.role_ids.#").HasValue("0"),
+				check.That(data.ResourceName).Key("granted_resource_access.0.scope_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("triggers", "managed_resource_access"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("granted_resource_access.0.role_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("granted_resource_access.0.scope_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("triggers", "managed_resource_access"),
+	})
+}
+
+func (r ApplicationAdminConsentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	assignmentsClient := clients.Applications.ServicePrincipalsAppRoleAssignmentsClient
+	grantsClient := clients.Applications.DelegatedPermissionGrantsClient
+
+	id, err := parse.ParseAdminConsentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	servicePrincipalId := state.Attributes["service_principal_id"]
+	if servicePrincipalId == "" {
+		return pointer.To(false), nil
+	}
+
+	assignments, _, err := assignmentsClient.List(ctx, servicePrincipalId, odata.Query{})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving app role assignments for %s: %+v", id, err)
+	}
+	if assignments != nil && len(*assignments) > 0 {
+		return pointer.To(true), nil
+	}
+
+	grants, _, err := grantsClient.List(ctx, odata.Query{Filter: fmt.Sprintf("clientId eq '%s'", servicePrincipalId)})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving delegated permission grants for %s: %+v", id, err)
+	}
+
+	return pointer.To(grants != nil && len(*grants) > 0), nil
+}
+
+func (ApplicationAdminConsentResource) template(data acceptance.TestData) string {
+	return `
+provider "azuread" {}
+
+data "azuread_application_published_app_ids" "well_known" {}
+
+data "azuread_service_principal" "msgraph" {
+  client_id = data.azuread_application_published_app_ids.well_known.result["MicrosoftGraph"]
+}
+`
+}
+
+func (r ApplicationAdminConsentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application" "test" {
+  display_name = "acctest-AdminConsent-%[2]d"
+
+  required_resource_access {
+    resource_app_id = data.azuread_application_published_app_ids.well_known.result["MicrosoftGraph"]
+
+    resource_access {
+      id   = data.azuread_service_principal.msgraph.app_role_ids["User.Read.All"]
+      type = "Role"
+    }
+
+    resource_access {
+      id   = data.azuread_service_principal.msgraph.oauth2_permission_scope_ids["User.Read"]
+      type = "Scope"
+    }
+
+    resource_access {
+      id   = data.azuread_service_principal.msgraph.oauth2_permission_scope_ids["openid"]
+      type = "Scope"
+    }
+  }
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+}
+
+resource "azuread_application_admin_consent" "test" {
+  application_id = azuread_application.test.id
+
+  triggers = {
+    required_resource_access = sha1(jsonencode(azuread_application.test.required_resource_access))
+  }
+
+  depends_on = [azuread_service_principal.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApplicationAdminConsentResource) reduced(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application" "test" {
+  display_name = "acctest-AdminConsent-%[2]d"
+
+  required_resource_access {
+    resource_app_id = data.azuread_application_published_app_ids.well_known.result["MicrosoftGraph"]
+
+    resource_access {
+      id   = data.azuread_service_principal.msgraph.oauth2_permission_scope_ids["User.Read"]
+      type = "Scope"
+    }
+  }
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+}
+
+resource "azuread_application_admin_consent" "test" {
+  application_id = azuread_application.test.id
+
+  triggers = {
+    required_resource_access = sha1(jsonencode(azuread_application.test.required_resource_access))
+  }
+
+  depends_on = [azuread_service_principal.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApplicationAdminConsentResource) unmanagedConsent(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application" "test" {
+  display_name = "acctest-AdminConsent-%[2]d"
+
+  required_resource_access {
+    resource_app_id = data.azuread_application_published_app_ids.well_known.result["MicrosoftGraph"]
+
+    resource_access {
+      id   = data.azuread_service_principal.msgraph.app_role_ids["User.Read.All"]
+      type = "Role"
+    }
+  }
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+}
+
+resource "azuread_app_role_assignment" "test" {
+  app_role_id         = data.azuread_service_principal.msgraph.app_role_ids["Group.Read.All"]
+  principal_object_id = azuread_service_principal.test.object_id
+  resource_object_id  = data.azuread_service_principal.msgraph.object_id
+}
+
+resource "azuread_application_admin_consent" "test" {
+  application_id = azuread_application.test.id
+
+  triggers = {
+    required_resource_access = sha1(jsonencode(azuread_application.test.required_resource_access))
+  }
+
+  depends_on = [azuread_app_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
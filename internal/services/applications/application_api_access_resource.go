@@ -23,11 +23,15 @@ import (
 type ApplicationApiAccessModel struct {
 	ApplicationId string   `tfschema:"application_id"`
 	ApiClientId   string   `tfschema:"api_client_id"`
+	ApiName       string   `tfschema:"api_name"`
 	RoleIds       []string `tfschema:"role_ids"`
+	RoleNames     []string `tfschema:"role_names"`
 	ScopeIds      []string `tfschema:"scope_ids"`
+	ScopeNames    []string `tfschema:"scope_names"`
 }
 
 var _ sdk.ResourceWithUpdate = ApplicationApiAccessResource{}
+var _ sdk.ResourceWithCustomizeDiff = ApplicationApiAccessResource{}
 
 type ApplicationApiAccessResource struct{}
 
@@ -56,32 +60,73 @@ func (r ApplicationApiAccessResource) Arguments() map[string]*pluginsdk.Schema {
 		"api_client_id": {
 			Description:  "The client ID of the API to which access is being granted",
 			Type:         pluginsdk.TypeString,
-			Required:     true,
+			Optional:     true,
+			Computed:     true,
 			ForceNew:     true,
+			ExactlyOneOf: []string{"api_client_id", "api_name"},
 			ValidateFunc: validation.IsUUID,
 		},
 
-		"role_ids": {
-			Description:  "A set of role IDs to be granted to the application, as published by the API",
-			Type:         pluginsdk.TypeSet,
+		"api_name": {
+			Description:  "The name of a first-party API to which access is being granted, as listed by the `azuread_application_published_app_ids` data source",
+			Type:         pluginsdk.TypeString,
 			Optional:     true,
-			AtLeastOneOf: []string{"role_ids", "scope_ids"},
+			Computed:     true,
+			ExactlyOneOf: []string{"api_client_id", "api_name"},
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"role_ids": {
+			Description:   "A set of role IDs to be granted to the application, as published by the API",
+			Type:          pluginsdk.TypeSet,
+			Optional:      true,
+			Computed:      true,
+			AtLeastOneOf:  []string{"role_ids", "role_names", "scope_ids", "scope_names"},
+			ConflictsWith: []string{"role_names"},
 			Elem: &pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				ValidateFunc: validation.IsUUID,
 			},
 		},
 
+		"role_names": {
+			Description:   "A set of role values to be granted to the application, as published by the API, e.g. `User.Read.All`",
+			Type:          pluginsdk.TypeSet,
+			Optional:      true,
+			Computed:      true,
+			AtLeastOneOf:  []string{"role_ids", "role_names", "scope_ids", "scope_names"},
+			ConflictsWith: []string{"role_ids"},
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
 		"scope_ids": {
-			Description:  "A set of scope IDs to be granted to the application, as published by the API",
-			Type:         pluginsdk.TypeSet,
-			Optional:     true,
-			AtLeastOneOf: []string{"role_ids", "scope_ids"},
+			Description:   "A set of scope IDs to be granted to the application, as published by the API",
+			Type:          pluginsdk.TypeSet,
+			Optional:      true,
+			Computed:      true,
+			AtLeastOneOf:  []string{"role_ids", "role_names", "scope_ids", "scope_names"},
+			ConflictsWith: []string{"scope_names"},
 			Elem: &pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				ValidateFunc: validation.IsUUID,
 			},
 		},
+
+		"scope_names": {
+			Description:   "A set of scope values to be granted to the application, as published by the API, e.g. `User.Read`",
+			Type:          pluginsdk.TypeSet,
+			Optional:      true,
+			Computed:      true,
+			AtLeastOneOf:  []string{"role_ids", "role_names", "scope_ids", "scope_names"},
+			ConflictsWith: []string{"scope_ids"},
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
 	}
 }
 
@@ -89,6 +134,86 @@ func (r ApplicationApiAccessResource) Attributes() map[string]*pluginsdk.Schema
 	return map[string]*pluginsdk.Schema{}
 }
 
+func (r ApplicationApiAccessResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			diff := metadata.ResourceDiff
+			rawConfig := diff.GetRawConfig()
+
+			// Resolve the API name to a client ID, or vice versa, so that both are known at plan time
+			if apiName := rawConfig.GetAttr("api_name"); !apiName.IsNull() {
+				if !apiName.IsKnown() {
+					return diff.SetNewComputed("api_client_id")
+				}
+				apiClientId, err := apiClientIdForName(apiName.AsString())
+				if err != nil {
+					return fmt.Errorf("resolving `api_name`: %+v", err)
+				}
+				if err = diff.SetNew("api_client_id", apiClientId); err != nil {
+					return err
+				}
+			} else if apiClientId := rawConfig.GetAttr("api_client_id"); apiClientId.IsKnown() && !apiClientId.IsNull() {
+				if err := diff.SetNew("api_name", apiNameForClientId(apiClientId.AsString())); err != nil {
+					return err
+				}
+			}
+
+			resolver := newApiPermissionResolver(metadata.Client.Applications.ServicePrincipalsClient)
+
+			// Resolve role and scope values to their IDs using the service principal for the API
+			for _, permission := range []struct {
+				idsKey, namesKey, permissionType string
+			}{
+				{"role_ids", "role_names", msgraph.ResourceAccessTypeRole},
+				{"scope_ids", "scope_names", msgraph.ResourceAccessTypeScope},
+			} {
+				names := rawConfig.GetAttr(permission.namesKey)
+				if names.IsNull() {
+					// Names are no longer being used, so discard any that were previously resolved
+					if old := diff.Get(permission.namesKey).(*pluginsdk.Set); old.Len() > 0 {
+						if err := diff.SetNew(permission.namesKey, []interface{}{}); err != nil {
+							return err
+						}
+					}
+
+					// When neither IDs nor names are configured, any previously granted permissions should be removed,
+					// rather than the computed IDs being retained in state
+					if rawConfig.GetAttr(permission.idsKey).IsNull() {
+						if err := diff.SetNew(permission.idsKey, []interface{}{}); err != nil {
+							return err
+						}
+					}
+					continue
+				}
+
+				if !names.IsWhollyKnown() || !diff.NewValueKnown("api_client_id") {
+					if err := diff.SetNewComputed(permission.idsKey); err != nil {
+						return err
+					}
+					continue
+				}
+
+				apiClientId := diff.Get("api_client_id").(string)
+				ids := make([]interface{}, 0)
+				for _, name := range names.AsValueSlice() {
+					id, err := resolver.id(ctx, apiClientId, permission.permissionType, name.AsString())
+					if err != nil {
+						return fmt.Errorf("resolving `%s`: %+v", permission.namesKey, err)
+					}
+					ids = append(ids, id)
+				}
+
+				if err := diff.SetNew(permission.idsKey, ids); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
 func (r ApplicationApiAccessResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 10 * time.Minute,
@@ -183,6 +308,11 @@ func (r ApplicationApiAccessResource) Read() sdk.ResourceFunc {
 				return err
 			}
 
+			var model ApplicationApiAccessModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
 			applicationId := parse.NewApplicationID(id.ApplicationId)
 
 			tf.LockByName(applicationResourceName, id.ApplicationId)
@@ -232,10 +362,31 @@ func (r ApplicationApiAccessResource) Read() sdk.ResourceFunc {
 			state := ApplicationApiAccessModel{
 				ApplicationId: applicationId.ID(),
 				ApiClientId:   pointer.From(api.ResourceAppId),
+				ApiName:       apiNameForClientId(pointer.From(api.ResourceAppId)),
 				RoleIds:       roleIds,
 				ScopeIds:      scopeIds,
 			}
 
+			// API names are matched case-insensitively, so retain the configured name when it refers to the same API
+			if model.ApiName != "" {
+				if apiClientId, err := apiClientIdForName(model.ApiName); err == nil && strings.EqualFold(apiClientId, state.ApiClientId) {
+					state.ApiName = model.ApiName
+				}
+			}
+
+			// Only resolve role and scope names when they are being used, to avoid looking up the API needlessly
+			resolver := newApiPermissionResolver(metadata.Client.Applications.ServicePrincipalsClient)
+			if len(model.RoleNames) > 0 {
+				if state.RoleNames, err = apiAccessPermissionNames(ctx, resolver, state.ApiClientId, msgraph.ResourceAccessTypeRole, roleIds); err != nil {
+					return fmt.Errorf("resolving role names for %s: %+v", id, err)
+				}
+			}
+			if len(model.ScopeNames) > 0 {
+				if state.ScopeNames, err = apiAccessPermissionNames(ctx, resolver, state.ApiClientId, msgraph.ResourceAccessTypeScope, scopeIds); err != nil {
+					return fmt.Errorf("resolving scope names for %s: %+v", id, err)
+				}
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -382,3 +533,19 @@ func (r ApplicationApiAccessResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+// apiAccessPermissionNames returns the values of the specified app roles or delegated permission scopes, omitting any
+// that are no longer published by the API
+func apiAccessPermissionNames(ctx context.Context, resolver *apiPermissionResolver, apiClientId, permissionType string, ids []string) ([]string, error) {
+	names := make([]string, 0)
+	for _, id := range ids {
+		name, err := resolver.value(ctx, apiClientId, permissionType, id)
+		if err != nil {
+			return nil, err
+		}
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
@@ -85,6 +85,8 @@ func TestAccApplicationApiAccess_multipleUpdate(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("application_id").Exists(),
 				check.That(data.ResourceName).Key("api_client_id").Exists(),
+				check.That(data.ResourceName).Key("role_ids.#").HasValue("0"),
+				check.That(data.ResourceName).Key("scope_ids.#").HasValue("1"),
 				check.That(data2.ResourceName).ExistsInAzure(r),
 				check.That(data2.ResourceName).Key("application_id").Exists(),
 				check.That(data2.ResourceName).Key("api_client_id").Exists(),
@@ -108,6 +110,47 @@ func TestAccApplicationApiAccess_multipleUpdate(t *testing.T) {
 	})
 }
 
+func TestAccApplicationApiAccess_byName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_api_access", "test")
+	r := ApplicationApiAccessResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.byName(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("api_client_id").HasValue("00000003-0000-0000-c000-000000000000"),
+				check.That(data.ResourceName).Key("api_name").HasValue("MicrosoftGraph"),
+				check.That(data.ResourceName).Key("role_ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("role_names.#").HasValue("2"),
+				check.That(data.ResourceName).Key("scope_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("scope_names.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("role_names", "scope_names"),
+		{
+			Config: r.byNameScopesOnly(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_ids.#").HasValue("0"),
+				check.That(data.ResourceName).Key("role_names.#").HasValue("0"),
+				check.That(data.ResourceName).Key("scope_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("scope_names.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("scope_names"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_names.#").HasValue("0"),
+				check.That(data.ResourceName).Key("scope_names.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccApplicationApiAccess_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application_api_access", "test")
 	r := ApplicationApiAccessResource{}
@@ -183,6 +226,49 @@ resource "azuread_application_api_access" "test" {
 `, data.RandomInteger, data.RandomPassword)
 }
 
+func (ApplicationApiAccessResource) byName(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application_registration" "test" {
+  display_name = "acctest-ApiAccess-%[1]d"
+}
+
+resource "azuread_application_api_access" "test" {
+  application_id = azuread_application_registration.test.id
+  api_name       = "MicrosoftGraph"
+
+  role_names = [
+    "Group.Read.All",
+    "User.Read.All",
+  ]
+
+  scope_names = [
+    "User.Read",
+  ]
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationApiAccessResource) byNameScopesOnly(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application_registration" "test" {
+  display_name = "acctest-ApiAccess-%[1]d"
+}
+
+resource "azuread_application_api_access" "test" {
+  application_id = azuread_application_registration.test.id
+  api_name       = "MicrosoftGraph"
+
+  scope_names = [
+    "User.Read",
+  ]
+}
+`, data.RandomInteger)
+}
+
 func (ApplicationApiAccessResource) multiple(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
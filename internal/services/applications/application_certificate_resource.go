@@ -140,10 +140,84 @@ func applicationCertificateResource() *pluginsdk.Resource {
 			},
 
 			"value": {
-				Description: "The certificate data, which can be PEM encoded, base64 encoded DER or hexadecimal encoded DER. See also the `encoding` argument",
+				Description:  "The certificate data, which can be PEM encoded, base64 encoded DER or hexadecimal encoded DER. See also the `encoding` argument",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"generate_certificate", "value"},
+			},
+
+			"generate_certificate": {
+				Description:  "Generate a key pair and a self-signed certificate, instead of supplying the certificate data",
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"generate_certificate", "value"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"subject": {
+							Description:  "The subject of the certificate, for example `CN=example`",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"key_algorithm": {
+							Description: "The algorithm of the generated key pair",
+							Type:        pluginsdk.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     helpers.CertificateKeyAlgorithmRSA,
+							ValidateFunc: validation.StringInSlice([]string{
+								helpers.CertificateKeyAlgorithmECDSA,
+								helpers.CertificateKeyAlgorithmRSA,
+							}, false),
+						},
+
+						"rsa_bits": {
+							Description:  "The size of the generated RSA key, in bits",
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      2048,
+							ValidateFunc: validation.IntInSlice([]int{2048, 3072, 4096}),
+						},
+
+						"ecdsa_curve": {
+							Description:  "The elliptic curve of the generated ECDSA key",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "P256",
+							ValidateFunc: validation.StringInSlice([]string{"P256", "P384", "P521"}, false),
+						},
+
+						"pfx_password": {
+							Description: "The password used to protect the generated PFX bundle",
+							Type:        pluginsdk.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+
+			"private_key_pem": {
+				Description: "The PEM encoded private key of the generated certificate",
 				Type:        pluginsdk.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Computed:    true,
+				Sensitive:   true,
+			},
+
+			"pfx": {
+				Description: "The generated certificate and private key, as a base64 encoded PFX bundle",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
 				Sensitive:   true,
 			},
 		},
@@ -173,6 +247,28 @@ func applicationCertificateResourceCreate(ctx context.Context, d *pluginsdk.Reso
 		}
 	}
 
+	generatedCertificate, err := helpers.GenerateCertificateForResource(d)
+	if err != nil {
+		attr := ""
+		if kerr, ok := err.(helpers.CredentialError); ok {
+			attr = kerr.Attr()
+		}
+		return tf.ErrorDiagPathF(err, attr, "Generating self-signed certificate for %s", applicationId)
+	}
+	if generatedCertificate != nil {
+		if d.Get("encoding").(string) != "pem" {
+			return tf.ErrorDiagPathF(nil, "encoding", "`encoding` must be `pem` when generating a certificate")
+		}
+		if d.Get("type").(string) != "AsymmetricX509Cert" {
+			return tf.ErrorDiagPathF(nil, "type", "`type` must be `AsymmetricX509Cert` when generating a certificate")
+		}
+
+		// Use the validity of the generated certificate for the key credential
+		tf.Set(d, "value", generatedCertificate.CertificatePem)
+		tf.Set(d, "start_date", generatedCertificate.StartDate.Format(time.RFC3339))
+		tf.Set(d, "end_date", generatedCertificate.EndDate.Format(time.RFC3339))
+	}
+
 	credential, err := helpers.KeyCredentialForResource(d)
 	if err != nil {
 		attr := ""
@@ -217,7 +313,7 @@ func applicationCertificateResourceCreate(ctx context.Context, d *pluginsdk.Reso
 		KeyCredentials: &newCredentials,
 	}
 	if _, err := client.Update(ctx, properties); err != nil {
-		return tf.ErrorDiagF(err, "Adding certificate for application with object ID %q", id.ObjectId)
+		return helpers.CredentialErrorDiag(err, helpers.CredentialTypeCertificate, "Adding certificate for application with object ID %q", id.ObjectId)
 	}
 
 	// Wait for the credential to appear in the application manifest, this can take several minutes
@@ -254,6 +350,11 @@ func applicationCertificateResourceCreate(ctx context.Context, d *pluginsdk.Reso
 
 	d.SetId(id.String())
 
+	if generatedCertificate != nil {
+		tf.Set(d, "pfx", generatedCertificate.PfxBase64)
+		tf.Set(d, "private_key_pem", generatedCertificate.PrivateKeyPem)
+	}
+
 	return applicationCertificateResourceRead(ctx, d, meta)
 }
 
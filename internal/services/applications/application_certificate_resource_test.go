@@ -153,6 +153,44 @@ func TestAccApplicationCertificate_relativeEndDate(t *testing.T) {
 	})
 }
 
+func TestAccApplicationCertificate_generateRsa(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_certificate", "test")
+	r := ApplicationCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.generateRsa(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_id").Exists(),
+				check.That(data.ResourceName).Key("end_date").Exists(),
+				check.That(data.ResourceName).Key("pfx").Exists(),
+				check.That(data.ResourceName).Key("private_key_pem").Exists(),
+				check.That(data.ResourceName).Key("value").Exists(),
+			),
+		},
+		data.ImportStep("encoding", "end_date_relative", "generate_certificate", "pfx", "private_key_pem", "value"),
+	})
+}
+
+func TestAccApplicationCertificate_generateEcdsa(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_certificate", "test")
+	r := ApplicationCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.generateEcdsa(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_id").Exists(),
+				check.That(data.ResourceName).Key("pfx").Exists(),
+				check.That(data.ResourceName).Key("private_key_pem").Exists(),
+			),
+		},
+		data.ImportStep("encoding", "generate_certificate", "pfx", "private_key_pem", "value"),
+	})
+}
+
 func TestAccApplicationCertificate_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application_certificate", "test")
 	endDate := time.Now().AddDate(0, 3, 27).UTC().Format(time.RFC3339)
@@ -321,6 +359,41 @@ EOT
 `, r.template(data), applicationCertificatePem)
 }
 
+func (r ApplicationCertificateResource) generateRsa(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_certificate" "test" {
+  application_id    = azuread_application.test.id
+  end_date_relative = "2280h"
+  type              = "AsymmetricX509Cert"
+
+  generate_certificate {
+    subject      = "CN=acctest-%[2]d, O=HashiCorp"
+    rsa_bits     = 3072
+    pfx_password = "acctest-%[3]s"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomString)
+}
+
+func (r ApplicationCertificateResource) generateEcdsa(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_certificate" "test" {
+  application_id = azuread_application.test.id
+  type           = "AsymmetricX509Cert"
+
+  generate_certificate {
+    subject       = "CN=acctest-%[2]d"
+    key_algorithm = "ECDSA"
+    ecdsa_curve   = "P384"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r ApplicationCertificateResource) requiresImport(data acceptance.TestData, endDate string) string {
 	return fmt.Sprintf(`
 %[1]s
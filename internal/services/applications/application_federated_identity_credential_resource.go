@@ -6,6 +6,7 @@ package applications
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"net/http"
 	"strings"
@@ -16,11 +17,12 @@ import (
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
+	applicationsClient "github.com/hashicorp/terraform-provider-azuread/internal/services/applications/client"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/validate"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
-	"github.com/manicminer/hamilton/msgraph"
 )
 
 func applicationFederatedIdentityCredentialResource() *pluginsdk.Resource {
@@ -30,6 +32,8 @@ func applicationFederatedIdentityCredentialResource() *pluginsdk.Resource {
 		ReadContext:   applicationFederatedIdentityCredentialResourceRead,
 		DeleteContext: applicationFederatedIdentityCredentialResourceDelete,
 
+		CustomizeDiff: applicationFederatedIdentityCredentialResourceCustomizeDiff,
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(15 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -81,7 +85,8 @@ func applicationFederatedIdentityCredentialResource() *pluginsdk.Resource {
 			"audiences": {
 				Description: "List of audiences that can appear in the external token. This specifies what should be accepted in the `aud` claim of incoming tokens.",
 				Type:        pluginsdk.TypeList,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				MaxItems:    1,
 				// TODO: consider making this a scalar value instead of a list in v3.0 (the API now only accepts a single value)
 				Elem: &pluginsdk.Schema{
@@ -99,17 +104,38 @@ func applicationFederatedIdentityCredentialResource() *pluginsdk.Resource {
 			},
 
 			"issuer": {
-				Description: "The URL of the external identity provider, which must match the issuer claim of the external token being exchanged. The combination of the values of issuer and subject must be unique on the app.",
-				Type:        pluginsdk.TypeString,
-				Required:    true,
+				Description:  "The URL of the external identity provider, which must match the issuer claim of the external token being exchanged. The combination of the values of issuer and subject must be unique on the app.",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: append([]string{"issuer"}, federatedIdentityCredentialPresets...),
+				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"subject": {
-				Description: "The identifier of the external software workload within the external identity provider. The combination of issuer and subject must be unique on the app.",
-				Type:        pluginsdk.TypeString,
-				Required:    true,
+				Description:  "The identifier of the external software workload within the external identity provider. The combination of issuer and subject must be unique on the app.",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, federatedIdentityCredentialSubjectMaxLength),
+			},
+
+			"claims_matching_expression": {
+				Description:      "An expression, using version 1 of the claims matching expression language, that must evaluate to true for incoming tokens. Cannot be used together with `subject`.",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ConflictsWith:    append([]string{"subject"}, federatedIdentityCredentialPresets...),
+				ValidateDiagFunc: validate.ClaimsMatchingExpression,
 			},
 
+			"github": federatedIdentityCredentialGitHubSchema(),
+
+			"gitlab": federatedIdentityCredentialGitLabSchema(),
+
+			"kubernetes": federatedIdentityCredentialKubernetesSchema(),
+
+			"terraform_cloud": federatedIdentityCredentialTerraformCloudSchema(),
+
 			"description": {
 				Description: "A description for the federated identity credential",
 				Type:        pluginsdk.TypeString,
@@ -125,8 +151,76 @@ func applicationFederatedIdentityCredentialResource() *pluginsdk.Resource {
 	}
 }
 
+func applicationFederatedIdentityCredentialResourceCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	config := diff.GetRawConfig()
+
+	preset := ""
+	for _, name := range federatedIdentityCredentialPresets {
+		if v := config.GetAttr(name); !v.IsNull() && v.IsKnown() && v.LengthInt() > 0 {
+			preset = name
+			break
+		}
+	}
+
+	if preset == "" {
+		// The issuer, audiences and either the subject or a claims matching expression must all be specified
+		// explicitly when not using a preset
+		if config.GetAttr("audiences").IsNull() {
+			return fmt.Errorf("`audiences` is required when `issuer` is specified")
+		}
+		if config.GetAttr("subject").IsNull() {
+			if config.GetAttr("claims_matching_expression").IsNull() {
+				return fmt.Errorf("one of `subject` or `claims_matching_expression` is required when `issuer` is specified")
+			}
+
+			// The subject is computed, so clear it explicitly when switching to a claims matching expression
+			if err := diff.SetNew("subject", ""); err != nil {
+				return fmt.Errorf("setting `subject`: %+v", err)
+			}
+		}
+		return nil
+	}
+
+	// Preset blocks request the recommended audience, unless audiences are specified explicitly
+	if config.GetAttr("audiences").IsNull() {
+		if err := diff.SetNew("audiences", []interface{}{federatedIdentityCredentialDefaultAudience}); err != nil {
+			return fmt.Errorf("setting `audiences`: %+v", err)
+		}
+	}
+
+	if !config.GetAttr(preset).IsWhollyKnown() {
+		if err := diff.SetNewComputed("issuer"); err != nil {
+			return fmt.Errorf("setting `issuer`: %+v", err)
+		}
+		if err := diff.SetNewComputed("subject"); err != nil {
+			return fmt.Errorf("setting `subject`: %+v", err)
+		}
+		return nil
+	}
+
+	block := diff.Get(preset).([]interface{})
+	if len(block) == 0 || block[0] == nil {
+		return nil
+	}
+
+	issuer, subject, err := federatedIdentityCredentialPresetIssuerSubject(preset, block[0].(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
+	if err = diff.SetNew("issuer", issuer); err != nil {
+		return fmt.Errorf("setting `issuer`: %+v", err)
+	}
+	if err = diff.SetNew("subject", subject); err != nil {
+		return fmt.Errorf("setting `subject`: %+v", err)
+	}
+
+	return nil
+}
+
 func applicationFederatedIdentityCredentialResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics { //nolint
 	client := meta.(*clients.Client).Applications.ApplicationsClientBeta
+	credentialsClient := meta.(*clients.Client).Applications.FederatedIdentityCredentialsClient
 
 	var applicationId *parse.ApplicationId
 	var err error
@@ -162,15 +256,16 @@ func applicationFederatedIdentityCredentialResourceCreate(ctx context.Context, d
 		return tf.ErrorDiagF(errors.New("nil application or application with nil ID was returned"), "API error retrieving application with object ID %q", applicationId.ApplicationId)
 	}
 
-	credential := msgraph.FederatedIdentityCredential{
-		Audiences:   tf.ExpandStringSlicePtr(d.Get("audiences").([]interface{})),
-		Description: tf.NullableString(d.Get("description").(string)),
-		Issuer:      pointer.To(d.Get("issuer").(string)),
-		Name:        pointer.To(d.Get("display_name").(string)),
-		Subject:     pointer.To(d.Get("subject").(string)),
+	credential := applicationsClient.FederatedIdentityCredential{
+		Audiences:                tf.ExpandStringSlicePtr(d.Get("audiences").([]interface{})),
+		ClaimsMatchingExpression: expandFederatedIdentityExpression(d.Get("claims_matching_expression").(string)),
+		Description:              tf.NullableString(d.Get("description").(string)),
+		Issuer:                   pointer.To(d.Get("issuer").(string)),
+		Name:                     pointer.To(d.Get("display_name").(string)),
+		Subject:                  tf.NullableString(d.Get("subject").(string)),
 	}
 
-	newCredential, _, err := client.CreateFederatedIdentityCredential(ctx, *app.ID(), credential)
+	newCredential, _, err := credentialsClient.Create(ctx, *app.ID(), credential)
 	if err != nil {
 		return tf.ErrorDiagF(err, "Adding federated identity credential for application with object ID %q", *app.ID())
 	}
@@ -192,7 +287,7 @@ func applicationFederatedIdentityCredentialResourceCreate(ctx context.Context, d
 		MinTimeout:                1 * time.Second,
 		ContinuousTargetOccurence: 5,
 		Refresh: func() (interface{}, string, error) {
-			credentials, _, err := client.ListFederatedIdentityCredentials(ctx, id.ObjectId, odata.Query{})
+			credentials, _, err := credentialsClient.List(ctx, id.ObjectId, odata.Query{})
 			if err != nil {
 				return nil, "Error", err
 			}
@@ -221,7 +316,7 @@ func applicationFederatedIdentityCredentialResourceCreate(ctx context.Context, d
 }
 
 func applicationFederatedIdentityCredentialResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics { //nolint
-	client := meta.(*clients.Client).Applications.ApplicationsClientBeta
+	client := meta.(*clients.Client).Applications.FederatedIdentityCredentialsClient
 
 	id, err := parse.FederatedIdentityCredentialID(d.Id())
 	if err != nil {
@@ -231,15 +326,16 @@ func applicationFederatedIdentityCredentialResourceUpdate(ctx context.Context, d
 	tf.LockByName(applicationResourceName, id.ObjectId)
 	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
 
-	credential := msgraph.FederatedIdentityCredential{
-		ID:          pointer.To(id.KeyId),
-		Audiences:   tf.ExpandStringSlicePtr(d.Get("audiences").([]interface{})),
-		Description: tf.NullableString(d.Get("description").(string)),
-		Issuer:      pointer.To(d.Get("issuer").(string)),
-		Subject:     pointer.To(d.Get("subject").(string)),
+	credential := applicationsClient.FederatedIdentityCredential{
+		ID:                       pointer.To(id.KeyId),
+		Audiences:                tf.ExpandStringSlicePtr(d.Get("audiences").([]interface{})),
+		ClaimsMatchingExpression: expandFederatedIdentityExpression(d.Get("claims_matching_expression").(string)),
+		Description:              tf.NullableString(d.Get("description").(string)),
+		Issuer:                   pointer.To(d.Get("issuer").(string)),
+		Subject:                  tf.NullableString(d.Get("subject").(string)),
 	}
 
-	_, err = client.UpdateFederatedIdentityCredential(ctx, id.ObjectId, credential)
+	_, err = client.Update(ctx, id.ObjectId, credential)
 	if err != nil {
 		return tf.ErrorDiagF(err, "Updating federated identity credential with ID %q for application with object ID %q", id.KeyId, id.ObjectId)
 	}
@@ -248,7 +344,7 @@ func applicationFederatedIdentityCredentialResourceUpdate(ctx context.Context, d
 }
 
 func applicationFederatedIdentityCredentialResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics { //nolint
-	client := meta.(*clients.Client).Applications.ApplicationsClientBeta
+	client := meta.(*clients.Client).Applications.FederatedIdentityCredentialsClient
 
 	id, err := parse.FederatedIdentityCredentialID(d.Id())
 	if err != nil {
@@ -257,7 +353,7 @@ func applicationFederatedIdentityCredentialResourceRead(ctx context.Context, d *
 
 	applicationId := parse.NewApplicationID(id.ObjectId)
 
-	credential, status, err := client.GetFederatedIdentityCredential(ctx, id.ObjectId, id.KeyId, odata.Query{})
+	credential, status, err := client.Get(ctx, id.ObjectId, id.KeyId, odata.Query{})
 	if err != nil {
 		if status == http.StatusNotFound {
 			log.Printf("[DEBUG] Federated Identity Credential with ID %q for Application %s was not found - removing from state!", id.KeyId, id.ObjectId)
@@ -271,6 +367,7 @@ func applicationFederatedIdentityCredentialResourceRead(ctx context.Context, d *
 	tf.Set(d, "credential_id", id.KeyId)
 
 	tf.Set(d, "audiences", tf.FlattenStringSlicePtr(credential.Audiences))
+	tf.Set(d, "claims_matching_expression", flattenFederatedIdentityExpression(credential.ClaimsMatchingExpression))
 	tf.Set(d, "description", credential.Description)
 	tf.Set(d, "display_name", credential.Name)
 	tf.Set(d, "issuer", credential.Issuer)
@@ -286,7 +383,7 @@ func applicationFederatedIdentityCredentialResourceRead(ctx context.Context, d *
 }
 
 func applicationFederatedIdentityCredentialResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics { //nolint
-	client := meta.(*clients.Client).Applications.ApplicationsClientBeta
+	client := meta.(*clients.Client).Applications.FederatedIdentityCredentialsClient
 
 	id, err := parse.FederatedIdentityCredentialID(d.Id())
 	if err != nil {
@@ -296,7 +393,7 @@ func applicationFederatedIdentityCredentialResourceDelete(ctx context.Context, d
 	tf.LockByName(applicationResourceName, id.ObjectId)
 	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
 
-	if _, err := client.DeleteFederatedIdentityCredential(ctx, id.ObjectId, id.KeyId); err != nil {
+	if _, err := client.Delete(ctx, id.ObjectId, id.KeyId); err != nil {
 		return tf.ErrorDiagF(err, "Removing federated identity credential %q from application with object ID %q", id.KeyId, id.ObjectId)
 	}
 
@@ -305,7 +402,7 @@ func applicationFederatedIdentityCredentialResourceDelete(ctx context.Context, d
 		defer func() { client.BaseClient.DisableRetries = false }()
 		client.BaseClient.DisableRetries = true
 
-		credentials, _, err := client.ListFederatedIdentityCredentials(ctx, id.ObjectId, odata.Query{})
+		credentials, _, err := client.List(ctx, id.ObjectId, odata.Query{})
 		if err != nil {
 			return nil, err
 		}
@@ -118,8 +118,139 @@ func TestAccApplicationFederatedIdentityCredential_deprecatedId2(t *testing.T) {
 	})
 }
 
+func TestAccApplicationFederatedIdentityCredential_github(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_federated_identity_credential", "test")
+	r := ApplicationFederatedIdentityCredentialResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.github(data, "branch", `"main"`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("issuer").HasValue("https://token.actions.githubusercontent.com"),
+				check.That(data.ResourceName).Key("subject").HasValue(fmt.Sprintf("repo:hashitown/acctest-%s:ref:refs/heads/main", data.RandomString)),
+				check.That(data.ResourceName).Key("audiences.0").HasValue("api://AzureADTokenExchange"),
+			),
+		},
+		data.ImportStep("github"),
+		{
+			Config: r.github(data, "environment", `"production"`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subject").HasValue(fmt.Sprintf("repo:hashitown/acctest-%s:environment:production", data.RandomString)),
+			),
+		},
+		data.ImportStep("github"),
+		{
+			Config: r.github(data, "pull_request", "null"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subject").HasValue(fmt.Sprintf("repo:hashitown/acctest-%s:pull_request", data.RandomString)),
+			),
+		},
+		data.ImportStep("github"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("issuer").HasValue("https://tokens.hashitown.net"),
+				check.That(data.ResourceName).Key("audiences.0").HasValue("api://HashiTownLikesAzureAD"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationFederatedIdentityCredential_gitlab(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_federated_identity_credential", "test")
+	r := ApplicationFederatedIdentityCredentialResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.gitlab(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("issuer").HasValue("https://gitlab.com"),
+				check.That(data.ResourceName).Key("subject").HasValue(fmt.Sprintf("project_path:hashitown/infra/acctest-%s:ref_type:tag:ref:v1.0.0", data.RandomString)),
+				check.That(data.ResourceName).Key("audiences.0").HasValue("https://gitlab.com"),
+			),
+		},
+		data.ImportStep("gitlab"),
+	})
+}
+
+func TestAccApplicationFederatedIdentityCredential_kubernetes(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_federated_identity_credential", "test")
+	r := ApplicationFederatedIdentityCredentialResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.kubernetes(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("issuer").HasValue(fmt.Sprintf("https://oidc.hashitown.net/%s/", data.RandomString)),
+				check.That(data.ResourceName).Key("subject").HasValue("system:serviceaccount:hashitown:workload-identity"),
+				check.That(data.ResourceName).Key("audiences.0").HasValue("api://AzureADTokenExchange"),
+			),
+		},
+		data.ImportStep("kubernetes"),
+	})
+}
+
+func TestAccApplicationFederatedIdentityCredential_terraformCloud(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_federated_identity_credential", "test")
+	r := ApplicationFederatedIdentityCredentialResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.terraformCloud(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("issuer").HasValue("https://app.terraform.io"),
+				check.That(data.ResourceName).Key("subject").HasValue(fmt.Sprintf("organization:hashitown:project:Default Project:workspace:acctest-%s:run_phase:apply", data.RandomString)),
+			),
+		},
+		data.ImportStep("terraform_cloud"),
+	})
+}
+
+func TestAccApplicationFederatedIdentityCredential_claimsMatchingExpression(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_federated_identity_credential", "test")
+	r := ApplicationFederatedIdentityCredentialResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.claimsMatchingExpression(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("claims_matching_expression").Exists(),
+				check.That(data.ResourceName).Key("subject").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("claims_matching_expression").HasValue(""),
+				check.That(data.ResourceName).Key("subject").HasValue(data.RandomID),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.claimsMatchingExpression(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("claims_matching_expression").Exists(),
+				check.That(data.ResourceName).Key("subject").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r ApplicationFederatedIdentityCredentialResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	client := clients.Applications.ApplicationsClientBeta
+	client := clients.Applications.FederatedIdentityCredentialsClient
 	client.BaseClient.DisableRetries = true
 	defer func() { client.BaseClient.DisableRetries = false }()
 
@@ -128,7 +259,7 @@ func (r ApplicationFederatedIdentityCredentialResource) Exists(ctx context.Conte
 		return nil, fmt.Errorf("parsing Application Federated Identity Credential ID: %v", err)
 	}
 
-	credential, status, err := client.GetFederatedIdentityCredential(ctx, id.ObjectId, id.KeyId, odata.Query{})
+	credential, status, err := client.Get(ctx, id.ObjectId, id.KeyId, odata.Query{})
 	if err != nil {
 		if status == http.StatusNotFound {
 			return nil, fmt.Errorf("Federated Identity Credential %q for Application with object ID %q does not exist", id.KeyId, id.ObjectId)
@@ -203,3 +334,87 @@ resource "azuread_application_federated_identity_credential" "test" {
 }
 `, r.template(data), data.RandomString, data.RandomID)
 }
+
+func (r ApplicationFederatedIdentityCredentialResource) github(data acceptance.TestData, entity, value string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_federated_identity_credential" "test" {
+  application_id = azuread_application.test.id
+  display_name   = "hashitown-%[2]s"
+
+  github {
+    organization = "hashitown"
+    repository   = "acctest-%[2]s"
+    entity       = "%[3]s"
+    value        = %[4]s
+  }
+}
+`, r.template(data), data.RandomString, entity, value)
+}
+
+func (r ApplicationFederatedIdentityCredentialResource) gitlab(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_federated_identity_credential" "test" {
+  application_id = azuread_application.test.id
+  display_name   = "hashitown-%[2]s"
+  audiences      = ["https://gitlab.com"]
+
+  gitlab {
+    project_path = "hashitown/infra/acctest-%[2]s"
+    ref_type     = "tag"
+    ref          = "v1.0.0"
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r ApplicationFederatedIdentityCredentialResource) kubernetes(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_federated_identity_credential" "test" {
+  application_id = azuread_application.test.id
+  display_name   = "hashitown-%[2]s"
+
+  kubernetes {
+    issuer_url      = "https://oidc.hashitown.net/%[2]s/"
+    namespace       = "hashitown"
+    service_account = "workload-identity"
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r ApplicationFederatedIdentityCredentialResource) terraformCloud(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_federated_identity_credential" "test" {
+  application_id = azuread_application.test.id
+  display_name   = "hashitown-%[2]s"
+
+  terraform_cloud {
+    organization = "hashitown"
+    workspace    = "acctest-%[2]s"
+    run_phase    = "apply"
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r ApplicationFederatedIdentityCredentialResource) claimsMatchingExpression(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_federated_identity_credential" "test" {
+  application_id             = azuread_application.test.id
+  display_name               = "hashitown-%[2]s"
+  audiences                  = ["api://HashiTownLikesAzureAD"]
+  issuer                     = "https://token.actions.githubusercontent.com"
+  claims_matching_expression = "claims['sub'] matches 'repo:hashitown/acctest-%[2]s:ref:refs/heads/*'"
+}
+`, r.template(data), data.RandomString)
+}
@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+type ApplicationFromManifestModel struct {
+	ClientId     string `tfschema:"client_id"`
+	DisplayName  string `tfschema:"display_name"`
+	ManifestJson string `tfschema:"manifest_json"`
+	ObjectId     string `tfschema:"object_id"`
+}
+
+var _ sdk.ResourceWithUpdate = ApplicationFromManifestResource{}
+
+type ApplicationFromManifestResource struct{}
+
+func (r ApplicationFromManifestResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.ValidateApplicationID
+}
+
+func (r ApplicationFromManifestResource) ResourceType() string {
+	return "azuread_application_from_manifest"
+}
+
+func (r ApplicationFromManifestResource) ModelObject() interface{} {
+	return &ApplicationFromManifestModel{}
+}
+
+func (r ApplicationFromManifestResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"manifest_json": {
+			Description:      "The application manifest, in either the Microsoft Graph or the legacy Azure AD Graph format, as exported from the Azure Portal",
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     validateApplicationManifest,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+	}
+}
+
+func (r ApplicationFromManifestResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"client_id": {
+			Description: "The Client ID (also called Application ID)",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+
+		"display_name": {
+			Description: "The display name for the application",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+
+		"object_id": {
+			Description: "The object ID of the application within the tenant",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func (r ApplicationFromManifestResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Applications.ApplicationsClient
+
+			var model ApplicationFromManifestModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			properties, _, err := parseApplicationManifest(model.ManifestJson)
+			if err != nil {
+				return err
+			}
+
+			// Pre-authorized applications may reference permission scopes being created, so they are set afterwards
+			preAuthorizedApplications := properties.Api.PreAuthorizedApplications
+			properties.Api.PreAuthorizedApplications = nil
+
+			client.BaseClient.DisableRetries = true
+			result, _, err := client.Create(ctx, *properties)
+			client.BaseClient.DisableRetries = false
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", parse.ApplicationId{}, err)
+			}
+
+			if pointer.From(result.ID()) == "" {
+				return fmt.Errorf("creating %s: object ID returned for application is nil/empty", parse.ApplicationId{})
+			}
+
+			id := parse.NewApplicationID(*result.ID())
+			metadata.SetID(id)
+
+			if preAuthorizedApplications != nil && len(*preAuthorizedApplications) > 0 {
+				update := msgraph.Application{
+					DirectoryObject: msgraph.DirectoryObject{
+						Id: &id.ApplicationId,
+					},
+					Api: &msgraph.ApplicationApi{
+						PreAuthorizedApplications: preAuthorizedApplications,
+					},
+				}
+
+				if _, err = client.Update(ctx, update); err != nil {
+					return fmt.Errorf("setting pre-authorized applications for %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationFromManifestResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Applications.ApplicationsClient
+			client.BaseClient.DisableRetries = true
+			defer func() { client.BaseClient.DisableRetries = false }()
+
+			id, err := parse.ParseApplicationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state ApplicationFromManifestModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			result, status, err := client.Get(ctx, id.ApplicationId, odata.Query{})
+			if err != nil {
+				if status == http.StatusNotFound {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if result == nil {
+				return fmt.Errorf("retrieving %s: result was nil", id)
+			}
+
+			state.ClientId = pointer.From(result.AppId)
+			state.DisplayName = pointer.From(result.DisplayName)
+			state.ObjectId = pointer.From(result.ID())
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationFromManifestResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Applications.ApplicationsClient
+
+			id, err := parse.ParseApplicationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationFromManifestModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if !metadata.ResourceData.HasChange("manifest_json") {
+				return nil
+			}
+
+			properties, _, err := parseApplicationManifest(model.ManifestJson)
+			if err != nil {
+				return err
+			}
+
+			properties.DirectoryObject = msgraph.DirectoryObject{
+				Id: &id.ApplicationId,
+			}
+
+			tf.LockByName(applicationResourceName, id.ApplicationId)
+			defer tf.UnlockByName(applicationResourceName, id.ApplicationId)
+
+			// App roles and permission scopes must be disabled before they can be removed or changed
+			if err = applicationDisableAppRoles(ctx, client, properties, properties.AppRoles); err != nil {
+				return fmt.Errorf("disabling app roles for %s: %+v", id, err)
+			}
+			if err = applicationDisableOauth2PermissionScopes(ctx, client, properties, properties.Api.OAuth2PermissionScopes); err != nil {
+				return fmt.Errorf("disabling OAuth2 permission scopes for %s: %+v", id, err)
+			}
+
+			if _, err = client.Update(ctx, *properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationFromManifestResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Applications.ApplicationsClient
+			client.BaseClient.DisableRetries = true
+			defer func() { client.BaseClient.DisableRetries = false }()
+
+			id, err := parse.ParseApplicationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, id.ApplicationId); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			// Wait for application object to be deleted
+			if err = helpers.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+				defer func() { client.BaseClient.DisableRetries = false }()
+				client.BaseClient.DisableRetries = true
+				if _, status, err := client.Get(ctx, id.ApplicationId, odata.Query{}); err != nil {
+					if status == http.StatusNotFound {
+						return pointer.To(false), nil
+					}
+					return nil, err
+				}
+				return pointer.To(true), nil
+			}); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %q", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// validateApplicationManifest validates that the manifest can be parsed, and returns warnings for any properties which
+// are unsupported or ignored
+func validateApplicationManifest(i interface{}, k string) (warnings []string, errors []error) {
+	if warnings, errors = validation.StringIsJSON(i, k); len(errors) > 0 {
+		return
+	}
+
+	_, manifestWarnings, err := parseApplicationManifest(i.(string))
+	for _, warning := range manifestWarnings {
+		warnings = append(warnings, fmt.Sprintf("%s: %s", k, warning))
+	}
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%s: %v", k, err))
+	}
+
+	return
+}
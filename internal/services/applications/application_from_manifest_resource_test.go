@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+)
+
+type ApplicationFromManifestResource struct{}
+
+func TestAccApplicationFromManifest_graph(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_from_manifest", "test")
+	r := ApplicationFromManifestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.graph(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("client_id").Exists(),
+				check.That(data.ResourceName).Key("object_id").Exists(),
+				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctest-AppFromManifest-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep("manifest_json"),
+	})
+}
+
+func TestAccApplicationFromManifest_legacy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_from_manifest", "test")
+	r := ApplicationFromManifestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.legacy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("client_id").Exists(),
+				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctest-AppFromManifest-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep("manifest_json"),
+	})
+}
+
+func TestAccApplicationFromManifest_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_from_manifest", "test")
+	r := ApplicationFromManifestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.legacy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.graph(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.legacy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (r ApplicationFromManifestResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Applications.ApplicationsClient
+	client.BaseClient.DisableRetries = true
+	defer func() { client.BaseClient.DisableRetries = false }()
+
+	id, err := parse.ParseApplicationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	app, status, err := client.Get(ctx, id.ApplicationId, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(app.ID() != nil && *app.ID() == id.ApplicationId), nil
+}
+
+func (ApplicationFromManifestResource) graph(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application_from_manifest" "test" {
+  manifest_json = jsonencode({
+    id             = "00000000-0000-0000-0000-000000000000"
+    appId          = "00000000-0000-0000-0000-000000000000"
+    displayName    = "acctest-AppFromManifest-%[1]d"
+    signInAudience = "AzureADMyOrg"
+    identifierUris = ["api://acctest-AppFromManifest-%[1]d"]
+    tags           = ["acctest"]
+
+    api = {
+      requestedAccessTokenVersion = 2
+      oauth2PermissionScopes = [
+        {
+          adminConsentDescription = "Administer the application"
+          adminConsentDisplayName = "Administer"
+          id                      = "%[2]s"
+          isEnabled               = true
+          type                    = "Admin"
+          value                   = "administer"
+        },
+      ]
+    }
+
+    appRoles = [
+      {
+        allowedMemberTypes = ["User"]
+        description        = "Admins can manage roles and perform all task actions"
+        displayName        = "Admin"
+        id                 = "%[3]s"
+        isEnabled          = true
+        value              = "admin"
+      },
+    ]
+
+    requiredResourceAccess = [
+      {
+        resourceAppId = "00000003-0000-0000-c000-000000000000"
+        resourceAccess = [
+          {
+            id   = "e1fe6dd8-ba31-4d61-89e7-88639da4683d"
+            type = "Scope"
+          },
+        ]
+      },
+    ]
+
+    web = {
+      homePageUrl  = "https://app.hashitown-%[1]d.com/"
+      redirectUris = ["https://app.hashitown-%[1]d.com/account"]
+      implicitGrantSettings = {
+        enableAccessTokenIssuance = false
+        enableIdTokenIssuance     = true
+      }
+    }
+  })
+}
+`, data.RandomInteger, data.UUID(), data.UUID())
+}
+
+func (ApplicationFromManifestResource) legacy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application_from_manifest" "test" {
+  manifest_json = jsonencode({
+    objectId                       = "00000000-0000-0000-0000-000000000000"
+    appId                          = "00000000-0000-0000-0000-000000000000"
+    name                           = "acctest-AppFromManifest-%[1]d"
+    accessTokenAcceptedVersion     = null
+    allowPublicClient              = true
+    groupMembershipClaims          = "SecurityGroup"
+    keyCredentials                 = []
+    oauth2AllowIdTokenImplicitFlow = true
+    oauth2AllowImplicitFlow        = false
+    passwordCredentials            = []
+    signInAudience                 = "AzureADMyOrg"
+
+    informationalUrls = {
+      privacy = "https://hashitown-%[1]d.com/privacy"
+      support = "https://support.hashitown-%[1]d.com"
+    }
+
+    oauth2Permissions = [
+      {
+        adminConsentDescription = "Access the application"
+        adminConsentDisplayName = "Access"
+        id                      = "%[2]s"
+        isEnabled               = true
+        lang                    = null
+        origin                  = "Application"
+        type                    = "User"
+        userConsentDescription  = "Access the application on your behalf"
+        userConsentDisplayName  = "Access"
+        value                   = "user_impersonation"
+      },
+    ]
+
+    replyUrlsWithType = [
+      {
+        url  = "https://app.hashitown-%[1]d.com/account"
+        type = "Web"
+      },
+      {
+        url  = "https://spa.hashitown-%[1]d.com/"
+        type = "Spa"
+      },
+      {
+        url  = "myapp://auth"
+        type = "InstalledClient"
+      },
+    ]
+
+    requiredResourceAccess = [
+      {
+        resourceAppId = "00000003-0000-0000-c000-000000000000"
+        resourceAccess = [
+          {
+            id   = "e1fe6dd8-ba31-4d61-89e7-88639da4683d"
+            type = "Scope"
+          },
+        ]
+      },
+    ]
+  })
+}
+`, data.RandomInteger, data.UUID())
+}
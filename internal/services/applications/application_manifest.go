@@ -0,0 +1,538 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// applicationManifest describes an application manifest as exported from the Azure Portal. Both the Microsoft Graph
+// manifest format and the legacy Azure AD Graph manifest format are supported, and legacy properties are translated
+// to their Microsoft Graph equivalents.
+type applicationManifest struct {
+	Api                        *applicationManifestApi                     `json:"api"`
+	AppRoles                   []applicationManifestAppRole                `json:"appRoles"`
+	Description                *string                                     `json:"description"`
+	DisplayName                *string                                     `json:"displayName"`
+	GroupMembershipClaims      *string                                     `json:"groupMembershipClaims"`
+	IdentifierUris             []string                                    `json:"identifierUris"`
+	Info                       *applicationManifestInfo                    `json:"info"`
+	IsFallbackPublicClient     *bool                                       `json:"isFallbackPublicClient"`
+	Notes                      *string                                     `json:"notes"`
+	OptionalClaims             *applicationManifestOptionalClaims          `json:"optionalClaims"`
+	PublicClient               *applicationManifestRedirectUris            `json:"publicClient"`
+	RequiredResourceAccess     []applicationManifestRequiredResourceAccess `json:"requiredResourceAccess"`
+	ServiceManagementReference *string                                     `json:"serviceManagementReference"`
+	SignInAudience             *string                                     `json:"signInAudience"`
+	Spa                        *applicationManifestRedirectUris            `json:"spa"`
+	Tags                       []string                                    `json:"tags"`
+	Web                        *applicationManifestWeb                     `json:"web"`
+
+	// Legacy Azure AD Graph properties
+	AcceptMappedClaims             *bool                                         `json:"acceptMappedClaims"`
+	AccessTokenAcceptedVersion     *int                                          `json:"accessTokenAcceptedVersion"`
+	AllowPublicClient              *bool                                         `json:"allowPublicClient"`
+	InformationalUrls              *applicationManifestInformationalUrls         `json:"informationalUrls"`
+	KnownClientApplications        []string                                      `json:"knownClientApplications"`
+	LogoutUrl                      *string                                       `json:"logoutUrl"`
+	Name                           *string                                       `json:"name"`
+	Oauth2AllowIdTokenImplicitFlow *bool                                         `json:"oauth2AllowIdTokenImplicitFlow"`
+	Oauth2AllowImplicitFlow        *bool                                         `json:"oauth2AllowImplicitFlow"`
+	Oauth2Permissions              []applicationManifestPermissionScope          `json:"oauth2Permissions"`
+	PreAuthorizedApplications      []applicationManifestPreAuthorizedApplication `json:"preAuthorizedApplications"`
+	ReplyUrlsWithType              []applicationManifestReplyUrl                 `json:"replyUrlsWithType"`
+	SignInUrl                      *string                                       `json:"signInUrl"`
+}
+
+type applicationManifestApi struct {
+	AcceptMappedClaims          *bool                                         `json:"acceptMappedClaims"`
+	KnownClientApplications     []string                                      `json:"knownClientApplications"`
+	OAuth2PermissionScopes      []applicationManifestPermissionScope          `json:"oauth2PermissionScopes"`
+	PreAuthorizedApplications   []applicationManifestPreAuthorizedApplication `json:"preAuthorizedApplications"`
+	RequestedAccessTokenVersion *int                                          `json:"requestedAccessTokenVersion"`
+}
+
+type applicationManifestAppRole struct {
+	AllowedMemberTypes []string `json:"allowedMemberTypes"`
+	Description        string   `json:"description"`
+	DisplayName        string   `json:"displayName"`
+	ID                 string   `json:"id"`
+	IsEnabled          bool     `json:"isEnabled"`
+	Value              *string  `json:"value"`
+}
+
+type applicationManifestInfo struct {
+	MarketingUrl        *string `json:"marketingUrl"`
+	PrivacyStatementUrl *string `json:"privacyStatementUrl"`
+	SupportUrl          *string `json:"supportUrl"`
+	TermsOfServiceUrl   *string `json:"termsOfServiceUrl"`
+}
+
+type applicationManifestInformationalUrls struct {
+	Marketing      *string `json:"marketing"`
+	Privacy        *string `json:"privacy"`
+	Support        *string `json:"support"`
+	TermsOfService *string `json:"termsOfService"`
+}
+
+type applicationManifestOptionalClaim struct {
+	AdditionalProperties []string `json:"additionalProperties"`
+	Essential            bool     `json:"essential"`
+	Name                 string   `json:"name"`
+	Source               *string  `json:"source"`
+}
+
+type applicationManifestOptionalClaims struct {
+	AccessToken []applicationManifestOptionalClaim `json:"accessToken"`
+	IdToken     []applicationManifestOptionalClaim `json:"idToken"`
+	Saml2Token  []applicationManifestOptionalClaim `json:"saml2Token"`
+}
+
+type applicationManifestPermissionScope struct {
+	AdminConsentDescription string  `json:"adminConsentDescription"`
+	AdminConsentDisplayName string  `json:"adminConsentDisplayName"`
+	ID                      string  `json:"id"`
+	IsEnabled               bool    `json:"isEnabled"`
+	Type                    string  `json:"type"`
+	UserConsentDescription  *string `json:"userConsentDescription"`
+	UserConsentDisplayName  *string `json:"userConsentDisplayName"`
+	Value                   string  `json:"value"`
+}
+
+type applicationManifestPreAuthorizedApplication struct {
+	AppId                  string   `json:"appId"`
+	DelegatedPermissionIds []string `json:"delegatedPermissionIds"`
+	PermissionIds          []string `json:"permissionIds"`
+}
+
+type applicationManifestRedirectUris struct {
+	RedirectUris []string `json:"redirectUris"`
+}
+
+type applicationManifestReplyUrl struct {
+	Type string `json:"type"`
+	Url  string `json:"url"`
+}
+
+type applicationManifestRequiredResourceAccess struct {
+	ResourceAccess []struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+	} `json:"resourceAccess"`
+	ResourceAppId string `json:"resourceAppId"`
+}
+
+type applicationManifestWeb struct {
+	HomePageUrl           *string `json:"homePageUrl"`
+	ImplicitGrantSettings *struct {
+		EnableAccessTokenIssuance bool `json:"enableAccessTokenIssuance"`
+		EnableIdTokenIssuance     bool `json:"enableIdTokenIssuance"`
+	} `json:"implicitGrantSettings"`
+	LogoutUrl    *string  `json:"logoutUrl"`
+	RedirectUris []string `json:"redirectUris"`
+}
+
+// applicationManifestLegacyProperties maps legacy Azure AD Graph manifest properties to their Microsoft Graph equivalents
+var applicationManifestLegacyProperties = map[string]string{
+	"acceptMappedClaims":             "api.acceptMappedClaims",
+	"accessTokenAcceptedVersion":     "api.requestedAccessTokenVersion",
+	"allowPublicClient":              "isFallbackPublicClient",
+	"informationalUrls":              "info",
+	"knownClientApplications":        "api.knownClientApplications",
+	"logoutUrl":                      "web.logoutUrl",
+	"name":                           "displayName",
+	"oauth2AllowIdTokenImplicitFlow": "web.implicitGrantSettings.enableIdTokenIssuance",
+	"oauth2AllowImplicitFlow":        "web.implicitGrantSettings.enableAccessTokenIssuance",
+	"oauth2Permissions":              "api.oauth2PermissionScopes",
+	"preAuthorizedApplications":      "api.preAuthorizedApplications",
+	"replyUrlsWithType":              "web.redirectUris, spa.redirectUris and publicClient.redirectUris",
+	"signInUrl":                      "web.homePageUrl",
+}
+
+// applicationManifestSupportedProperties are the Microsoft Graph manifest properties which are supported
+var applicationManifestSupportedProperties = []string{
+	"api",
+	"appRoles",
+	"description",
+	"displayName",
+	"groupMembershipClaims",
+	"identifierUris",
+	"info",
+	"isFallbackPublicClient",
+	"notes",
+	"optionalClaims",
+	"publicClient",
+	"requiredResourceAccess",
+	"serviceManagementReference",
+	"signInAudience",
+	"spa",
+	"tags",
+	"web",
+}
+
+// applicationManifestReadOnlyProperties are generated by the service and are ignored without a warning, since they are
+// present in every exported manifest
+var applicationManifestReadOnlyProperties = []string{
+	"appId",
+	"applicationTemplateId",
+	"certification",
+	"createdDateTime",
+	"deletedDateTime",
+	"disabledByMicrosoftStatus",
+	"id",
+	"objectId",
+	"publisherDomain",
+	"verifiedPublisher",
+}
+
+// applicationManifestCredentialProperties are ignored, since credentials must be managed with separate resources
+var applicationManifestCredentialProperties = []string{
+	"keyCredentials",
+	"passwordCredentials",
+}
+
+// parseApplicationManifest parses an application manifest and returns the resulting Application, along with warnings
+// for any properties which are unsupported or ignored.
+func parseApplicationManifest(input string) (*msgraph.Application, []string, error) {
+	warnings := make([]string, 0)
+
+	var properties map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(input), &properties); err != nil {
+		return nil, nil, fmt.Errorf("parsing manifest: %v", err)
+	}
+
+	var manifest applicationManifest
+	if err := json.Unmarshal([]byte(input), &manifest); err != nil {
+		return nil, nil, fmt.Errorf("parsing manifest: %v", err)
+	}
+
+	// Sort the property names so that warnings are returned in a consistent order
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if applicationManifestValueIsEmpty(properties[name]) || strings.HasPrefix(name, "@odata.") {
+			continue
+		}
+		switch {
+		case slices.Contains(applicationManifestSupportedProperties, name), slices.Contains(applicationManifestReadOnlyProperties, name):
+		case slices.Contains(applicationManifestCredentialProperties, name):
+			warnings = append(warnings, fmt.Sprintf("manifest property %q is ignored, credentials should be managed with the `azuread_application_certificate` and `azuread_application_password` resources", name))
+		default:
+			if equivalent, ok := applicationManifestLegacyProperties[name]; ok {
+				if applicationManifestLegacyPropertyIsOverridden(name, manifest) {
+					warnings = append(warnings, fmt.Sprintf("legacy manifest property %q is ignored because %s is also specified", name, equivalent))
+				}
+				continue
+			}
+			warnings = append(warnings, fmt.Sprintf("manifest property %q is not supported and will be ignored", name))
+		}
+	}
+
+	displayName := manifest.DisplayName
+	if displayName == nil {
+		displayName = manifest.Name
+	}
+	if pointer.From(displayName) == "" {
+		return nil, warnings, errors.New("manifest must specify a `displayName` or `name`")
+	}
+
+	// API
+	api := map[string]interface{}{
+		"known_client_applications":      pluginsdk.NewSet(pluginsdk.HashString, tf.FlattenStringSlice(manifest.KnownClientApplications)),
+		"mapped_claims_enabled":          pointer.From(manifest.AcceptMappedClaims),
+		"oauth2_permission_scope":        pluginsdk.NewSet(applicationManifestHashId, flattenApplicationManifestPermissionScopes(manifest.Oauth2Permissions)),
+		"requested_access_token_version": 1,
+	}
+	if manifest.AccessTokenAcceptedVersion != nil {
+		api["requested_access_token_version"] = *manifest.AccessTokenAcceptedVersion
+	}
+	preAuthorizedApplications := manifest.PreAuthorizedApplications
+	if manifest.Api != nil {
+		if manifest.Api.AcceptMappedClaims != nil {
+			api["mapped_claims_enabled"] = *manifest.Api.AcceptMappedClaims
+		}
+		if manifest.Api.KnownClientApplications != nil {
+			api["known_client_applications"] = pluginsdk.NewSet(pluginsdk.HashString, tf.FlattenStringSlice(manifest.Api.KnownClientApplications))
+		}
+		if manifest.Api.OAuth2PermissionScopes != nil {
+			api["oauth2_permission_scope"] = pluginsdk.NewSet(applicationManifestHashId, flattenApplicationManifestPermissionScopes(manifest.Api.OAuth2PermissionScopes))
+		}
+		if manifest.Api.PreAuthorizedApplications != nil {
+			preAuthorizedApplications = manifest.Api.PreAuthorizedApplications
+		}
+		if manifest.Api.RequestedAccessTokenVersion != nil {
+			api["requested_access_token_version"] = *manifest.Api.RequestedAccessTokenVersion
+		}
+	}
+
+	// App roles
+	appRoles := make([]interface{}, 0)
+	for _, role := range manifest.AppRoles {
+		appRoles = append(appRoles, map[string]interface{}{
+			"allowed_member_types": pluginsdk.NewSet(pluginsdk.HashString, tf.FlattenStringSlice(role.AllowedMemberTypes)),
+			"description":          role.Description,
+			"display_name":         role.DisplayName,
+			"enabled":              role.IsEnabled,
+			"id":                   role.ID,
+			"value":                pointer.From(role.Value),
+		})
+	}
+
+	if err := applicationValidateRolesScopes(appRoles, api["oauth2_permission_scope"].(*pluginsdk.Set).List()); err != nil {
+		return nil, warnings, err
+	}
+
+	// Group membership claims
+	groupMembershipClaims := make([]interface{}, 0)
+	if manifest.GroupMembershipClaims != nil {
+		for _, claim := range strings.Split(*manifest.GroupMembershipClaims, ",") {
+			if claim = strings.TrimSpace(claim); claim != "" {
+				groupMembershipClaims = append(groupMembershipClaims, claim)
+			}
+		}
+	}
+
+	// Optional claims
+	optionalClaims := make([]interface{}, 0)
+	if manifest.OptionalClaims != nil {
+		optionalClaims = append(optionalClaims, map[string]interface{}{
+			"access_token": flattenApplicationManifestOptionalClaims(manifest.OptionalClaims.AccessToken),
+			"id_token":     flattenApplicationManifestOptionalClaims(manifest.OptionalClaims.IdToken),
+			"saml2_token":  flattenApplicationManifestOptionalClaims(manifest.OptionalClaims.Saml2Token),
+		})
+	}
+
+	// Required resource access
+	requiredResourceAccess := make([]interface{}, 0)
+	for _, rra := range manifest.RequiredResourceAccess {
+		resourceAccess := make([]interface{}, 0)
+		for _, ra := range rra.ResourceAccess {
+			resourceAccess = append(resourceAccess, map[string]interface{}{
+				"id":   ra.ID,
+				"type": ra.Type,
+			})
+		}
+		requiredResourceAccess = append(requiredResourceAccess, map[string]interface{}{
+			"resource_access": resourceAccess,
+			"resource_app_id": rra.ResourceAppId,
+		})
+	}
+
+	// Redirect URIs for each platform, which are combined into a single list in the legacy manifest format
+	publicClientRedirectUris := make([]string, 0)
+	spaRedirectUris := make([]string, 0)
+	webRedirectUris := make([]string, 0)
+	for _, replyUrl := range manifest.ReplyUrlsWithType {
+		switch strings.ToLower(replyUrl.Type) {
+		case "installedclient":
+			publicClientRedirectUris = append(publicClientRedirectUris, replyUrl.Url)
+		case "spa":
+			spaRedirectUris = append(spaRedirectUris, replyUrl.Url)
+		case "web":
+			webRedirectUris = append(webRedirectUris, replyUrl.Url)
+		default:
+			warnings = append(warnings, fmt.Sprintf("reply URL %q with unsupported type %q will be ignored", replyUrl.Url, replyUrl.Type))
+		}
+	}
+	if manifest.PublicClient != nil && manifest.PublicClient.RedirectUris != nil {
+		publicClientRedirectUris = manifest.PublicClient.RedirectUris
+	}
+	if manifest.Spa != nil && manifest.Spa.RedirectUris != nil {
+		spaRedirectUris = manifest.Spa.RedirectUris
+	}
+
+	// Web
+	web := map[string]interface{}{
+		"homepage_url": pointer.From(manifest.SignInUrl),
+		"implicit_grant": []interface{}{
+			map[string]interface{}{
+				"access_token_issuance_enabled": pointer.From(manifest.Oauth2AllowImplicitFlow),
+				"id_token_issuance_enabled":     pointer.From(manifest.Oauth2AllowIdTokenImplicitFlow),
+			},
+		},
+		"logout_url":    pointer.From(manifest.LogoutUrl),
+		"redirect_uris": pluginsdk.NewSet(pluginsdk.HashString, tf.FlattenStringSlice(webRedirectUris)),
+	}
+	if manifest.Web != nil {
+		if manifest.Web.HomePageUrl != nil {
+			web["homepage_url"] = *manifest.Web.HomePageUrl
+		}
+		if v := manifest.Web.ImplicitGrantSettings; v != nil {
+			web["implicit_grant"] = []interface{}{
+				map[string]interface{}{
+					"access_token_issuance_enabled": v.EnableAccessTokenIssuance,
+					"id_token_issuance_enabled":     v.EnableIdTokenIssuance,
+				},
+			}
+		}
+		if manifest.Web.LogoutUrl != nil {
+			web["logout_url"] = *manifest.Web.LogoutUrl
+		}
+		if manifest.Web.RedirectUris != nil {
+			web["redirect_uris"] = pluginsdk.NewSet(pluginsdk.HashString, tf.FlattenStringSlice(manifest.Web.RedirectUris))
+		}
+	}
+
+	// Informational URLs
+	info := &msgraph.InformationalUrl{
+		MarketingUrl:        tf.NullableString(""),
+		PrivacyStatementUrl: tf.NullableString(""),
+		SupportUrl:          tf.NullableString(""),
+		TermsOfServiceUrl:   tf.NullableString(""),
+	}
+	if v := manifest.InformationalUrls; v != nil {
+		info.MarketingUrl = tf.NullableString(pointer.From(v.Marketing))
+		info.PrivacyStatementUrl = tf.NullableString(pointer.From(v.Privacy))
+		info.SupportUrl = tf.NullableString(pointer.From(v.Support))
+		info.TermsOfServiceUrl = tf.NullableString(pointer.From(v.TermsOfService))
+	}
+	if v := manifest.Info; v != nil {
+		info.MarketingUrl = tf.NullableString(pointer.From(v.MarketingUrl))
+		info.PrivacyStatementUrl = tf.NullableString(pointer.From(v.PrivacyStatementUrl))
+		info.SupportUrl = tf.NullableString(pointer.From(v.SupportUrl))
+		info.TermsOfServiceUrl = tf.NullableString(pointer.From(v.TermsOfServiceUrl))
+	}
+
+	isFallbackPublicClient := manifest.AllowPublicClient
+	if manifest.IsFallbackPublicClient != nil {
+		isFallbackPublicClient = manifest.IsFallbackPublicClient
+	}
+
+	identifierUris := make([]string, 0)
+	if manifest.IdentifierUris != nil {
+		identifierUris = manifest.IdentifierUris
+	}
+
+	tags := make([]string, 0)
+	if manifest.Tags != nil {
+		tags = manifest.Tags
+	}
+
+	application := msgraph.Application{
+		Api:                        expandApplicationApi([]interface{}{api}),
+		AppRoles:                   expandApplicationAppRoles(appRoles),
+		Description:                tf.NullableString(pointer.From(manifest.Description)),
+		DisplayName:                displayName,
+		GroupMembershipClaims:      expandApplicationGroupMembershipClaims(groupMembershipClaims),
+		IdentifierUris:             &identifierUris,
+		Info:                       info,
+		IsFallbackPublicClient:     pointer.To(pointer.From(isFallbackPublicClient)),
+		Notes:                      tf.NullableString(pointer.From(manifest.Notes)),
+		OptionalClaims:             expandApplicationOptionalClaims(optionalClaims),
+		PublicClient:               expandApplicationPublicClient([]interface{}{map[string]interface{}{"redirect_uris": pluginsdk.NewSet(pluginsdk.HashString, tf.FlattenStringSlice(publicClientRedirectUris))}}),
+		RequiredResourceAccess:     expandApplicationRequiredResourceAccess(requiredResourceAccess),
+		ServiceManagementReference: tf.NullableString(pointer.From(manifest.ServiceManagementReference)),
+		SignInAudience:             manifest.SignInAudience,
+		Spa:                        expandApplicationSpa([]interface{}{map[string]interface{}{"redirect_uris": pluginsdk.NewSet(pluginsdk.HashString, tf.FlattenStringSlice(spaRedirectUris))}}),
+		Tags:                       &tags,
+		Web:                        expandApplicationWeb([]interface{}{web}),
+	}
+
+	application.Api.PreAuthorizedApplications = &[]msgraph.ApiPreAuthorizedApplication{}
+	for _, preAuthorizedApplication := range preAuthorizedApplications {
+		permissionIds := preAuthorizedApplication.DelegatedPermissionIds
+		if permissionIds == nil {
+			permissionIds = preAuthorizedApplication.PermissionIds
+		}
+		*application.Api.PreAuthorizedApplications = append(*application.Api.PreAuthorizedApplications, msgraph.ApiPreAuthorizedApplication{
+			AppId:         pointer.To(preAuthorizedApplication.AppId),
+			PermissionIds: pointer.To(permissionIds),
+		})
+	}
+
+	return &application, warnings, nil
+}
+
+// applicationManifestLegacyPropertyIsOverridden returns whether a legacy manifest property is ignored because its
+// Microsoft Graph equivalent is also specified
+func applicationManifestLegacyPropertyIsOverridden(name string, manifest applicationManifest) bool {
+	switch name {
+	case "acceptMappedClaims":
+		return manifest.Api != nil && manifest.Api.AcceptMappedClaims != nil
+	case "accessTokenAcceptedVersion":
+		return manifest.Api != nil && manifest.Api.RequestedAccessTokenVersion != nil
+	case "allowPublicClient":
+		return manifest.IsFallbackPublicClient != nil
+	case "informationalUrls":
+		return manifest.Info != nil
+	case "knownClientApplications":
+		return manifest.Api != nil && manifest.Api.KnownClientApplications != nil
+	case "logoutUrl":
+		return manifest.Web != nil && manifest.Web.LogoutUrl != nil
+	case "name":
+		return manifest.DisplayName != nil
+	case "oauth2AllowIdTokenImplicitFlow", "oauth2AllowImplicitFlow":
+		return manifest.Web != nil && manifest.Web.ImplicitGrantSettings != nil
+	case "oauth2Permissions":
+		return manifest.Api != nil && manifest.Api.OAuth2PermissionScopes != nil
+	case "preAuthorizedApplications":
+		return manifest.Api != nil && manifest.Api.PreAuthorizedApplications != nil
+	case "replyUrlsWithType":
+		return (manifest.Web != nil && manifest.Web.RedirectUris != nil) ||
+			(manifest.Spa != nil && manifest.Spa.RedirectUris != nil) ||
+			(manifest.PublicClient != nil && manifest.PublicClient.RedirectUris != nil)
+	case "signInUrl":
+		return manifest.Web != nil && manifest.Web.HomePageUrl != nil
+	}
+	return false
+}
+
+// applicationManifestValueIsEmpty returns whether a manifest property value is null, false, or an empty string, list
+// or object
+func applicationManifestValueIsEmpty(in json.RawMessage) bool {
+	switch string(bytes.TrimSpace(in)) {
+	case "", "null", "false", `""`, "[]", "{}":
+		return true
+	}
+	return false
+}
+
+func applicationManifestHashId(v interface{}) int {
+	return pluginsdk.HashString(v.(map[string]interface{})["id"])
+}
+
+func flattenApplicationManifestOptionalClaims(in []applicationManifestOptionalClaim) []interface{} {
+	result := make([]interface{}, 0)
+	for _, claim := range in {
+		result = append(result, map[string]interface{}{
+			"additional_properties": tf.FlattenStringSlice(claim.AdditionalProperties),
+			"essential":             claim.Essential,
+			"name":                  claim.Name,
+			"source":                pointer.From(claim.Source),
+		})
+	}
+	return result
+}
+
+func flattenApplicationManifestPermissionScopes(in []applicationManifestPermissionScope) []interface{} {
+	result := make([]interface{}, 0)
+	for _, scope := range in {
+		result = append(result, map[string]interface{}{
+			"admin_consent_description":  scope.AdminConsentDescription,
+			"admin_consent_display_name": scope.AdminConsentDisplayName,
+			"enabled":                    scope.IsEnabled,
+			"id":                         scope.ID,
+			"type":                       scope.Type,
+			"user_consent_description":   pointer.From(scope.UserConsentDescription),
+			"user_consent_display_name":  pointer.From(scope.UserConsentDisplayName),
+			"value":                      scope.Value,
+		})
+	}
+	return result
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+func TestParseApplicationManifest(t *testing.T) {
+	cases := []struct {
+		TestName string
+		Manifest string
+		Warnings []string
+		Error    string
+		Check    func(*testing.T, *msgraph.Application)
+	}{
+		{
+			TestName: "LegacyProperties",
+			Manifest: `{
+				"name": "legacy",
+				"acceptMappedClaims": true,
+				"accessTokenAcceptedVersion": 2,
+				"allowPublicClient": true,
+				"informationalUrls": {"marketing": "https://example.com/marketing"},
+				"knownClientApplications": ["00000000-0000-0000-0000-000000000001"],
+				"logoutUrl": "https://example.com/logout",
+				"oauth2AllowIdTokenImplicitFlow": true,
+				"oauth2Permissions": [],
+				"preAuthorizedApplications": [{"appId": "00000000-0000-0000-0000-000000000002", "permissionIds": ["00000000-0000-0000-0000-000000000003"]}],
+				"replyUrlsWithType": [
+					{"url": "https://example.com/web", "type": "Web"},
+					{"url": "https://example.com/spa", "type": "Spa"},
+					{"url": "http://localhost", "type": "InstalledClient"}
+				],
+				"signInUrl": "https://example.com"
+			}`,
+			Warnings: []string{},
+			Check: func(t *testing.T, application *msgraph.Application) {
+				applicationManifestExpectString(t, "displayName", application.DisplayName, "legacy")
+				applicationManifestExpect(t, "api.acceptMappedClaims", pointer.From(application.Api.AcceptMappedClaims), true)
+				applicationManifestExpect(t, "api.requestedAccessTokenVersion", pointer.From(application.Api.RequestedAccessTokenVersion), int32(2))
+				applicationManifestExpectStrings(t, "api.knownClientApplications", application.Api.KnownClientApplications, "00000000-0000-0000-0000-000000000001")
+				applicationManifestExpect(t, "isFallbackPublicClient", pointer.From(application.IsFallbackPublicClient), true)
+				applicationManifestExpectNullableString(t, "info.marketingUrl", application.Info.MarketingUrl, "https://example.com/marketing")
+				applicationManifestExpectNullableString(t, "web.homePageUrl", application.Web.HomePageUrl, "https://example.com")
+				applicationManifestExpectNullableString(t, "web.logoutUrl", application.Web.LogoutUrl, "https://example.com/logout")
+				applicationManifestExpect(t, "web.implicitGrantSettings.enableIdTokenIssuance", pointer.From(application.Web.ImplicitGrantSettings.EnableIdTokenIssuance), true)
+				applicationManifestExpect(t, "web.implicitGrantSettings.enableAccessTokenIssuance", pointer.From(application.Web.ImplicitGrantSettings.EnableAccessTokenIssuance), false)
+				applicationManifestExpectStrings(t, "web.redirectUris", application.Web.RedirectUris, "https://example.com/web")
+				applicationManifestExpectStrings(t, "spa.redirectUris", application.Spa.RedirectUris, "https://example.com/spa")
+				applicationManifestExpectStrings(t, "publicClient.redirectUris", application.PublicClient.RedirectUris, "http://localhost")
+
+				preAuthorizedApplications := pointer.From(application.Api.PreAuthorizedApplications)
+				if len(preAuthorizedApplications) != 1 {
+					t.Fatalf("expected 1 pre-authorized application, got %d", len(preAuthorizedApplications))
+				}
+				applicationManifestExpectString(t, "api.preAuthorizedApplications.appId", preAuthorizedApplications[0].AppId, "00000000-0000-0000-0000-000000000002")
+				applicationManifestExpectStrings(t, "api.preAuthorizedApplications.permissionIds", preAuthorizedApplications[0].PermissionIds, "00000000-0000-0000-0000-000000000003")
+			},
+		},
+		{
+			TestName: "LegacyPropertiesOverridden",
+			Manifest: `{
+				"displayName": "graph",
+				"name": "legacy",
+				"accessTokenAcceptedVersion": 1,
+				"api": {"requestedAccessTokenVersion": 2},
+				"signInUrl": "https://legacy.example.com",
+				"web": {"homePageUrl": "https://graph.example.com"}
+			}`,
+			Warnings: []string{
+				`legacy manifest property "accessTokenAcceptedVersion" is ignored because api.requestedAccessTokenVersion is also specified`,
+				`legacy manifest property "name" is ignored because displayName is also specified`,
+				`legacy manifest property "signInUrl" is ignored because web.homePageUrl is also specified`,
+			},
+			Check: func(t *testing.T, application *msgraph.Application) {
+				applicationManifestExpectString(t, "displayName", application.DisplayName, "graph")
+				applicationManifestExpect(t, "api.requestedAccessTokenVersion", pointer.From(application.Api.RequestedAccessTokenVersion), int32(2))
+				applicationManifestExpectNullableString(t, "web.homePageUrl", application.Web.HomePageUrl, "https://graph.example.com")
+			},
+		},
+		{
+			TestName: "UnsupportedProperties",
+			Manifest: `{
+				"@odata.context": "https://graph.microsoft.com/v1.0/$metadata#applications/$entity",
+				"displayName": "unsupported",
+				"id": "00000000-0000-0000-0000-000000000004",
+				"keyCredentials": [{"keyId": "00000000-0000-0000-0000-000000000005"}],
+				"parentalControlSettings": {"legalAgeGroupRule": "Allow"},
+				"replyUrlsWithType": [{"url": "ms-app://example", "type": "Unknown"}],
+				"samlMetadataUrl": null
+			}`,
+			Warnings: []string{
+				`manifest property "keyCredentials" is ignored, credentials should be managed with the ` + "`azuread_application_certificate` and `azuread_application_password`" + ` resources`,
+				`manifest property "parentalControlSettings" is not supported and will be ignored`,
+				`reply URL "ms-app://example" with unsupported type "Unknown" will be ignored`,
+			},
+			Check: func(t *testing.T, application *msgraph.Application) {
+				applicationManifestExpectString(t, "displayName", application.DisplayName, "unsupported")
+				applicationManifestExpectStrings(t, "web.redirectUris", application.Web.RedirectUris)
+			},
+		},
+		{
+			TestName: "MissingDisplayName",
+			Manifest: `{"description": "no name", "unknownProperty": "value"}`,
+			Warnings: []string{
+				`manifest property "unknownProperty" is not supported and will be ignored`,
+			},
+			Error: "manifest must specify a `displayName` or `name`",
+		},
+		{
+			TestName: "EmptyDisplayName",
+			Manifest: `{"displayName": ""}`,
+			Warnings: []string{},
+			Error:    "manifest must specify a `displayName` or `name`",
+		},
+		{
+			TestName: "InvalidJson",
+			Manifest: `{"displayName": `,
+			Error:    "parsing manifest",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			application, warnings, err := parseApplicationManifest(tc.Manifest)
+
+			if tc.Error != "" {
+				if err == nil {
+					t.Fatalf("expected an error containing %q, got nil", tc.Error)
+				}
+				if !strings.Contains(err.Error(), tc.Error) {
+					t.Fatalf("expected an error containing %q, got %q", tc.Error, err.Error())
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			if tc.Warnings != nil && !slices.Equal(warnings, tc.Warnings) {
+				t.Fatalf("expected warnings %q, got %q", tc.Warnings, warnings)
+			}
+
+			if tc.Check != nil {
+				tc.Check(t, application)
+			}
+		})
+	}
+}
+
+func applicationManifestExpect[T comparable](t *testing.T, property string, actual, expected T) {
+	t.Helper()
+	if actual != expected {
+		t.Errorf("expected %s to be %v, got %v", property, expected, actual)
+	}
+}
+
+func applicationManifestExpectString(t *testing.T, property string, actual *string, expected string) {
+	t.Helper()
+	applicationManifestExpect(t, property, pointer.From(actual), expected)
+}
+
+func applicationManifestExpectNullableString(t *testing.T, property string, actual *msgraph.StringNullWhenEmpty, expected string) {
+	t.Helper()
+	applicationManifestExpect(t, property, string(pointer.From(actual)), expected)
+}
+
+func applicationManifestExpectStrings(t *testing.T, property string, actual *[]string, expected ...string) {
+	t.Helper()
+	if !slices.Equal(pointer.From(actual), expected) && (len(pointer.From(actual)) > 0 || len(expected) > 0) {
+		t.Errorf("expected %s to be %q, got %q", property, expected, pointer.From(actual))
+	}
+}
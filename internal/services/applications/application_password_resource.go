@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/base64"
 	"errors"
+	"fmt"
 	"log"
 	"net/http"
 	"strings"
@@ -22,18 +23,22 @@ import (
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+	"github.com/manicminer/hamilton/msgraph"
 )
 
 func applicationPasswordResource() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		CreateContext: applicationPasswordResourceCreate,
 		ReadContext:   applicationPasswordResourceRead,
+		UpdateContext: applicationPasswordResourceUpdate,
 		DeleteContext: applicationPasswordResourceDelete,
 
+		CustomizeDiff: helpers.PasswordRotationCustomizeDiff,
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(15 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
-			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(15 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
 		},
 
@@ -128,6 +133,29 @@ func applicationPasswordResource() *pluginsdk.Resource {
 				},
 			},
 
+			"rotation_days": {
+				Description:   "The number of days after which the password is rotated. A new password is created when a plan is run after this period, and the previous password is retained for the duration of `rotation_overlap`",
+				Type:          pluginsdk.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"end_date", "start_date"},
+				ValidateFunc:  validation.IntAtLeast(1),
+			},
+
+			"rotate_before_expiry": {
+				Description:   "A duration before the expiry of the password at which it is rotated, for example `720h` (30 days). A new password is created when a plan is run within this period, and the previous password is retained for the duration of `rotation_overlap`",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"end_date", "start_date"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
+			"rotation_overlap": {
+				Description:  "The duration for which the previous password remains valid after rotation, for example `48h`. Defaults to `24h`",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
 			"key_id": {
 				Description: "A UUID used to uniquely identify this password credential",
 				Type:        pluginsdk.TypeString,
@@ -140,6 +168,19 @@ func applicationPasswordResource() *pluginsdk.Resource {
 				Computed:    true,
 				Sensitive:   true,
 			},
+
+			"previous_key_id": {
+				Description: "The key ID of the previous password credential, which is retained for the duration of `rotation_overlap` following rotation",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"previous_value": {
+				Description: "The previous password for this application, which is retained for the duration of `rotation_overlap` following rotation",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
 		},
 	}
 }
@@ -193,56 +234,71 @@ func applicationPasswordResourceCreate(ctx context.Context, d *pluginsdk.Resourc
 		return tf.ErrorDiagF(errors.New("nil application or application with nil ID was returned"), "API error retrieving application with object ID %q", applicationId.ApplicationId)
 	}
 
-	newCredential, _, err := client.AddPassword(ctx, *app.ID(), *credential)
+	newCredential, err := applicationPasswordResourceAddPassword(ctx, client, *app.ID(), *credential)
 	if err != nil {
-		return tf.ErrorDiagF(err, "Adding password for application with object ID %q", *app.ID())
-	}
-	if newCredential == nil {
-		return tf.ErrorDiagF(errors.New("nil credential received when adding password"), "API error adding password for application with object ID %q", *app.ID())
-	}
-	if newCredential.KeyId == nil {
-		return tf.ErrorDiagF(errors.New("nil or empty keyId received"), "API error adding password for application with object ID %q", *app.ID())
-	}
-	if newCredential.SecretText == nil || len(*newCredential.SecretText) == 0 {
-		return tf.ErrorDiagF(errors.New("nil or empty password received"), "API error adding password for application with object ID %q", *app.ID())
+		return helpers.CredentialErrorDiag(err, helpers.CredentialTypePassword, "Adding password for application with object ID %q", *app.ID())
 	}
 
 	id := parse.NewCredentialID(*app.ID(), "password", *newCredential.KeyId)
 
-	// Wait for the credential to appear in the application manifest, this can take several minutes
-	timeout, _ := ctx.Deadline()
-	polledForCredential, err := (&pluginsdk.StateChangeConf{ //nolint:staticcheck
-		Pending:                   []string{"Waiting"},
-		Target:                    []string{"Done"},
-		Timeout:                   time.Until(timeout),
-		MinTimeout:                1 * time.Second,
-		ContinuousTargetOccurence: 5,
-		Refresh: func() (interface{}, string, error) {
-			app, _, err := client.Get(ctx, id.ObjectId, odata.Query{})
-			if err != nil {
-				return nil, "Error", err
-			}
+	d.SetId(id.String())
+	d.Set("value", newCredential.SecretText)
 
-			if app.PasswordCredentials != nil {
-				for _, cred := range *app.PasswordCredentials {
-					if cred.KeyId != nil && strings.EqualFold(*cred.KeyId, id.KeyId) {
-						return &cred, "Done", nil
-					}
-				}
-			}
+	return applicationPasswordResourceRead(ctx, d, meta)
+}
 
-			return nil, "Waiting", nil
-		},
-	}).WaitForStateContext(ctx)
+func applicationPasswordResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics { //nolint
+	client := meta.(*clients.Client).Applications.ApplicationsClientBeta
 
+	id, err := parse.PasswordID(d.Id())
 	if err != nil {
-		return tf.ErrorDiagF(err, "Waiting for password credential for application with object ID %q", id.ObjectId)
-	} else if polledForCredential == nil {
-		return tf.ErrorDiagF(errors.New("password credential not found in application manifest"), "Waiting for password credential for application with object ID %q", id.ObjectId)
+		return tf.ErrorDiagPathF(err, "id", "Parsing password credential with ID %q", d.Id())
 	}
 
-	d.SetId(id.String())
-	d.Set("value", newCredential.SecretText)
+	tf.LockByName(applicationResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
+
+	oldPreviousKeyId, newPreviousKeyId := d.GetChange("previous_key_id")
+
+	if d.HasChange("key_id") {
+		// The password is due for rotation, so add a new password and retain the current one as the previous password
+		credential, err := helpers.PasswordCredentialForRotation(d)
+		if err != nil {
+			attr := ""
+			if kerr, ok := err.(helpers.CredentialError); ok {
+				attr = kerr.Attr()
+			}
+			return tf.ErrorDiagPathF(err, attr, "Generating password credentials for application with object ID %q", id.ObjectId)
+		}
+
+		newCredential, err := applicationPasswordResourceAddPassword(ctx, client, id.ObjectId, *credential)
+		if err != nil {
+			return helpers.CredentialErrorDiag(err, helpers.CredentialTypePassword, "Rotating password for application with object ID %q", id.ObjectId)
+		}
+
+		// Any password retained from an earlier rotation is superseded
+		if v := oldPreviousKeyId.(string); v != "" {
+			if err = applicationPasswordResourceRemovePassword(ctx, client, id.ObjectId, v); err != nil {
+				return tf.ErrorDiagF(err, "Removing previous password credential %q from application with object ID %q", v, id.ObjectId)
+			}
+		}
+
+		oldValue, _ := d.GetChange("value")
+		newId := parse.NewCredentialID(id.ObjectId, "password", *newCredential.KeyId)
+
+		d.SetId(newId.String())
+		tf.Set(d, "previous_key_id", id.KeyId)
+		tf.Set(d, "previous_value", oldValue)
+		tf.Set(d, "value", newCredential.SecretText)
+	} else if v := oldPreviousKeyId.(string); v != "" && newPreviousKeyId.(string) == "" {
+		// The rotation overlap period has passed, so remove the previous password
+		if err = applicationPasswordResourceRemovePassword(ctx, client, id.ObjectId, v); err != nil {
+			return tf.ErrorDiagF(err, "Removing previous password credential %q from application with object ID %q", v, id.ObjectId)
+		}
+
+		tf.Set(d, "previous_key_id", "")
+		tf.Set(d, "previous_value", "")
+	}
 
 	return applicationPasswordResourceRead(ctx, d, meta)
 }
@@ -288,6 +344,12 @@ func applicationPasswordResourceRead(ctx context.Context, d *pluginsdk.ResourceD
 
 	tf.Set(d, "key_id", id.KeyId)
 
+	if v := d.Get("previous_key_id").(string); v != "" && helpers.GetPasswordCredential(app.PasswordCredentials, v) == nil {
+		log.Printf("[DEBUG] Previous password credential %q (ID %q) was not found - removing from state!", v, id.ObjectId)
+		tf.Set(d, "previous_key_id", "")
+		tf.Set(d, "previous_value", "")
+	}
+
 	startDate := ""
 	if v := credential.StartDateTime; v != nil {
 		startDate = v.Format(time.RFC3339)
@@ -320,29 +382,90 @@ func applicationPasswordResourceDelete(ctx context.Context, d *pluginsdk.Resourc
 	tf.LockByName(applicationResourceName, id.ObjectId)
 	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
 
-	if _, err := client.RemovePassword(ctx, id.ObjectId, id.KeyId); err != nil {
+	if err = applicationPasswordResourceRemovePassword(ctx, client, id.ObjectId, id.KeyId); err != nil {
 		return tf.ErrorDiagF(err, "Removing password credential %q from application with object ID %q", id.KeyId, id.ObjectId)
 	}
 
-	// Wait for application password to be deleted
-	if err := helpers.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+	if v := d.Get("previous_key_id").(string); v != "" {
+		if err = applicationPasswordResourceRemovePassword(ctx, client, id.ObjectId, v); err != nil {
+			return tf.ErrorDiagF(err, "Removing previous password credential %q from application with object ID %q", v, id.ObjectId)
+		}
+	}
+
+	return nil
+}
+
+// applicationPasswordResourceAddPassword adds a password to an application and waits for it to appear in the
+// application manifest, which can take several minutes
+func applicationPasswordResourceAddPassword(ctx context.Context, client *msgraph.ApplicationsClient, objectId string, credential msgraph.PasswordCredential) (*msgraph.PasswordCredential, error) {
+	newCredential, _, err := client.AddPassword(ctx, objectId, credential)
+	if err != nil {
+		return nil, err
+	}
+	if newCredential == nil {
+		return nil, errors.New("nil credential received when adding password")
+	}
+	if newCredential.KeyId == nil {
+		return nil, errors.New("nil or empty keyId received")
+	}
+	if newCredential.SecretText == nil || len(*newCredential.SecretText) == 0 {
+		return nil, errors.New("nil or empty password received")
+	}
+
+	timeout, _ := ctx.Deadline()
+	polledForCredential, err := (&pluginsdk.StateChangeConf{ //nolint:staticcheck
+		Pending:                   []string{"Waiting"},
+		Target:                    []string{"Done"},
+		Timeout:                   time.Until(timeout),
+		MinTimeout:                1 * time.Second,
+		ContinuousTargetOccurence: 5,
+		Refresh: func() (interface{}, string, error) {
+			app, _, err := client.Get(ctx, objectId, odata.Query{})
+			if err != nil {
+				return nil, "Error", err
+			}
+
+			if app.PasswordCredentials != nil {
+				for _, cred := range *app.PasswordCredentials {
+					if cred.KeyId != nil && strings.EqualFold(*cred.KeyId, *newCredential.KeyId) {
+						return &cred, "Done", nil
+					}
+				}
+			}
+
+			return nil, "Waiting", nil
+		},
+	}).WaitForStateContext(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for password credential: %+v", err)
+	} else if polledForCredential == nil {
+		return nil, errors.New("password credential not found in application manifest")
+	}
+
+	return newCredential, nil
+}
+
+// applicationPasswordResourceRemovePassword removes a password from an application and waits for it to be deleted
+func applicationPasswordResourceRemovePassword(ctx context.Context, client *msgraph.ApplicationsClient, objectId, keyId string) error {
+	if _, err := client.RemovePassword(ctx, objectId, keyId); err != nil {
+		return err
+	}
+
+	return helpers.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
 		defer func() { client.BaseClient.DisableRetries = false }()
 		client.BaseClient.DisableRetries = true
 
-		app, _, err := client.Get(ctx, id.ObjectId, odata.Query{})
+		app, _, err := client.Get(ctx, objectId, odata.Query{})
 		if err != nil {
 			return nil, err
 		}
 
-		credential := helpers.GetPasswordCredential(app.PasswordCredentials, id.KeyId)
+		credential := helpers.GetPasswordCredential(app.PasswordCredentials, keyId)
 		if credential == nil {
 			return pointer.To(false), nil
 		}
 
 		return pointer.To(true), nil
-	}); err != nil {
-		return tf.ErrorDiagF(err, "Waiting for deletion of password credential %q from application with object ID %q", id.KeyId, id.ObjectId)
-	}
-
-	return nil
+	})
 }
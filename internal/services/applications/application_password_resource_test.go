@@ -78,6 +78,36 @@ func TestAccApplicationPassword_relativeEndDate(t *testing.T) {
 	})
 }
 
+func TestAccApplicationPassword_rotation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_password", "test")
+	r := ApplicationPasswordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rotation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_id").Exists(),
+				check.That(data.ResourceName).Key("previous_key_id").HasValue(""),
+				check.That(data.ResourceName).Key("value").Exists(),
+			),
+			// The password is always within the rotation window, so it is rotated on every plan
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			Config: r.rotation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_id").Exists(),
+				check.That(data.ResourceName).Key("previous_key_id").IsUuid(),
+				check.That(data.ResourceName).Key("previous_value").Exists(),
+				check.That(data.ResourceName).Key("value").Exists(),
+			),
+			ExpectNonEmptyPlan: true,
+		},
+	})
+}
+
 func TestAccApplicationPassword_deprecatedId(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application_password", "test")
 	r := ApplicationPasswordResource{}
@@ -188,6 +218,20 @@ resource "azuread_application_password" "test" {
 `, r.template(data), data.RandomString)
 }
 
+func (r ApplicationPasswordResource) rotation(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_password" "test" {
+  application_id       = azuread_application.test.id
+  display_name         = "terraform-%[2]s"
+  end_date_relative    = "240h"
+  rotate_before_expiry = "240h"
+  rotation_overlap     = "1h"
+}
+`, r.template(data), data.RandomString)
+}
+
 func (r ApplicationPasswordResource) deprecatedId(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
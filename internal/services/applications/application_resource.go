@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
+	applicationsClient "github.com/hashicorp/terraform-provider-azuread/internal/services/applications/client"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/migrations"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
 	applicationsValidate "github.com/hashicorp/terraform-provider-azuread/internal/services/applications/validate"
@@ -487,12 +488,20 @@ func applicationResource() *pluginsdk.Resource {
 			"required_resource_access": {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
+				Computed: true, // API and permission names are resolved to IDs at plan time
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"resource_app_id": {
 							Description: "",
 							Type:        pluginsdk.TypeString,
-							Required:    true,
+							Optional:    true,
+						},
+
+						"resource_app_name": {
+							Description:  "The name of a first-party API, as listed by the `azuread_application_published_app_ids` data source",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
 						"resource_access": {
@@ -504,10 +513,17 @@ func applicationResource() *pluginsdk.Resource {
 									"id": {
 										Description:  "",
 										Type:         pluginsdk.TypeString,
-										Required:     true,
+										Optional:     true,
 										ValidateFunc: validation.IsUUID,
 									},
 
+									"name": {
+										Description:  "The value of the app role or delegated permission scope, as published by the API",
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
 									"type": {
 										Description: "",
 										Type:        pluginsdk.TypeString,
@@ -527,12 +543,30 @@ func applicationResource() *pluginsdk.Resource {
 				},
 			},
 
+			"saml_metadata_url": {
+				Description:  "The URL where the service exposes SAML metadata for federation",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsHttpOrHttpsUrl,
+			},
+
 			"service_management_reference": {
 				Description: "References application or service contact information from a Service or Asset Management database",
 				Type:        pluginsdk.TypeString,
 				Optional:    true,
 			},
 
+			"service_principal_lock_configuration": {
+				Description: "Specifies which properties of service principals created from this application are locked against modification in the tenants where they are provisioned",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: schemaServicePrincipalLockConfigurationProperties(),
+				},
+			},
+
 			"sign_in_audience": {
 				Description: "The Microsoft account types that are supported for the current application",
 				Type:        pluginsdk.TypeString,
@@ -726,6 +760,11 @@ func applicationResourceCustomizeDiff(ctx context.Context, diff *pluginsdk.Resou
 		}
 	}
 
+	// Resolve any API and permission names in required_resource_access
+	if err := applicationResolveRequiredResourceAccess(ctx, diff, meta.(*clients.Client).Applications.ServicePrincipalsClient); err != nil {
+		return err
+	}
+
 	// Validate roles and scopes to check for duplicate IDs or values
 	if err := applicationValidateRolesScopes(diff.Get("app_role").(*pluginsdk.Set).List(), diff.Get("api.0.oauth2_permission_scope").(*pluginsdk.Set).List()); err != nil {
 		return fmt.Errorf("checking for duplicate app roles / OAuth2.0 permission scopes: %v", err)
@@ -962,6 +1001,7 @@ func applicationResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, m
 	client := meta.(*clients.Client).Applications.ApplicationsClientBeta
 	appTemplatesClient := meta.(*clients.Client).Applications.ApplicationTemplatesClient
 	directoryObjectsClient := meta.(*clients.Client).Applications.DirectoryObjectsClient
+	propertiesClient := meta.(*clients.Client).Applications.ApplicationPropertiesClient
 	callerId := meta.(*clients.Client).ObjectID
 	tenantId := meta.(*clients.Client).TenantID
 	displayName := d.Get("display_name").(string)
@@ -1295,11 +1335,26 @@ func applicationResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, m
 		}
 	}
 
+	// Set any properties which are not supported by the SDK
+	additionalProperties := applicationsClient.ApplicationProperties{}
+	if v := d.Get("service_principal_lock_configuration").([]interface{}); len(v) > 0 {
+		additionalProperties.ServicePrincipalLockConfiguration = pointer.To(expandApplicationServicePrincipalLockConfiguration(v))
+	}
+	if v := d.Get("saml_metadata_url").(string); v != "" {
+		additionalProperties.SamlMetadataUrl = msgraph.NullableString(msgraph.StringNullWhenEmpty(v))
+	}
+	if additionalProperties.ServicePrincipalLockConfiguration != nil || additionalProperties.SamlMetadataUrl != nil {
+		if _, err = propertiesClient.Update(ctx, id.ApplicationId, additionalProperties); err != nil {
+			return tf.ErrorDiagF(err, "Could not set SAML metadata URL or service principal lock configuration for application with object ID: %q", id.ApplicationId)
+		}
+	}
+
 	return applicationResourceRead(ctx, d, meta)
 }
 
 func applicationResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).Applications.ApplicationsClientBeta
+	propertiesClient := meta.(*clients.Client).Applications.ApplicationPropertiesClient
 	tenantId := meta.(*clients.Client).TenantID
 
 	id, err := parse.ParseApplicationID(d.Id())
@@ -1396,7 +1451,7 @@ func applicationResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, m
 
 			newCredential, _, err := client.AddPassword(ctx, id.ApplicationId, *credential)
 			if err != nil {
-				return tf.ErrorDiagF(err, "Adding password for application with object ID %q", id.ApplicationId)
+				return helpers.CredentialErrorDiag(err, helpers.CredentialTypePassword, "Adding password for application with object ID %q", id.ApplicationId)
 			}
 			if newCredential == nil {
 				return tf.ErrorDiagF(errors.New("nil credential received when adding password"), "API error adding password for application with object ID %q", id.ApplicationId)
@@ -1556,11 +1611,26 @@ func applicationResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, m
 		}
 	}
 
+	// Update any properties which are not supported by the SDK
+	additionalProperties := applicationsClient.ApplicationProperties{}
+	if v := d.Get("service_principal_lock_configuration").([]interface{}); d.HasChange("service_principal_lock_configuration") && len(v) > 0 {
+		additionalProperties.ServicePrincipalLockConfiguration = pointer.To(expandApplicationServicePrincipalLockConfiguration(v))
+	}
+	if d.HasChange("saml_metadata_url") {
+		additionalProperties.SamlMetadataUrl = msgraph.NullableString(msgraph.StringNullWhenEmpty(d.Get("saml_metadata_url").(string)))
+	}
+	if additionalProperties.ServicePrincipalLockConfiguration != nil || additionalProperties.SamlMetadataUrl != nil {
+		if _, err = propertiesClient.Update(ctx, id.ApplicationId, additionalProperties); err != nil {
+			return tf.ErrorDiagF(err, "Could not update SAML metadata URL or service principal lock configuration for application with object ID: %q", id.ApplicationId)
+		}
+	}
+
 	return applicationResourceRead(ctx, d, meta)
 }
 
 func applicationResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).Applications.ApplicationsClientBeta
+	propertiesClient := meta.(*clients.Client).Applications.ApplicationPropertiesClient
 
 	id, err := parse.ParseApplicationID(d.Id())
 	if err != nil {
@@ -1597,7 +1667,7 @@ func applicationResourceRead(ctx context.Context, d *pluginsdk.ResourceData, met
 	tf.Set(d, "optional_claims", flattenApplicationOptionalClaims(app.OptionalClaims))
 	tf.Set(d, "public_client", flattenApplicationPublicClient(app.PublicClient))
 	tf.Set(d, "publisher_domain", app.PublisherDomain)
-	tf.Set(d, "required_resource_access", flattenApplicationRequiredResourceAccess(app.RequiredResourceAccess))
+	tf.Set(d, "required_resource_access", applicationRequiredResourceAccessWithNames(flattenApplicationRequiredResourceAccess(app.RequiredResourceAccess), d.Get("required_resource_access").(*pluginsdk.Set).List()))
 	tf.Set(d, "service_management_reference", app.ServiceManagementReference)
 	tf.Set(d, "sign_in_audience", app.SignInAudience)
 	tf.Set(d, "single_page_application", flattenApplicationSpa(app.Spa))
@@ -1659,6 +1729,21 @@ func applicationResourceRead(ctx context.Context, d *pluginsdk.ResourceData, met
 	}
 	tf.Set(d, "owners", owners)
 
+	properties, _, err := propertiesClient.Get(ctx, *app.ID())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not retrieve SAML metadata URL and service principal lock configuration for application with object ID %q", *app.ID())
+	}
+	if properties == nil {
+		return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+	}
+
+	samlMetadataUrl := ""
+	if properties.SamlMetadataUrl != nil {
+		samlMetadataUrl = string(*properties.SamlMetadataUrl)
+	}
+	tf.Set(d, "saml_metadata_url", samlMetadataUrl)
+	tf.Set(d, "service_principal_lock_configuration", flattenApplicationServicePrincipalLockConfiguration(properties.ServicePrincipalLockConfiguration))
+
 	return nil
 }
 
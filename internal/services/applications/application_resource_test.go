@@ -567,6 +567,33 @@ func TestAccApplication_featureTagsUpdate(t *testing.T) {
 	})
 }
 
+func TestAccApplication_requiredResourceAccessByName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.requiredResourceAccessByName(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("required_resource_access.#").HasValue("1"),
+				check.That(data.ResourceName).Key("required_resource_access.0.resource_app_id").HasValue("00000003-0000-0000-c000-000000000000"),
+				check.That(data.ResourceName).Key("required_resource_access.0.resource_app_name").HasValue("MicrosoftGraph"),
+				check.That(data.ResourceName).Key("required_resource_access.0.resource_access.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("required_resource_access"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("required_resource_access.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccApplication_logo(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application", "test")
 	r := ApplicationResource{}
@@ -672,6 +699,57 @@ func TestAccApplication_passwordNotSet(t *testing.T) {
 	})
 }
 
+func TestAccApplication_samlMetadataUrl(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.samlMetadataUrl(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("saml_metadata_url").HasValue(fmt.Sprintf("https://test-%d.internal/saml/metadata", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("saml_metadata_url").IsEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_servicePrincipalLockConfiguration(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.servicePrincipalLockConfiguration(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("service_principal_lock_configuration.#").HasValue("1"),
+				check.That(data.ResourceName).Key("service_principal_lock_configuration.0.enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("service_principal_lock_configuration.0.all_properties").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.servicePrincipalLockConfiguration(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("service_principal_lock_configuration.#").HasValue("1"),
+				check.That(data.ResourceName).Key("service_principal_lock_configuration.0.enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r ApplicationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.Applications.ApplicationsClientBeta
 	client.BaseClient.DisableRetries = true
@@ -1663,6 +1741,30 @@ resource "azuread_application" "test" {
 `, data.RandomInteger)
 }
 
+func (r ApplicationResource) requiredResourceAccessByName(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+
+  required_resource_access {
+    resource_app_name = "MicrosoftGraph"
+
+    resource_access {
+      name = "User.Read.All"
+      type = "Role"
+    }
+
+    resource_access {
+      name = "User.Read"
+      type = "Scope"
+    }
+  }
+}
+`, data.RandomInteger)
+}
+
 func (r ApplicationResource) logo(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
@@ -1709,3 +1811,30 @@ resource "azuread_application" "test" {
 }
 `, data.RandomInteger, startDate, endDate)
 }
+
+func (ApplicationResource) servicePrincipalLockConfiguration(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name     = "acctest-APP-%[1]d"
+  sign_in_audience = "AzureADMultipleOrgs"
+
+  service_principal_lock_configuration {
+    enabled        = %[2]t
+    all_properties = %[2]t
+  }
+}
+`, data.RandomInteger, enabled)
+}
+
+func (ApplicationResource) samlMetadataUrl(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name      = "acctest-APP-%[1]d"
+  saml_metadata_url = "https://test-%[1]d.internal/saml/metadata"
+}
+`, data.RandomInteger)
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+	applicationsClient "github.com/hashicorp/terraform-provider-azuread/internal/services/applications/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+)
+
+type ApplicationServicePrincipalLockConfigurationModel struct {
+	ApplicationId              string `tfschema:"application_id"`
+	AllProperties              bool   `tfschema:"all_properties"`
+	CredentialsWithUsageSign   bool   `tfschema:"credentials_with_usage_sign"`
+	CredentialsWithUsageVerify bool   `tfschema:"credentials_with_usage_verify"`
+	Enabled                    bool   `tfschema:"enabled"`
+	TokenEncryptionKeyId       bool   `tfschema:"token_encryption_key_id"`
+}
+
+var _ sdk.ResourceWithUpdate = ApplicationServicePrincipalLockConfigurationResource{}
+
+type ApplicationServicePrincipalLockConfigurationResource struct{}
+
+func (r ApplicationServicePrincipalLockConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.ValidateServicePrincipalLockConfigurationID
+}
+
+func (r ApplicationServicePrincipalLockConfigurationResource) ResourceType() string {
+	return "azuread_application_service_principal_lock_configuration"
+}
+
+func (r ApplicationServicePrincipalLockConfigurationResource) ModelObject() interface{} {
+	return &ApplicationServicePrincipalLockConfigurationModel{}
+}
+
+func (r ApplicationServicePrincipalLockConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	arguments := schemaServicePrincipalLockConfigurationProperties()
+
+	arguments["application_id"] = &pluginsdk.Schema{
+		Description:  "The resource ID of the application to which the service principal lock configuration should be applied",
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: parse.ValidateApplicationID,
+	}
+
+	return arguments
+}
+
+func (r ApplicationServicePrincipalLockConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApplicationServicePrincipalLockConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Applications.ApplicationPropertiesClient
+			client.BaseClient.DisableRetries = true
+			defer func() { client.BaseClient.DisableRetries = false }()
+
+			var model ApplicationServicePrincipalLockConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			applicationId, err := parse.ParseApplicationID(model.ApplicationId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewServicePrincipalLockConfigurationID(applicationId.ApplicationId)
+
+			tf.LockByName(applicationResourceName, id.ApplicationId)
+			defer tf.UnlockByName(applicationResourceName, id.ApplicationId)
+
+			if _, err = client.Update(ctx, id.ApplicationId, applicationsClient.ApplicationProperties{ServicePrincipalLockConfiguration: pointer.To(expandServicePrincipalLockConfigurationModel(model))}); err != nil {
+				return fmt.Errorf("setting %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApplicationServicePrincipalLockConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Applications.ApplicationPropertiesClient
+			client.BaseClient.DisableRetries = true
+			defer func() { client.BaseClient.DisableRetries = false }()
+
+			id, err := parse.ParseServicePrincipalLockConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			applicationId := parse.NewApplicationID(id.ApplicationId)
+
+			tf.LockByName(applicationResourceName, id.ApplicationId)
+			defer tf.UnlockByName(applicationResourceName, id.ApplicationId)
+
+			result, status, err := client.Get(ctx, id.ApplicationId)
+			if err != nil {
+				if status == http.StatusNotFound {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if result == nil || result.ServicePrincipalLockConfiguration == nil {
+				return metadata.MarkAsGone(id)
+			}
+			lockConfiguration := result.ServicePrincipalLockConfiguration
+
+			state := ApplicationServicePrincipalLockConfigurationModel{
+				ApplicationId:              applicationId.ID(),
+				AllProperties:              pointer.From(lockConfiguration.AllProperties),
+				CredentialsWithUsageSign:   pointer.From(lockConfiguration.CredentialsWithUsageSign),
+				CredentialsWithUsageVerify: pointer.From(lockConfiguration.CredentialsWithUsageVerify),
+				Enabled:                    pointer.From(lockConfiguration.IsEnabled),
+				TokenEncryptionKeyId:       pointer.From(lockConfiguration.TokenEncryptionKeyId),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationServicePrincipalLockConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Applications.ApplicationPropertiesClient
+			client.BaseClient.DisableRetries = true
+			defer func() { client.BaseClient.DisableRetries = false }()
+
+			id, err := parse.ParseServicePrincipalLockConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationServicePrincipalLockConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			tf.LockByName(applicationResourceName, id.ApplicationId)
+			defer tf.UnlockByName(applicationResourceName, id.ApplicationId)
+
+			if _, err = client.Update(ctx, id.ApplicationId, applicationsClient.ApplicationProperties{ServicePrincipalLockConfiguration: pointer.To(expandServicePrincipalLockConfigurationModel(model))}); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationServicePrincipalLockConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Applications.ApplicationPropertiesClient
+			client.BaseClient.DisableRetries = true
+			defer func() { client.BaseClient.DisableRetries = false }()
+
+			id, err := parse.ParseServicePrincipalLockConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			tf.LockByName(applicationResourceName, id.ApplicationId)
+			defer tf.UnlockByName(applicationResourceName, id.ApplicationId)
+
+			// The lock configuration cannot be removed, so disable it instead
+			if _, err = client.Update(ctx, id.ApplicationId, applicationsClient.ApplicationProperties{ServicePrincipalLockConfiguration: pointer.To(expandApplicationServicePrincipalLockConfiguration(nil))}); err != nil {
+				return fmt.Errorf("disabling %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandServicePrincipalLockConfigurationModel(model ApplicationServicePrincipalLockConfigurationModel) applicationsClient.ServicePrincipalLockConfiguration {
+	return applicationsClient.ServicePrincipalLockConfiguration{
+		AllProperties:              pointer.To(model.AllProperties),
+		CredentialsWithUsageSign:   pointer.To(model.CredentialsWithUsageSign),
+		CredentialsWithUsageVerify: pointer.To(model.CredentialsWithUsageVerify),
+		IsEnabled:                  pointer.To(model.Enabled),
+		TokenEncryptionKeyId:       pointer.To(model.TokenEncryptionKeyId),
+	}
+}
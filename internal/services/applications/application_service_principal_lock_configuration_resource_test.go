@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+)
+
+type ApplicationServicePrincipalLockConfigurationResource struct{}
+
+func TestAccApplicationServicePrincipalLockConfiguration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_service_principal_lock_configuration", "test")
+	r := ApplicationServicePrincipalLockConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("application_id").Exists(),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("all_properties").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationServicePrincipalLockConfiguration_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_service_principal_lock_configuration", "test")
+	r := ApplicationServicePrincipalLockConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("all_properties").HasValue("false"),
+				check.That(data.ResourceName).Key("credentials_with_usage_sign").HasValue("true"),
+				check.That(data.ResourceName).Key("credentials_with_usage_verify").HasValue("true"),
+				check.That(data.ResourceName).Key("token_encryption_key_id").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.disabled(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationServicePrincipalLockConfigurationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Applications.ApplicationPropertiesClient
+	client.BaseClient.DisableRetries = true
+	defer func() { client.BaseClient.DisableRetries = false }()
+
+	id, err := parse.ParseServicePrincipalLockConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, status, err := client.Get(ctx, id.ApplicationId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(result != nil && result.ServicePrincipalLockConfiguration != nil), nil
+}
+
+func (ApplicationServicePrincipalLockConfigurationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application_registration" "test" {
+  display_name     = "acctest-SPLockConfig-%[1]d"
+  sign_in_audience = "AzureADMultipleOrgs"
+}
+`, data.RandomInteger)
+}
+
+func (r ApplicationServicePrincipalLockConfigurationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_service_principal_lock_configuration" "test" {
+  application_id = azuread_application_registration.test.id
+  enabled        = true
+  all_properties = true
+}
+`, r.template(data))
+}
+
+func (r ApplicationServicePrincipalLockConfigurationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_service_principal_lock_configuration" "test" {
+  application_id                = azuread_application_registration.test.id
+  enabled                       = true
+  credentials_with_usage_sign   = true
+  credentials_with_usage_verify = true
+  token_encryption_key_id       = true
+}
+`, r.template(data))
+}
+
+func (r ApplicationServicePrincipalLockConfigurationResource) disabled(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_service_principal_lock_configuration" "test" {
+  application_id = azuread_application_registration.test.id
+  enabled        = false
+}
+`, r.template(data))
+}
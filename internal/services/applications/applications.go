@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
+	applicationsClient "github.com/hashicorp/terraform-provider-azuread/internal/services/applications/client"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
 	"github.com/manicminer/hamilton/msgraph"
@@ -652,6 +653,17 @@ func expandApplicationWeb(input []interface{}) (result *msgraph.ApplicationWeb)
 	return
 }
 
+func expandFederatedIdentityExpression(in string) *applicationsClient.FederatedIdentityExpression {
+	if in == "" {
+		return nil
+	}
+
+	return &applicationsClient.FederatedIdentityExpression{
+		LanguageVersion: pointer.To(applicationsClient.FederatedIdentityExpressionLanguageVersion1),
+		Value:           pointer.To(in),
+	}
+}
+
 func flattenApplicationApi(in *msgraph.ApplicationApi, dataSource bool) []map[string]interface{} {
 	if in == nil {
 		return []map[string]interface{}{}
@@ -866,3 +878,195 @@ func flattenApplicationWeb(in *msgraph.ApplicationWeb) []map[string]interface{}
 		"implicit_grant": flattenApplicationImplicitGrant(in.ImplicitGrantSettings),
 	}}
 }
+
+func expandApplicationServicePrincipalLockConfiguration(input []interface{}) applicationsClient.ServicePrincipalLockConfiguration {
+	result := applicationsClient.ServicePrincipalLockConfiguration{
+		AllProperties:              pointer.To(false),
+		CredentialsWithUsageSign:   pointer.To(false),
+		CredentialsWithUsageVerify: pointer.To(false),
+		IsEnabled:                  pointer.To(false),
+		TokenEncryptionKeyId:       pointer.To(false),
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return result
+	}
+
+	in := input[0].(map[string]interface{})
+	result.AllProperties = pointer.To(in["all_properties"].(bool))
+	result.CredentialsWithUsageSign = pointer.To(in["credentials_with_usage_sign"].(bool))
+	result.CredentialsWithUsageVerify = pointer.To(in["credentials_with_usage_verify"].(bool))
+	result.IsEnabled = pointer.To(in["enabled"].(bool))
+	result.TokenEncryptionKeyId = pointer.To(in["token_encryption_key_id"].(bool))
+
+	return result
+}
+
+func flattenFederatedIdentityExpression(in *applicationsClient.FederatedIdentityExpression) string {
+	if in == nil {
+		return ""
+	}
+
+	return pointer.From(in.Value)
+}
+
+func flattenApplicationServicePrincipalLockConfiguration(in *applicationsClient.ServicePrincipalLockConfiguration) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{{
+		"all_properties":                pointer.From(in.AllProperties),
+		"credentials_with_usage_sign":   pointer.From(in.CredentialsWithUsageSign),
+		"credentials_with_usage_verify": pointer.From(in.CredentialsWithUsageVerify),
+		"enabled":                       pointer.From(in.IsEnabled),
+		"token_encryption_key_id":       pointer.From(in.TokenEncryptionKeyId),
+	}}
+}
+
+// applicationResolveRequiredResourceAccess validates the `required_resource_access` blocks of an application, and
+// when any API or permission is referenced by name, resolves the corresponding IDs so they are known at plan time
+func applicationResolveRequiredResourceAccess(ctx context.Context, diff *pluginsdk.ResourceDiff, client *msgraph.ServicePrincipalsClient) error {
+	raw := diff.GetRawConfig().GetAttr("required_resource_access")
+
+	// The block is Optional+Computed, so removing all blocks must be handled explicitly
+	if raw.IsNull() || (raw.IsKnown() && raw.LengthInt() == 0) {
+		if diff.Get("required_resource_access").(*pluginsdk.Set).Len() > 0 {
+			return diff.SetNew("required_resource_access", []interface{}{})
+		}
+		return nil
+	}
+	if !raw.IsKnown() {
+		return nil
+	}
+
+	usesNames := false
+	for it := raw.ElementIterator(); it.Next(); {
+		_, block := it.Element()
+
+		appId, appName := block.GetAttr("resource_app_id"), block.GetAttr("resource_app_name")
+		if appId.IsKnown() && appName.IsKnown() && appId.IsNull() == appName.IsNull() {
+			return fmt.Errorf("exactly one of `resource_app_id` or `resource_app_name` must be specified in each `required_resource_access` block")
+		}
+		if !appName.IsNull() {
+			usesNames = true
+		}
+
+		resourceAccess := block.GetAttr("resource_access")
+		if !resourceAccess.IsKnown() || resourceAccess.IsNull() {
+			continue
+		}
+		for accessIt := resourceAccess.ElementIterator(); accessIt.Next(); {
+			_, access := accessIt.Element()
+
+			id, name := access.GetAttr("id"), access.GetAttr("name")
+			if id.IsKnown() && name.IsKnown() && id.IsNull() == name.IsNull() {
+				return fmt.Errorf("exactly one of `id` or `name` must be specified in each `resource_access` block")
+			}
+			if !name.IsNull() {
+				usesNames = true
+			}
+		}
+	}
+
+	// When only IDs are used, the configured value is used as-is
+	if !usesNames {
+		return nil
+	}
+
+	if !raw.IsWhollyKnown() {
+		return diff.SetNewComputed("required_resource_access")
+	}
+
+	resolver := newApiPermissionResolver(client)
+	result := make([]interface{}, 0)
+
+	for it := raw.ElementIterator(); it.Next(); {
+		_, block := it.Element()
+
+		var appId, appName string
+		if v := block.GetAttr("resource_app_name"); !v.IsNull() {
+			appName = v.AsString()
+
+			var err error
+			if appId, err = apiClientIdForName(appName); err != nil {
+				return fmt.Errorf("resolving `resource_app_name`: %+v", err)
+			}
+		} else {
+			appId = block.GetAttr("resource_app_id").AsString()
+		}
+
+		accesses := make([]interface{}, 0)
+		for accessIt := block.GetAttr("resource_access").ElementIterator(); accessIt.Next(); {
+			_, access := accessIt.Element()
+
+			var id, name string
+			permissionType := access.GetAttr("type").AsString()
+
+			if v := access.GetAttr("name"); !v.IsNull() {
+				name = v.AsString()
+
+				var err error
+				if id, err = resolver.id(ctx, appId, permissionType, name); err != nil {
+					return fmt.Errorf("resolving `resource_access` name: %+v", err)
+				}
+			} else {
+				id = access.GetAttr("id").AsString()
+			}
+
+			accesses = append(accesses, map[string]interface{}{
+				"id":   id,
+				"name": name,
+				"type": permissionType,
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"resource_app_id":   appId,
+			"resource_app_name": appName,
+			"resource_access":   accesses,
+		})
+	}
+
+	return diff.SetNew("required_resource_access", result)
+}
+
+// applicationRequiredResourceAccessWithNames populates the API and permission names in flattened
+// `required_resource_access` blocks, using the names previously resolved for matching IDs
+func applicationRequiredResourceAccessWithNames(in []map[string]interface{}, prior []interface{}) []map[string]interface{} {
+	appNames := make(map[string]string)
+	permissionNames := make(map[string]string)
+
+	for _, raw := range prior {
+		block, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		appId := strings.ToLower(block["resource_app_id"].(string))
+		if name := block["resource_app_name"].(string); name != "" {
+			appNames[appId] = name
+		}
+		for _, rawAccess := range block["resource_access"].([]interface{}) {
+			access, ok := rawAccess.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if name := access["name"].(string); name != "" {
+				permissionNames[fmt.Sprintf("%s/%s/%s", appId, access["type"], strings.ToLower(access["id"].(string)))] = name
+			}
+		}
+	}
+
+	for _, block := range in {
+		appId := strings.ToLower(block["resource_app_id"].(string))
+		block["resource_app_name"] = appNames[appId]
+
+		for _, rawAccess := range block["resource_access"].([]interface{}) {
+			access := rawAccess.(map[string]interface{})
+			id, _ := access["id"].(string)
+			access["name"] = permissionNames[fmt.Sprintf("%s/%s/%s", appId, access["type"], strings.ToLower(id))]
+		}
+	}
+
+	return in
+}
@@ -0,0 +1,354 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+var applicationsDataSourceFilters = []string{
+	"display_name_prefix",
+	"identifier_uri_prefix",
+	"owner_object_id",
+	"publisher_domain",
+	"sign_in_audience",
+	"tags",
+}
+
+func applicationsDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: applicationsDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"display_name_prefix": {
+				Description:      "Only return applications whose display name starts with this value",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				AtLeastOneOf:     append(applicationsDataSourceFilters, "return_all"),
+				ConflictsWith:    []string{"return_all"},
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotEmpty),
+			},
+
+			"identifier_uri_prefix": {
+				Description:      "Only return applications having at least one identifier URI which starts with this value",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				AtLeastOneOf:     append(applicationsDataSourceFilters, "return_all"),
+				ConflictsWith:    []string{"return_all"},
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotEmpty),
+			},
+
+			"owner_object_id": {
+				Description:      "Only return applications owned by the user or service principal with this object ID",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				AtLeastOneOf:     append(applicationsDataSourceFilters, "return_all"),
+				ConflictsWith:    []string{"return_all"},
+				ValidateDiagFunc: validation.ValidateDiag(validation.IsUUID),
+			},
+
+			"publisher_domain": {
+				Description:      "Only return applications having this publisher domain",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				AtLeastOneOf:     append(applicationsDataSourceFilters, "return_all"),
+				ConflictsWith:    []string{"return_all"},
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotEmpty),
+			},
+
+			"sign_in_audience": {
+				Description:   "Only return applications supporting this sign-in audience",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  append(applicationsDataSourceFilters, "return_all"),
+				ConflictsWith: []string{"return_all"},
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice([]string{
+					msgraph.SignInAudienceAzureADMyOrg,
+					msgraph.SignInAudienceAzureADMultipleOrgs,
+					msgraph.SignInAudienceAzureADandPersonalMicrosoftAccount,
+					msgraph.SignInAudiencePersonalMicrosoftAccount,
+				}, false)),
+			},
+
+			"tags": {
+				Description:   "Only return applications having all of these tags",
+				Type:          pluginsdk.TypeSet,
+				Optional:      true,
+				AtLeastOneOf:  append(applicationsDataSourceFilters, "return_all"),
+				ConflictsWith: []string{"return_all"},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"ignore_missing": {
+				Description:   "Ignore a filter which matches no applications and return an empty list, instead of failing",
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"return_all"},
+			},
+
+			"return_all": {
+				Description:   "Fetch all applications with no filter and return all that were found. The data source will still fail if no applications are found.",
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Default:       false,
+				AtLeastOneOf:  append(applicationsDataSourceFilters, "return_all"),
+				ConflictsWith: append(applicationsDataSourceFilters, "ignore_missing"),
+			},
+
+			"client_ids": {
+				Description: "The client IDs of the applications",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"display_names": {
+				Description: "The display names of the applications",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"object_ids": {
+				Description: "The object IDs of the applications",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"applications": {
+				Description: "A list of applications",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"client_id": {
+							Description: "The client ID of the application",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"display_name": {
+							Description: "The display name of the application",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"identifier_uris": {
+							Description: "A list of user-defined URI(s) that uniquely identify the application within its Azure AD tenant, or within a verified custom domain if the application is multi-tenant",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"object_id": {
+							Description: "The object ID of the application",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"publisher_domain": {
+							Description: "The verified publisher domain for the application",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"sign_in_audience": {
+							Description: "The Microsoft account types that are supported for the application",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"tags": {
+							Description: "A list of tags applied to the application",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func applicationsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Applications.ApplicationsClient
+	client.BaseClient.DisableRetries = true
+	defer func() { client.BaseClient.DisableRetries = false }()
+
+	directoryObjectsClient := meta.(*clients.Client).Applications.DirectoryObjectsClient
+	ownedApplicationsClient := meta.(*clients.Client).Applications.OwnedApplicationsClient
+
+	ignoreMissing := d.Get("ignore_missing").(bool)
+	returnAll := d.Get("return_all").(bool)
+
+	filter := make([]string, 0)
+	if v := d.Get("display_name_prefix").(string); v != "" {
+		filter = append(filter, fmt.Sprintf("startswith(displayName, '%s')", odata.EscapeSingleQuote(v)))
+	}
+	if v := d.Get("identifier_uri_prefix").(string); v != "" {
+		filter = append(filter, fmt.Sprintf("identifierUris/any(uri:startswith(uri, '%s'))", odata.EscapeSingleQuote(v)))
+	}
+	if v := d.Get("publisher_domain").(string); v != "" {
+		filter = append(filter, fmt.Sprintf("publisherDomain eq '%s'", odata.EscapeSingleQuote(v)))
+	}
+	if v := d.Get("sign_in_audience").(string); v != "" {
+		filter = append(filter, fmt.Sprintf("signInAudience eq '%s'", odata.EscapeSingleQuote(v)))
+	}
+	for _, v := range tf.ExpandStringSlice(d.Get("tags").(*pluginsdk.Set).List()) {
+		filter = append(filter, fmt.Sprintf("tags/any(t:t eq '%s')", odata.EscapeSingleQuote(v)))
+	}
+
+	// Filtering on collections and with startswith requires an advanced query. The client follows paging links in
+	// list responses, so this retrieves all matching applications.
+	query := odata.Query{}
+	if len(filter) > 0 {
+		query = odata.Query{
+			ConsistencyLevel: odata.ConsistencyLevelEventual,
+			Count:            true,
+			Filter:           strings.Join(filter, " and "),
+		}
+	}
+
+	var applications []msgraph.Application
+
+	ownerId := d.Get("owner_object_id").(string)
+	if ownerId != "" {
+		// Owned objects can only be listed for a specific type of principal, so determine the type of the owner
+		ownerObject, status, err := directoryObjectsClient.Get(ctx, ownerId, odata.Query{})
+		if err != nil {
+			if status == http.StatusNotFound {
+				return tf.ErrorDiagPathF(err, "owner_object_id", "Owner with object ID %q was not found", ownerId)
+			}
+			return tf.ErrorDiagF(err, "Could not retrieve owner with object ID %q", ownerId)
+		}
+		if ownerObject == nil || ownerObject.ODataType == nil {
+			return tf.ErrorDiagF(errors.New("nil object or OData type returned"), "Bad API response for owner with object ID %q", ownerId)
+		}
+		if *ownerObject.ODataType != odata.TypeUser && *ownerObject.ODataType != odata.TypeServicePrincipal {
+			return tf.ErrorDiagPathF(fmt.Errorf("unsupported object type %q", *ownerObject.ODataType), "owner_object_id", "Owner with object ID %q is not a user or service principal", ownerId)
+		}
+
+		// Retrieve the applications owned by the principal, which are typically far fewer than those in the tenant
+		owned, _, err := ownedApplicationsClient.List(ctx, *ownerObject.ODataType, ownerId, odata.Query{})
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not retrieve applications owned by principal with object ID %q", ownerId)
+		}
+		if owned == nil {
+			return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+		}
+		applications = *owned
+	}
+
+	// Retrieve applications matching any other filters, intersecting them with the owned applications if necessary
+	if ownerId == "" || len(filter) > 0 {
+		result, _, err := client.List(ctx, query)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not retrieve applications")
+		}
+		if result == nil {
+			return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+		}
+
+		if ownerId == "" {
+			applications = *result
+		} else {
+			ownedIds := make(map[string]bool)
+			for _, app := range applications {
+				if app.ID() != nil {
+					ownedIds[strings.ToLower(*app.ID())] = true
+				}
+			}
+
+			applications = make([]msgraph.Application, 0)
+			for _, app := range *result {
+				if app.ID() != nil && ownedIds[strings.ToLower(*app.ID())] {
+					applications = append(applications, app)
+				}
+			}
+		}
+	}
+
+	if len(applications) == 0 {
+		if returnAll {
+			return tf.ErrorDiagPathF(nil, "return_all", "No applications found")
+		}
+		if !ignoreMissing {
+			return tf.ErrorDiagF(errors.New("no applications were found matching the specified filters"), "Applications not found")
+		}
+	}
+
+	clientIds := make([]string, 0)
+	displayNames := make([]string, 0)
+	objectIds := make([]string, 0)
+	appList := make([]map[string]interface{}, 0)
+	for _, app := range applications {
+		if app.ID() == nil || app.DisplayName == nil {
+			return tf.ErrorDiagF(errors.New("API returned application with nil object ID or displayName"), "Bad API Response")
+		}
+
+		objectIds = append(objectIds, *app.ID())
+		displayNames = append(displayNames, *app.DisplayName)
+		if app.AppId != nil {
+			clientIds = append(clientIds, *app.AppId)
+		}
+
+		a := make(map[string]interface{})
+		a["client_id"] = app.AppId
+		a["display_name"] = app.DisplayName
+		a["identifier_uris"] = tf.FlattenStringSlicePtr(app.IdentifierUris)
+		a["object_id"] = app.ID()
+		a["publisher_domain"] = app.PublisherDomain
+		a["sign_in_audience"] = app.SignInAudience
+		a["tags"] = tf.FlattenStringSlicePtr(app.Tags)
+		appList = append(appList, a)
+	}
+
+	// Generate a unique ID based on the filters and result
+	h := sha1.New()
+	if _, err := h.Write([]byte(query.Filter + d.Get("owner_object_id").(string) + "/" + strings.Join(objectIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for object IDs")
+	}
+
+	d.SetId("applications#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	tf.Set(d, "applications", appList)
+	tf.Set(d, "client_ids", clientIds)
+	tf.Set(d, "display_names", displayNames)
+	tf.Set(d, "object_ids", objectIds)
+
+	return nil
+}
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ApplicationsDataSource struct{}
+
+func TestAccApplicationsDataSource_byDisplayNamePrefix(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_applications", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ApplicationsDataSource{}.byDisplayNamePrefix(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("applications.#").HasValue("3"),
+			check.That(data.ResourceName).Key("client_ids.#").HasValue("3"),
+			check.That(data.ResourceName).Key("display_names.#").HasValue("3"),
+			check.That(data.ResourceName).Key("object_ids.#").HasValue("3"),
+		),
+	}})
+}
+
+func TestAccApplicationsDataSource_byTagsAndSignInAudience(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_applications", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ApplicationsDataSource{}.byTagsAndSignInAudience(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("applications.#").HasValue("1"),
+			check.That(data.ResourceName).Key("applications.0.display_name").HasValue(fmt.Sprintf("acctest-APPs-%d-B", data.RandomInteger)),
+			check.That(data.ResourceName).Key("applications.0.sign_in_audience").HasValue("AzureADMultipleOrgs"),
+		),
+	}})
+}
+
+func TestAccApplicationsDataSource_byIdentifierUriPrefix(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_applications", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ApplicationsDataSource{}.byIdentifierUriPrefix(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("applications.#").HasValue("1"),
+			check.That(data.ResourceName).Key("applications.0.identifier_uris.#").HasValue("1"),
+		),
+	}})
+}
+
+func TestAccApplicationsDataSource_byOwner(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_applications", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ApplicationsDataSource{}.byOwner(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("applications.#").HasValue("1"),
+			check.That(data.ResourceName).Key("display_names.0").HasValue(fmt.Sprintf("acctest-APPs-%d-C", data.RandomInteger)),
+		),
+	}})
+}
+
+func TestAccApplicationsDataSource_ignoreMissing(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_applications", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ApplicationsDataSource{}.ignoreMissing(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("applications.#").HasValue("0"),
+			check.That(data.ResourceName).Key("object_ids.#").HasValue("0"),
+		),
+	}})
+}
+
+func TestAccApplicationsDataSource_returnAll(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_applications", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ApplicationsDataSource{}.returnAll(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("applications.#").Exists(),
+			check.That(data.ResourceName).Key("client_ids.#").Exists(),
+			check.That(data.ResourceName).Key("display_names.#").Exists(),
+			check.That(data.ResourceName).Key("object_ids.#").Exists(),
+		),
+	}})
+}
+
+func (ApplicationsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_client_config" "current" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestAPPsOwner.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestAPPsOwner-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_application" "testA" {
+  display_name = "acctest-APPs-%[1]d-A"
+  tags         = ["acctest-%[1]d"]
+}
+
+resource "azuread_application" "testB" {
+  display_name     = "acctest-APPs-%[1]d-B"
+  sign_in_audience = "AzureADMultipleOrgs"
+  tags             = ["acctest-%[1]d", "acctest-multi"]
+}
+
+resource "azuread_application" "testC" {
+  display_name    = "acctest-APPs-%[1]d-C"
+  identifier_uris = ["api://acctest-APPs-%[1]d-C"]
+  owners          = [data.azuread_client_config.current.object_id, azuread_user.test.object_id]
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r ApplicationsDataSource) byDisplayNamePrefix(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_applications" "test" {
+  display_name_prefix = "acctest-APPs-%[2]d-"
+
+  depends_on = [
+    azuread_application.testA,
+    azuread_application.testB,
+    azuread_application.testC,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApplicationsDataSource) byTagsAndSignInAudience(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_applications" "test" {
+  sign_in_audience = "AzureADMultipleOrgs"
+  tags             = ["acctest-%[2]d"]
+
+  depends_on = [
+    azuread_application.testA,
+    azuread_application.testB,
+    azuread_application.testC,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApplicationsDataSource) byIdentifierUriPrefix(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_applications" "test" {
+  identifier_uri_prefix = "api://acctest-APPs-%[2]d-"
+
+  depends_on = [
+    azuread_application.testA,
+    azuread_application.testB,
+    azuread_application.testC,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApplicationsDataSource) byOwner(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_applications" "test" {
+  display_name_prefix = "acctest-APPs-%[2]d-"
+  owner_object_id     = azuread_user.test.object_id
+
+  depends_on = [
+    azuread_application.testA,
+    azuread_application.testB,
+    azuread_application.testC,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (ApplicationsDataSource) ignoreMissing(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_applications" "test" {
+  display_name_prefix = "not-a-real-application-%[1]d-g1bb3r1sh"
+  ignore_missing      = true
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationsDataSource) returnAll(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_applications" "test" {
+  return_all = true
+
+  depends_on = [azuread_application.testA]
+}
+`, ApplicationsDataSource{}.template(data))
+}
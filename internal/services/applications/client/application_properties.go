@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// ApplicationProperties holds properties of applications which are not supported by the application model in the SDK.
+// Properties which are nil are omitted when updating an application, and an empty SamlMetadataUrl removes it.
+type ApplicationProperties struct {
+	SamlMetadataUrl                   *msgraph.StringNullWhenEmpty       `json:"samlMetadataUrl,omitempty"`
+	ServicePrincipalLockConfiguration *ServicePrincipalLockConfiguration `json:"servicePrincipalLockConfiguration,omitempty"`
+}
+
+// ServicePrincipalLockConfiguration describes which properties of service principals created from a multi-tenant
+// application are locked against modification in the tenants where they are provisioned (app instance property lock).
+type ServicePrincipalLockConfiguration struct {
+	AllProperties              *bool `json:"allProperties,omitempty"`
+	CredentialsWithUsageSign   *bool `json:"credentialsWithUsageSign,omitempty"`
+	CredentialsWithUsageVerify *bool `json:"credentialsWithUsageVerify,omitempty"`
+	IsEnabled                  *bool `json:"isEnabled,omitempty"`
+	TokenEncryptionKeyId       *bool `json:"tokenEncryptionKeyId,omitempty"`
+}
+
+// ApplicationPropertiesClient manages the properties of applications which are not supported by the application model
+// in the SDK, namely the SAML metadata URL and the service principal lock configuration.
+type ApplicationPropertiesClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewApplicationPropertiesClient returns a new ApplicationPropertiesClient
+func NewApplicationPropertiesClient() *ApplicationPropertiesClient {
+	return &ApplicationPropertiesClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10),
+	}
+}
+
+// Get retrieves the additional properties for an application. Properties which are not set are returned as nil.
+func (c *ApplicationPropertiesClient) Get(ctx context.Context, applicationId string) (*ApplicationProperties, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		OData: odata.Query{
+			Select: []string{"id", "samlMetadataUrl", "servicePrincipalLockConfiguration"},
+		},
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/applications/%s", applicationId),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ApplicationPropertiesClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var properties ApplicationProperties
+	if err := json.Unmarshal(respBody, &properties); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &properties, status, nil
+}
+
+// Update sets the additional properties for an application, in a single request.
+func (c *ApplicationPropertiesClient) Update(ctx context.Context, applicationId string, properties ApplicationProperties) (int, error) {
+	var status int
+
+	body, err := json.Marshal(properties)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/applications/%s", applicationId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ApplicationPropertiesClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
@@ -9,14 +9,22 @@ import (
 )
 
 type Client struct {
-	ApplicationsClient         *msgraph.ApplicationsClient
-	ApplicationsClientBeta     *msgraph.ApplicationsClient
-	ApplicationTemplatesClient *msgraph.ApplicationTemplatesClient
-	DirectoryObjectsClient     *msgraph.DirectoryObjectsClient
-	ServicePrincipalsClient    *msgraph.ServicePrincipalsClient
+	ApplicationPropertiesClient               *ApplicationPropertiesClient
+	ApplicationsClient                        *msgraph.ApplicationsClient
+	ApplicationsClientBeta                    *msgraph.ApplicationsClient
+	ApplicationTemplatesClient                *msgraph.ApplicationTemplatesClient
+	DelegatedPermissionGrantsClient           *msgraph.DelegatedPermissionGrantsClient
+	DirectoryObjectsClient                    *msgraph.DirectoryObjectsClient
+	FederatedIdentityCredentialsClient        *FederatedIdentityCredentialsClient
+	OwnedApplicationsClient                   *OwnedApplicationsClient
+	ServicePrincipalsAppRoleAssignmentsClient *msgraph.AppRoleAssignmentsClient
+	ServicePrincipalsClient                   *msgraph.ServicePrincipalsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	applicationPropertiesClient := NewApplicationPropertiesClient()
+	o.ConfigureClient(&applicationPropertiesClient.BaseClient)
+
 	applicationsClient := msgraph.NewApplicationsClient()
 	o.ConfigureClient(&applicationsClient.BaseClient)
 
@@ -29,17 +37,37 @@ func NewClient(o *common.ClientOptions) *Client {
 	applicationTemplatesClient := msgraph.NewApplicationTemplatesClient()
 	o.ConfigureClient(&applicationTemplatesClient.BaseClient)
 
+	delegatedPermissionGrantsClient := msgraph.NewDelegatedPermissionGrantsClient()
+	o.ConfigureClient(&delegatedPermissionGrantsClient.BaseClient)
+
 	directoryObjectsClient := msgraph.NewDirectoryObjectsClient()
 	o.ConfigureClient(&directoryObjectsClient.BaseClient)
 
+	federatedIdentityCredentialsClient := NewFederatedIdentityCredentialsClient()
+	o.ConfigureClient(&federatedIdentityCredentialsClient.BaseClient)
+
+	// Claims matching expressions are only supported in the beta API
+	federatedIdentityCredentialsClient.BaseClient.ApiVersion = msgraph.VersionBeta
+
+	ownedApplicationsClient := NewOwnedApplicationsClient()
+	o.ConfigureClient(&ownedApplicationsClient.BaseClient)
+
+	servicePrincipalsAppRoleAssignmentsClient := msgraph.NewServicePrincipalsAppRoleAssignmentsClient()
+	o.ConfigureClient(&servicePrincipalsAppRoleAssignmentsClient.BaseClient)
+
 	servicePrincipalsClient := msgraph.NewServicePrincipalsClient()
 	o.ConfigureClient(&servicePrincipalsClient.BaseClient)
 
 	return &Client{
-		ApplicationsClient:         applicationsClient,
-		ApplicationsClientBeta:     applicationsClientBeta,
-		ApplicationTemplatesClient: applicationTemplatesClient,
-		DirectoryObjectsClient:     directoryObjectsClient,
-		ServicePrincipalsClient:    servicePrincipalsClient,
+		ApplicationPropertiesClient:               applicationPropertiesClient,
+		ApplicationsClient:                        applicationsClient,
+		ApplicationsClientBeta:                    applicationsClientBeta,
+		ApplicationTemplatesClient:                applicationTemplatesClient,
+		DelegatedPermissionGrantsClient:           delegatedPermissionGrantsClient,
+		DirectoryObjectsClient:                    directoryObjectsClient,
+		FederatedIdentityCredentialsClient:        federatedIdentityCredentialsClient,
+		OwnedApplicationsClient:                   ownedApplicationsClient,
+		ServicePrincipalsAppRoleAssignmentsClient: servicePrincipalsAppRoleAssignmentsClient,
+		ServicePrincipalsClient:                   servicePrincipalsClient,
 	}
 }
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// FederatedIdentityExpressionLanguageVersion1 is the only language version currently supported for claims matching expressions
+const FederatedIdentityExpressionLanguageVersion1 = 1
+
+// FederatedIdentityCredential describes a federated identity credential for an application. This differs from the
+// model in the SDK by supporting claims matching expressions, which are mutually exclusive with the subject.
+type FederatedIdentityCredential struct {
+	Audiences                *[]string                    `json:"audiences,omitempty"`
+	ClaimsMatchingExpression *FederatedIdentityExpression `json:"claimsMatchingExpression"`
+	Description              *msgraph.StringNullWhenEmpty `json:"description,omitempty"`
+	ID                       *string                      `json:"id,omitempty"`
+	Issuer                   *string                      `json:"issuer,omitempty"`
+	Name                     *string                      `json:"name,omitempty"`
+	Subject                  *msgraph.StringNullWhenEmpty `json:"subject,omitempty"`
+}
+
+type FederatedIdentityExpression struct {
+	LanguageVersion *int    `json:"languageVersion,omitempty"`
+	Value           *string `json:"value,omitempty"`
+}
+
+// FederatedIdentityCredentialsClient performs operations on the federated identity credentials of applications.
+type FederatedIdentityCredentialsClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewFederatedIdentityCredentialsClient returns a new FederatedIdentityCredentialsClient
+func NewFederatedIdentityCredentialsClient() *FederatedIdentityCredentialsClient {
+	return &FederatedIdentityCredentialsClient{
+		BaseClient: msgraph.NewClient(msgraph.VersionBeta),
+	}
+}
+
+// List returns the federated identity credentials for an application.
+func (c *FederatedIdentityCredentialsClient) List(ctx context.Context, applicationId string, query odata.Query) (*[]FederatedIdentityCredential, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/applications/%s/federatedIdentityCredentials", applicationId),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("FederatedIdentityCredentialsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		FederatedIdentityCredentials []FederatedIdentityCredential `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.FederatedIdentityCredentials, status, nil
+}
+
+// Get retrieves a federated identity credential for an application.
+func (c *FederatedIdentityCredentialsClient) Get(ctx context.Context, applicationId, credentialId string, query odata.Query) (*FederatedIdentityCredential, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/applications/%s/federatedIdentityCredentials/%s", applicationId, credentialId),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("FederatedIdentityCredentialsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data FederatedIdentityCredential
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data, status, nil
+}
+
+// Create adds a new federated identity credential for an application.
+func (c *FederatedIdentityCredentialsClient) Create(ctx context.Context, applicationId string, credential FederatedIdentityCredential) (*FederatedIdentityCredential, int, error) {
+	var status int
+
+	body, err := json.Marshal(credential)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/applications/%s/federatedIdentityCredentials", applicationId),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("FederatedIdentityCredentialsClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var result FederatedIdentityCredential
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &result, status, nil
+}
+
+// Update amends an existing federated identity credential for an application. Since the claims matching expression is
+// always sent, a nil ClaimsMatchingExpression removes any existing expression.
+func (c *FederatedIdentityCredentialsClient) Update(ctx context.Context, applicationId string, credential FederatedIdentityCredential) (int, error) {
+	var status int
+
+	if credential.ID == nil {
+		return status, errors.New("FederatedIdentityCredentialsClient.Update(): cannot update federated identity credential with nil ID")
+	}
+
+	body, err := json.Marshal(credential)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/applications/%s/federatedIdentityCredentials/%s", applicationId, *credential.ID),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("FederatedIdentityCredentialsClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
+
+// Delete removes a federated identity credential from an application.
+func (c *FederatedIdentityCredentialsClient) Delete(ctx context.Context, applicationId, credentialId string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/applications/%s/federatedIdentityCredentials/%s", applicationId, credentialId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("FederatedIdentityCredentialsClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// OwnedApplicationsClient retrieves the applications owned by a user or a service principal, which is not supported by the SDK.
+type OwnedApplicationsClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewOwnedApplicationsClient returns a new OwnedApplicationsClient
+func NewOwnedApplicationsClient() *OwnedApplicationsClient {
+	return &OwnedApplicationsClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10),
+	}
+}
+
+// List returns the applications owned by the specified user or service principal. The ownerType should be the OData
+// type of the owner, as returned when retrieving it as a directory object.
+func (c *OwnedApplicationsClient) List(ctx context.Context, ownerType odata.Type, ownerId string, query odata.Query) (*[]msgraph.Application, int, error) {
+	var collection string
+	switch ownerType {
+	case odata.TypeUser:
+		collection = "users"
+	case odata.TypeServicePrincipal:
+		collection = "servicePrincipals"
+	default:
+		return nil, 0, fmt.Errorf("unsupported owner type %q", ownerType)
+	}
+
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/%s/%s/ownedObjects/microsoft.graph.application", collection, ownerId),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("OwnedApplicationsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		Applications []msgraph.Application `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.Applications, status, nil
+}
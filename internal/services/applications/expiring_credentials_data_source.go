@@ -0,0 +1,435 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+const (
+	expiringCredentialObjectTypeApplication      = "Application"
+	expiringCredentialObjectTypeServicePrincipal = "ServicePrincipal"
+
+	expiringCredentialTypeCertificate = "Certificate"
+	expiringCredentialTypePassword    = "Password"
+)
+
+func expiringCredentialsDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: expiringCredentialsDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"days": {
+				Description:  "Return credentials which expire within this number of days",
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"client_ids": {
+				Description: "Only return credentials for applications and service principals with these client IDs",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+
+			"tags": {
+				Description: "Only return credentials for applications and service principals having all of these tags",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"credential_type": {
+				Description:  "Only return credentials of this type",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{expiringCredentialTypeCertificate, expiringCredentialTypePassword}, false),
+			},
+
+			"include_expired": {
+				Description: "Whether to also return credentials which have already expired",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"credentials": {
+				Description: "A list of expiring credentials, ordered by expiry date",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"object_id": {
+							Description: "The object ID of the application or service principal to which the credential belongs",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"object_type": {
+							Description: "The type of object to which the credential belongs, either `Application` or `ServicePrincipal`",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"client_id": {
+							Description: "The client ID of the application or service principal to which the credential belongs",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"display_name": {
+							Description: "The display name of the application or service principal to which the credential belongs",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"credential_type": {
+							Description: "The type of credential, either `Certificate` or `Password`",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"key_id": {
+							Description: "The unique key ID of the credential",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"credential_display_name": {
+							Description: "The display name of the credential",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"usage": {
+							Description: "The usage of a certificate credential, either `Sign` or `Verify`",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"start_date": {
+							Description: "The start date from which the credential is valid, formatted as an RFC3339 date string",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"end_date": {
+							Description: "The end date until which the credential is valid, formatted as an RFC3339 date string",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"days_remaining": {
+							Description: "The number of whole days until the credential expires, which is negative for expired credentials",
+							Type:        pluginsdk.TypeInt,
+							Computed:    true,
+						},
+
+						"owners": {
+							Description: "The owners of the application or service principal to which the credential belongs",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"object_id": {
+										Description: "The object ID of the owner",
+										Type:        pluginsdk.TypeString,
+										Computed:    true,
+									},
+
+									"display_name": {
+										Description: "The display name of the owner",
+										Type:        pluginsdk.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// expiringCredential describes a credential belonging to an application or service principal
+type expiringCredential struct {
+	objectId       string
+	objectType     string
+	clientId       string
+	displayName    string
+	credentialType string
+	keyId          string
+	credentialName string
+	usage          string
+	startDate      *time.Time
+	endDate        time.Time
+}
+
+func expiringCredentialsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Applications
+	client.ApplicationsClient.BaseClient.DisableRetries = true
+	defer func() { client.ApplicationsClient.BaseClient.DisableRetries = false }()
+	client.ServicePrincipalsClient.BaseClient.DisableRetries = true
+	defer func() { client.ServicePrincipalsClient.BaseClient.DisableRetries = false }()
+
+	now := time.Now().UTC()
+	cutoff := now.AddDate(0, 0, d.Get("days").(int))
+	includeExpired := d.Get("include_expired").(bool)
+	credentialType := d.Get("credential_type").(string)
+	tags := tf.ExpandStringSlice(d.Get("tags").(*pluginsdk.Set).List())
+
+	// The client follows paging links in list responses, so each query retrieves all matching objects
+	queries := []odata.Query{{}}
+	if v := tf.ExpandStringSlice(d.Get("client_ids").([]interface{})); len(v) > 0 {
+		queries = make([]odata.Query, 0)
+		for _, clientId := range v {
+			queries = append(queries, odata.Query{Filter: fmt.Sprintf("appId eq '%s'", odata.EscapeSingleQuote(clientId))})
+		}
+	}
+
+	credentials := make([]expiringCredential, 0)
+
+	for _, query := range queries {
+		query.Select = []string{"id", "appId", "displayName", "keyCredentials", "passwordCredentials", "tags"}
+
+		applications, _, err := client.ApplicationsClient.List(ctx, query)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not retrieve applications")
+		}
+		if applications != nil {
+			for _, app := range *applications {
+				if !expiringCredentialsHasTags(app.Tags, tags) {
+					continue
+				}
+				credentials = append(credentials, expiringCredentialsFlatten(expiringCredentialObjectTypeApplication, pointer.From(app.ID()), pointer.From(app.AppId), pointer.From(app.DisplayName), app.KeyCredentials, app.PasswordCredentials)...)
+			}
+		}
+
+		servicePrincipals, _, err := client.ServicePrincipalsClient.List(ctx, query)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not retrieve service principals")
+		}
+		if servicePrincipals != nil {
+			for _, sp := range *servicePrincipals {
+				if !expiringCredentialsHasTags(sp.Tags, tags) {
+					continue
+				}
+				credentials = append(credentials, expiringCredentialsFlatten(expiringCredentialObjectTypeServicePrincipal, pointer.From(sp.ID()), pointer.From(sp.AppId), pointer.From(sp.DisplayName), sp.KeyCredentials, sp.PasswordCredentials)...)
+			}
+		}
+	}
+
+	// Filter by type and expiry date
+	result := make([]expiringCredential, 0)
+	for _, credential := range credentials {
+		if credentialType != "" && credential.credentialType != credentialType {
+			continue
+		}
+		if credential.endDate.After(cutoff) || (!includeExpired && credential.endDate.Before(now)) {
+			continue
+		}
+		result = append(result, credential)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].endDate.Before(result[j].endDate)
+	})
+
+	// Retrieve owners only for objects with matching credentials
+	owners := make(map[string][]string)
+	ownerIds := make([]string, 0)
+	seenOwnerIds := make(map[string]bool)
+	for _, credential := range result {
+		if _, ok := owners[credential.objectId]; ok {
+			continue
+		}
+
+		var objectOwners *[]string
+		var err error
+		switch credential.objectType {
+		case expiringCredentialObjectTypeApplication:
+			objectOwners, _, err = client.ApplicationsClient.ListOwners(ctx, credential.objectId)
+		case expiringCredentialObjectTypeServicePrincipal:
+			objectOwners, _, err = client.ServicePrincipalsClient.ListOwners(ctx, credential.objectId)
+		}
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not retrieve owners for %s with object ID %q", credential.objectType, credential.objectId)
+		}
+
+		owners[credential.objectId] = pointer.From(objectOwners)
+		for _, ownerId := range owners[credential.objectId] {
+			if !seenOwnerIds[ownerId] {
+				seenOwnerIds[ownerId] = true
+				ownerIds = append(ownerIds, ownerId)
+			}
+		}
+	}
+
+	ownerNames, err := expiringCredentialsOwnerNames(ctx, client.DirectoryObjectsClient, ownerIds)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not retrieve owner display names")
+	}
+
+	keyIds := make([]string, 0)
+	credentialsList := make([]map[string]interface{}, 0)
+	for _, credential := range result {
+		keyIds = append(keyIds, credential.keyId)
+
+		ownersList := make([]map[string]interface{}, 0)
+		for _, ownerId := range owners[credential.objectId] {
+			ownersList = append(ownersList, map[string]interface{}{
+				"object_id":    ownerId,
+				"display_name": ownerNames[ownerId],
+			})
+		}
+
+		startDate := ""
+		if credential.startDate != nil {
+			startDate = credential.startDate.Format(time.RFC3339)
+		}
+
+		credentialsList = append(credentialsList, map[string]interface{}{
+			"object_id":               credential.objectId,
+			"object_type":             credential.objectType,
+			"client_id":               credential.clientId,
+			"display_name":            credential.displayName,
+			"credential_type":         credential.credentialType,
+			"key_id":                  credential.keyId,
+			"credential_display_name": credential.credentialName,
+			"usage":                   credential.usage,
+			"start_date":              startDate,
+			"end_date":                credential.endDate.Format(time.RFC3339),
+			"days_remaining":          int(math.Floor(credential.endDate.Sub(now).Hours() / 24)),
+			"owners":                  ownersList,
+		})
+	}
+
+	// Generate a unique ID based on result
+	h := sha1.New()
+	if _, err := h.Write([]byte(fmt.Sprintf("%d/%s", d.Get("days").(int), strings.Join(keyIds, "/")))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for key IDs")
+	}
+
+	d.SetId("expiringcredentials#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	tf.Set(d, "credentials", credentialsList)
+
+	return nil
+}
+
+// expiringCredentialsFlatten returns the certificate and password credentials with an expiry date, for an application
+// or service principal
+func expiringCredentialsFlatten(objectType, objectId, clientId, displayName string, keyCredentials *[]msgraph.KeyCredential, passwordCredentials *[]msgraph.PasswordCredential) []expiringCredential {
+	result := make([]expiringCredential, 0)
+
+	if keyCredentials != nil {
+		for _, credential := range *keyCredentials {
+			if credential.EndDateTime == nil {
+				continue
+			}
+			result = append(result, expiringCredential{
+				objectId:       objectId,
+				objectType:     objectType,
+				clientId:       clientId,
+				displayName:    displayName,
+				credentialType: expiringCredentialTypeCertificate,
+				keyId:          pointer.From(credential.KeyId),
+				credentialName: pointer.From(credential.DisplayName),
+				usage:          credential.Usage,
+				startDate:      credential.StartDateTime,
+				endDate:        *credential.EndDateTime,
+			})
+		}
+	}
+
+	if passwordCredentials != nil {
+		for _, credential := range *passwordCredentials {
+			if credential.EndDateTime == nil {
+				continue
+			}
+			result = append(result, expiringCredential{
+				objectId:       objectId,
+				objectType:     objectType,
+				clientId:       clientId,
+				displayName:    displayName,
+				credentialType: expiringCredentialTypePassword,
+				keyId:          pointer.From(credential.KeyId),
+				credentialName: pointer.From(credential.DisplayName),
+				startDate:      credential.StartDateTime,
+				endDate:        *credential.EndDateTime,
+			})
+		}
+	}
+
+	return result
+}
+
+// expiringCredentialsHasTags determines whether the tags of an object include all the required tags
+func expiringCredentialsHasTags(tags *[]string, required []string) bool {
+	for _, tag := range required {
+		found := false
+		for _, v := range pointer.From(tags) {
+			if strings.EqualFold(v, tag) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// expiringCredentialsOwnerNames returns a map of object IDs to display names for the specified owners
+func expiringCredentialsOwnerNames(ctx context.Context, client *msgraph.DirectoryObjectsClient, ids []string) (map[string]string, error) {
+	result := make(map[string]string)
+
+	// The getByIds endpoint supports up to 1000 IDs per request
+	for start := 0; start < len(ids); start += 1000 {
+		end := start + 1000
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		objects, _, err := client.GetByIds(ctx, ids[start:end], []odata.ShortType{odata.ShortTypeServicePrincipal, odata.ShortTypeUser})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range pointer.From(objects) {
+			if object.ID() != nil {
+				result[*object.ID()] = pointer.From(object.DisplayName)
+			}
+		}
+	}
+
+	return result, nil
+}
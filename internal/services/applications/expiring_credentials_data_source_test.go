@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ExpiringCredentialsDataSource struct{}
+
+func TestAccExpiringCredentialsDataSource_byClientId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_expiring_credentials", "test")
+	r := ExpiringCredentialsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.byClientId(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("credentials.#").HasValue("1"),
+				check.That(data.ResourceName).Key("credentials.0.object_type").HasValue("Application"),
+				check.That(data.ResourceName).Key("credentials.0.credential_type").HasValue("Password"),
+				check.That(data.ResourceName).Key("credentials.0.display_name").HasValue(fmt.Sprintf("acctest-ExpiringCreds-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("credentials.0.key_id").IsUuid(),
+				check.That(data.ResourceName).Key("credentials.0.days_remaining").Exists(),
+				check.That(data.ResourceName).Key("credentials.0.owners.#").HasValue("1"),
+				check.That(data.ResourceName).Key("credentials.0.owners.0.display_name").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccExpiringCredentialsDataSource_filtered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_expiring_credentials", "test")
+	r := ExpiringCredentialsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.filtered(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("credentials.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (ExpiringCredentialsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_client_config" "current" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-ExpiringCreds-%[1]d"
+  owners       = [data.azuread_client_config.current.object_id]
+  tags         = ["acctest-expiring"]
+}
+
+resource "azuread_application_password" "test" {
+  application_id    = azuread_application.test.id
+  end_date_relative = "240h"
+}
+`, data.RandomInteger)
+}
+
+func (r ExpiringCredentialsDataSource) byClientId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_expiring_credentials" "test" {
+  days       = 30
+  client_ids = [azuread_application.test.client_id]
+  tags       = ["acctest-expiring"]
+
+  depends_on = [azuread_application_password.test]
+}
+`, r.template(data))
+}
+
+func (r ExpiringCredentialsDataSource) filtered(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_expiring_credentials" "test" {
+  days            = 30
+  client_ids      = [azuread_application.test.client_id]
+  credential_type = "Certificate"
+
+  depends_on = [azuread_application_password.test]
+}
+`, r.template(data))
+}
@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+const (
+	// federatedIdentityCredentialDefaultAudience is the audience recommended by Microsoft for workload identity
+	// federation, and the default audience requested by most supported identity providers
+	federatedIdentityCredentialDefaultAudience = "api://AzureADTokenExchange"
+
+	// federatedIdentityCredentialSubjectMaxLength is the maximum length of a subject accepted by Microsoft Graph
+	federatedIdentityCredentialSubjectMaxLength = 600
+
+	federatedIdentityCredentialGitHubIssuer = "https://token.actions.githubusercontent.com"
+
+	federatedIdentityCredentialGitHubEntityBranch      = "branch"
+	federatedIdentityCredentialGitHubEntityEnvironment = "environment"
+	federatedIdentityCredentialGitHubEntityPullRequest = "pull_request"
+	federatedIdentityCredentialGitHubEntityTag         = "tag"
+
+	federatedIdentityCredentialGitLabRefTypeBranch = "branch"
+	federatedIdentityCredentialGitLabRefTypeTag    = "tag"
+
+	federatedIdentityCredentialTerraformCloudRunPhaseApply = "apply"
+	federatedIdentityCredentialTerraformCloudRunPhasePlan  = "plan"
+)
+
+// federatedIdentityCredentialPresets lists the names of the preset blocks supported by the
+// `azuread_application_federated_identity_credential` resource, which are mutually exclusive with `issuer`
+var federatedIdentityCredentialPresets = []string{"github", "gitlab", "kubernetes", "terraform_cloud"}
+
+var (
+	federatedIdentityCredentialGitHubOrganizationRegex = regexp.MustCompile(`^[A-Za-z0-9](?:[A-Za-z0-9-]{0,38})$`)
+	federatedIdentityCredentialGitHubRepositoryRegex   = regexp.MustCompile(`^[A-Za-z0-9._-]{1,100}$`)
+	federatedIdentityCredentialGitLabProjectPathRegex  = regexp.MustCompile(`^[A-Za-z0-9_.][A-Za-z0-9_.-]*(?:/[A-Za-z0-9_.][A-Za-z0-9_.-]*)+$`)
+	federatedIdentityCredentialHostnameRegex           = regexp.MustCompile(`^(?:[A-Za-z0-9](?:[A-Za-z0-9-]{0,61}[A-Za-z0-9])?\.)*[A-Za-z0-9](?:[A-Za-z0-9-]{0,61}[A-Za-z0-9])?(?::[0-9]{1,5})?$`)
+	federatedIdentityCredentialKubernetesLabelRegex    = regexp.MustCompile(`^[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?$`)
+	federatedIdentityCredentialKubernetesNameRegex     = regexp.MustCompile(`^[a-z0-9](?:[a-z0-9.-]{0,251}[a-z0-9])?$`)
+	federatedIdentityCredentialTerraformCloudNameRegex = regexp.MustCompile(`^[A-Za-z0-9_-]{1,90}$`)
+)
+
+func federatedIdentityCredentialPresetConflicts(preset string) []string {
+	result := []string{"claims_matching_expression", "subject"}
+	for _, name := range federatedIdentityCredentialPresets {
+		if name != preset {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+func federatedIdentityCredentialGitHubSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description:   "Configures the credential to trust tokens issued by GitHub Actions",
+		Type:          pluginsdk.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: federatedIdentityCredentialPresetConflicts("github"),
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"organization": {
+					Description:  "The name of the GitHub organization or user that owns the repository",
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringMatch(federatedIdentityCredentialGitHubOrganizationRegex, "must be a valid GitHub organization or user name"),
+				},
+
+				"repository": {
+					Description:  "The name of the GitHub repository",
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringMatch(federatedIdentityCredentialGitHubRepositoryRegex, "must be a valid GitHub repository name"),
+				},
+
+				"entity": {
+					Description: "The type of entity that workflows must run for in order to exchange tokens",
+					Type:        pluginsdk.TypeString,
+					Required:    true,
+					ValidateFunc: validation.StringInSlice([]string{
+						federatedIdentityCredentialGitHubEntityBranch,
+						federatedIdentityCredentialGitHubEntityEnvironment,
+						federatedIdentityCredentialGitHubEntityPullRequest,
+						federatedIdentityCredentialGitHubEntityTag,
+					}, false),
+				},
+
+				"value": {
+					Description:  "The name of the branch, tag or environment that workflows must run for. Required unless `entity` is `pull_request`",
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+		},
+	}
+}
+
+func federatedIdentityCredentialGitLabSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description:   "Configures the credential to trust tokens issued by GitLab CI/CD",
+		Type:          pluginsdk.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: federatedIdentityCredentialPresetConflicts("gitlab"),
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"hostname": {
+					Description:  "The hostname of the GitLab instance",
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "gitlab.com",
+					ValidateFunc: validation.StringMatch(federatedIdentityCredentialHostnameRegex, "must be a valid hostname, without a scheme or path"),
+				},
+
+				"project_path": {
+					Description:  "The full path of the GitLab project, including any groups and subgroups",
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringMatch(federatedIdentityCredentialGitLabProjectPathRegex, "must be a valid GitLab project path, in the format `group/project`"),
+				},
+
+				"ref_type": {
+					Description: "The type of Git reference that pipelines must run for in order to exchange tokens",
+					Type:        pluginsdk.TypeString,
+					Required:    true,
+					ValidateFunc: validation.StringInSlice([]string{
+						federatedIdentityCredentialGitLabRefTypeBranch,
+						federatedIdentityCredentialGitLabRefTypeTag,
+					}, false),
+				},
+
+				"ref": {
+					Description:  "The name of the branch or tag that pipelines must run for",
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+		},
+	}
+}
+
+func federatedIdentityCredentialKubernetesSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description:   "Configures the credential to trust tokens issued to a Kubernetes service account",
+		Type:          pluginsdk.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: federatedIdentityCredentialPresetConflicts("kubernetes"),
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"issuer_url": {
+					Description:  "The OIDC issuer URL of the Kubernetes cluster",
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsURLWithHTTPS,
+				},
+
+				"namespace": {
+					Description:  "The namespace of the service account",
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringMatch(federatedIdentityCredentialKubernetesLabelRegex, "must be a valid Kubernetes namespace name"),
+				},
+
+				"service_account": {
+					Description:  "The name of the service account",
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringMatch(federatedIdentityCredentialKubernetesNameRegex, "must be a valid Kubernetes service account name"),
+				},
+			},
+		},
+	}
+}
+
+func federatedIdentityCredentialTerraformCloudSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description:   "Configures the credential to trust tokens issued to runs in HCP Terraform or Terraform Enterprise",
+		Type:          pluginsdk.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: federatedIdentityCredentialPresetConflicts("terraform_cloud"),
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"hostname": {
+					Description:  "The hostname of the HCP Terraform or Terraform Enterprise instance",
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "app.terraform.io",
+					ValidateFunc: validation.StringMatch(federatedIdentityCredentialHostnameRegex, "must be a valid hostname, without a scheme or path"),
+				},
+
+				"organization": {
+					Description:  "The name of the organization",
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringMatch(federatedIdentityCredentialTerraformCloudNameRegex, "must be a valid organization name"),
+				},
+
+				"project": {
+					Description:  "The name of the project containing the workspace",
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "Default Project",
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+
+				"workspace": {
+					Description:  "The name of the workspace",
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringMatch(federatedIdentityCredentialTerraformCloudNameRegex, "must be a valid workspace name"),
+				},
+
+				"run_phase": {
+					Description: "The run phase that tokens must be issued for",
+					Type:        pluginsdk.TypeString,
+					Required:    true,
+					ValidateFunc: validation.StringInSlice([]string{
+						federatedIdentityCredentialTerraformCloudRunPhaseApply,
+						federatedIdentityCredentialTerraformCloudRunPhasePlan,
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+// federatedIdentityCredentialPresetIssuerSubject computes the issuer and subject for the specified preset block
+func federatedIdentityCredentialPresetIssuerSubject(preset string, in map[string]interface{}) (issuer string, subject string, err error) {
+	switch preset {
+	case "github":
+		issuer = federatedIdentityCredentialGitHubIssuer
+		repo := fmt.Sprintf("repo:%s/%s", in["organization"].(string), in["repository"].(string))
+		value := in["value"].(string)
+
+		switch entity := in["entity"].(string); entity {
+		case federatedIdentityCredentialGitHubEntityPullRequest:
+			if value != "" {
+				return "", "", fmt.Errorf("`github.0.value` must not be specified when `github.0.entity` is %q", entity)
+			}
+			subject = fmt.Sprintf("%s:pull_request", repo)
+
+		default:
+			if value == "" {
+				return "", "", fmt.Errorf("`github.0.value` is required when `github.0.entity` is %q", entity)
+			}
+			switch entity {
+			case federatedIdentityCredentialGitHubEntityBranch:
+				subject = fmt.Sprintf("%s:ref:refs/heads/%s", repo, value)
+			case federatedIdentityCredentialGitHubEntityEnvironment:
+				subject = fmt.Sprintf("%s:environment:%s", repo, value)
+			case federatedIdentityCredentialGitHubEntityTag:
+				subject = fmt.Sprintf("%s:ref:refs/tags/%s", repo, value)
+			}
+		}
+
+	case "gitlab":
+		issuer = fmt.Sprintf("https://%s", in["hostname"].(string))
+		subject = fmt.Sprintf("project_path:%s:ref_type:%s:ref:%s", in["project_path"].(string), in["ref_type"].(string), in["ref"].(string))
+
+	case "kubernetes":
+		issuer = in["issuer_url"].(string)
+		subject = fmt.Sprintf("system:serviceaccount:%s:%s", in["namespace"].(string), in["service_account"].(string))
+
+	case "terraform_cloud":
+		project := in["project"].(string)
+		if strings.Contains(project, ":") {
+			return "", "", fmt.Errorf("`terraform_cloud.0.project` must not contain a colon")
+		}
+		issuer = fmt.Sprintf("https://%s", in["hostname"].(string))
+		subject = fmt.Sprintf("organization:%s:project:%s:workspace:%s:run_phase:%s", in["organization"].(string), project, in["workspace"].(string), in["run_phase"].(string))
+
+	default:
+		return "", "", fmt.Errorf("unsupported federated identity credential preset %q", preset)
+	}
+
+	if len(subject) > federatedIdentityCredentialSubjectMaxLength {
+		return "", "", fmt.Errorf("the subject computed for the `%s` block is %d characters long, which exceeds the maximum of %d characters", preset, len(subject), federatedIdentityCredentialSubjectMaxLength)
+	}
+
+	return
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+type AdminConsentId struct {
+	ApplicationId string
+}
+
+func NewAdminConsentID(applicationId string) *AdminConsentId {
+	return &AdminConsentId{
+		ApplicationId: applicationId,
+	}
+}
+
+// ParseAdminConsentID parses 'input' into an AdminConsentId
+func ParseAdminConsentID(input string) (*AdminConsentId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&AdminConsentId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := &AdminConsentId{}
+
+	if id.ApplicationId, ok = parsed.Parsed["applicationId"]; !ok {
+		return nil, resourceids.NewSegmentNotSpecifiedError(id, "applicationId", *parsed)
+	}
+
+	return id, nil
+}
+
+// ValidateAdminConsentID checks that 'input' can be parsed as an Application ID
+func ValidateAdminConsentID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	id, err := ParseAdminConsentID(v)
+	if err != nil {
+		errors = append(errors, err)
+		return
+	}
+
+	return validation.IsUUID(id.ApplicationId, "ID")
+}
+
+func (id *AdminConsentId) ID() string {
+	fmtString := "/applications/%s/adminConsent"
+	return fmt.Sprintf(fmtString, id.ApplicationId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this ID
+func (id *AdminConsentId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("applications", "applications", "applications"),
+		resourceids.UserSpecifiedSegment("applicationId", "00000000-0000-0000-0000-000000000000"),
+		resourceids.StaticSegment("adminConsent", "adminConsent", "adminConsent"),
+	}
+}
+
+func (id *AdminConsentId) String() string {
+	return fmt.Sprintf("Admin Consent (Application ID: %q)", id.ApplicationId)
+}
+
+func (id *AdminConsentId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.ApplicationId, ok = input.Parsed["applicationId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "applicationId", input)
+	}
+
+	return nil
+}
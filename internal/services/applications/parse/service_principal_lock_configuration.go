@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+type ServicePrincipalLockConfigurationId struct {
+	ApplicationId string
+}
+
+func NewServicePrincipalLockConfigurationID(applicationId string) *ServicePrincipalLockConfigurationId {
+	return &ServicePrincipalLockConfigurationId{
+		ApplicationId: applicationId,
+	}
+}
+
+// ParseServicePrincipalLockConfigurationID parses 'input' into a ServicePrincipalLockConfigurationId
+func ParseServicePrincipalLockConfigurationID(input string) (*ServicePrincipalLockConfigurationId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&ServicePrincipalLockConfigurationId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := &ServicePrincipalLockConfigurationId{}
+
+	if id.ApplicationId, ok = parsed.Parsed["applicationId"]; !ok {
+		return nil, resourceids.NewSegmentNotSpecifiedError(id, "applicationId", *parsed)
+	}
+
+	return id, nil
+}
+
+// ValidateServicePrincipalLockConfigurationID checks that 'input' can be parsed as an Application ID
+func ValidateServicePrincipalLockConfigurationID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	id, err := ParseServicePrincipalLockConfigurationID(v)
+	if err != nil {
+		errors = append(errors, err)
+		return
+	}
+
+	return validation.IsUUID(id.ApplicationId, "ID")
+}
+
+func (id *ServicePrincipalLockConfigurationId) ID() string {
+	fmtString := "/applications/%s/servicePrincipalLockConfiguration"
+	return fmt.Sprintf(fmtString, id.ApplicationId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this ID
+func (id *ServicePrincipalLockConfigurationId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("applications", "applications", "applications"),
+		resourceids.UserSpecifiedSegment("applicationId", "00000000-0000-0000-0000-000000000000"),
+		resourceids.StaticSegment("servicePrincipalLockConfiguration", "servicePrincipalLockConfiguration", "servicePrincipalLockConfiguration"),
+	}
+}
+
+func (id *ServicePrincipalLockConfigurationId) String() string {
+	return fmt.Sprintf("Service Principal Lock Configuration (Application ID: %q)", id.ApplicationId)
+}
+
+func (id *ServicePrincipalLockConfigurationId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.ApplicationId, ok = input.Parsed["applicationId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "applicationId", input)
+	}
+
+	return nil
+}
@@ -33,6 +33,8 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azuread_application":                   applicationDataSource(),
 		"azuread_application_published_app_ids": applicationPublishedAppIdsDataSource(),
 		"azuread_application_template":          applicationTemplateDataSource(),
+		"azuread_applications":                  applicationsDataSource(),
+		"azuread_expiring_credentials":          expiringCredentialsDataSource(),
 	}
 }
 
@@ -55,9 +57,11 @@ func (r Registration) DataSources() []sdk.DataSource {
 // Resources returns the typed Resources supported by this service
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		ApplicationAdminConsentResource{},
 		ApplicationApiAccessResource{},
 		ApplicationAppRoleResource{},
 		ApplicationFallbackPublicClientResource{},
+		ApplicationFromManifestResource{},
 		ApplicationFromTemplateResource{},
 		ApplicationIdentifierUriResource{},
 		ApplicationKnownClientsResource{},
@@ -66,5 +70,6 @@ func (r Registration) Resources() []sdk.Resource {
 		ApplicationPermissionScopeResource{},
 		ApplicationRedirectUrisResource{},
 		ApplicationRegistrationResource{},
+		ApplicationServicePrincipalLockConfigurationResource{},
 	}
 }
@@ -64,3 +64,43 @@ func schemaOptionalClaims() *pluginsdk.Schema {
 		},
 	}
 }
+
+// schemaServicePrincipalLockConfigurationProperties returns the properties of a service principal lock configuration,
+// which are shared between the `azuread_application` and `azuread_application_service_principal_lock_configuration` resources
+func schemaServicePrincipalLockConfigurationProperties() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"enabled": {
+			Description: "Whether the lock configuration is enabled",
+			Type:        pluginsdk.TypeBool,
+			Required:    true,
+		},
+
+		"all_properties": {
+			Description: "Whether all sensitive properties of the service principal are locked. When `true`, the other lock settings are ignored",
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+
+		"credentials_with_usage_sign": {
+			Description: "Whether adding, removing or updating credentials with a usage of `Sign` is locked",
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+
+		"credentials_with_usage_verify": {
+			Description: "Whether adding, removing or updating credentials with a usage of `Verify` (including password credentials) is locked",
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+
+		"token_encryption_key_id": {
+			Description: "Whether changing the token encryption key ID of the service principal is locked",
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+	}
+}
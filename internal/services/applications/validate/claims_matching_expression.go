@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+)
+
+// claimsMatchingExpressionConditionRegex matches a single condition of a claims matching expression, e.g.
+// `claims['sub'] matches 'repo:contoso/contoso-repo:ref:refs/heads/*'`
+var claimsMatchingExpressionConditionRegex = regexp.MustCompile(`^claims\['([A-Za-z_][A-Za-z0-9_]*)'\]\s+(\S+)\s+'([^']*)'`)
+
+// ClaimsMatchingExpression checks whether a value is a valid claims matching expression for a federated identity
+// credential, using version 1 of the expression language. An expression consists of one or more conditions joined by
+// `and`, where each condition compares a claim with a single-quoted string using either the `eq` operator (exact match)
+// or the `matches` operator (where `*` is a wildcard).
+// See https://learn.microsoft.com/en-us/entra/workload-id/workload-identities-flexible-federated-identity-credentials
+func ClaimsMatchingExpression(i interface{}, path cty.Path) (ret pluginsdk.Diagnostics) {
+	v, ok := i.(string)
+	if !ok {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Expected a string value",
+			AttributePath: path,
+		})
+		return
+	}
+
+	if err := parseClaimsMatchingExpression(v); err != nil {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid claims matching expression",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+	}
+
+	return
+}
+
+func parseClaimsMatchingExpression(expression string) error {
+	remaining := strings.TrimSpace(expression)
+	if remaining == "" {
+		return fmt.Errorf("expression must not be empty")
+	}
+
+	seen := make(map[string]bool)
+	for position := 1; ; position++ {
+		match := claimsMatchingExpressionConditionRegex.FindStringSubmatch(remaining)
+		if match == nil {
+			return fmt.Errorf("condition %d must be in the format `claims['<claim>'] <operator> '<value>'`, near %q", position, remaining)
+		}
+
+		claim, operator, value := match[1], match[2], match[3]
+
+		switch operator {
+		case "eq":
+			if strings.Contains(value, "*") {
+				return fmt.Errorf("condition %d compares claim %q using `eq`, which does not support wildcards - use `matches` instead", position, claim)
+			}
+		case "matches":
+		default:
+			return fmt.Errorf("condition %d uses unsupported operator %q, supported operators are `eq` and `matches`", position, operator)
+		}
+
+		if value == "" {
+			return fmt.Errorf("condition %d must not compare claim %q with an empty value", position, claim)
+		}
+
+		if seen[claim] {
+			return fmt.Errorf("claim %q is referenced by more than one condition", claim)
+		}
+		seen[claim] = true
+
+		remaining = strings.TrimSpace(remaining[len(match[0]):])
+		if remaining == "" {
+			return nil
+		}
+
+		fields := strings.Fields(remaining)
+		if fields[0] != "and" {
+			return fmt.Errorf("conditions must be joined with `and`, found %q", fields[0])
+		}
+		remaining = strings.TrimSpace(strings.TrimPrefix(remaining, "and"))
+	}
+}
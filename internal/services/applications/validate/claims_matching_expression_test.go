@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestClaimsMatchingExpression(t *testing.T) {
+	cases := []struct {
+		Value    string
+		TestName string
+		ErrCount int
+	}{
+		{
+			Value:    "claims['sub'] matches 'repo:contoso/contoso-repo:ref:refs/heads/*'",
+			TestName: "Valid_Matches",
+			ErrCount: 0,
+		},
+		{
+			Value:    "claims['sub'] eq 'repo:contoso/contoso-repo:environment:prod'",
+			TestName: "Valid_Eq",
+			ErrCount: 0,
+		},
+		{
+			Value:    "claims['sub'] matches 'repo:contoso/*:environment:prod' and claims['job_workflow_ref'] eq 'contoso/workflows/.github/workflows/deploy.yml@refs/heads/main'",
+			TestName: "Valid_And",
+			ErrCount: 0,
+		},
+		{
+			Value:    "  claims['sub']  matches  'repo:contoso/*'  ",
+			TestName: "Valid_ExtraWhitespace",
+			ErrCount: 0,
+		},
+		{
+			Value:    "",
+			TestName: "Invalid_Empty",
+			ErrCount: 1,
+		},
+		{
+			Value:    "claims['sub'] == 'repo:contoso/contoso-repo:environment:prod'",
+			TestName: "Invalid_Operator",
+			ErrCount: 1,
+		},
+		{
+			Value:    "claims['sub'] eq 'repo:contoso/*'",
+			TestName: "Invalid_EqWildcard",
+			ErrCount: 1,
+		},
+		{
+			Value:    "claims['sub'] matches ''",
+			TestName: "Invalid_EmptyValue",
+			ErrCount: 1,
+		},
+		{
+			Value:    "claims[\"sub\"] matches 'repo:contoso/*'",
+			TestName: "Invalid_DoubleQuotes",
+			ErrCount: 1,
+		},
+		{
+			Value:    "claims['sub'] matches repo:contoso/*",
+			TestName: "Invalid_UnquotedValue",
+			ErrCount: 1,
+		},
+		{
+			Value:    "claims['sub'] matches 'repo:contoso/*' or claims['job_workflow_ref'] matches 'contoso/*'",
+			TestName: "Invalid_Or",
+			ErrCount: 1,
+		},
+		{
+			Value:    "claims['sub'] matches 'repo:contoso/*' and",
+			TestName: "Invalid_TrailingAnd",
+			ErrCount: 1,
+		},
+		{
+			Value:    "claims['sub'] matches 'repo:contoso/*' and claims['sub'] matches 'repo:*/contoso-repo:*'",
+			TestName: "Invalid_DuplicateClaim",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			diags := ClaimsMatchingExpression(tc.Value, cty.Path{})
+
+			if len(diags) != tc.ErrCount {
+				t.Fatalf("Expected ClaimsMatchingExpression to have %d not %d errors for %q", tc.ErrCount, len(diags), tc.TestName)
+			}
+		})
+	}
+}
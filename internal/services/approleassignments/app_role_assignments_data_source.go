@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package approleassignments
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+func appRoleAssignmentsDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: appRoleAssignmentsDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_object_id": {
+				Description:      "The object ID of the service principal representing the resource, for which to list app role assignments",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				AtLeastOneOf:     []string{"principal_object_id", "resource_object_id"},
+				ValidateDiagFunc: validation.ValidateDiag(validation.IsUUID),
+			},
+
+			"principal_object_id": {
+				Description:      "The object ID of a user, group or service principal, for which to list the app role assignments it holds",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				AtLeastOneOf:     []string{"principal_object_id", "resource_object_id"},
+				ValidateDiagFunc: validation.ValidateDiag(validation.IsUUID),
+			},
+
+			"app_role_value": {
+				Description:      "Only return assignments of the app role with this value",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotEmpty),
+			},
+
+			"principal_type": {
+				Description:      "Only return assignments to principals of this type",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice([]string{"Group", "ServicePrincipal", "User"}, false)),
+			},
+
+			"app_role_assignments": {
+				Description: "A list of app role assignments",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Description: "The ID of the app role assignment",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"app_role_display_name": {
+							Description: "The display name of the assigned app role",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"app_role_id": {
+							Description: "The ID of the assigned app role",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"app_role_value": {
+							Description: "The value of the assigned app role",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"principal_display_name": {
+							Description: "The display name of the principal to which the app role is assigned",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"principal_object_id": {
+							Description: "The object ID of the principal to which the app role is assigned",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"principal_type": {
+							Description: "The object type of the principal to which the app role is assigned",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"resource_display_name": {
+							Description: "The display name of the service principal representing the resource",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"resource_object_id": {
+							Description: "The object ID of the service principal representing the resource",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+
+			"principal_object_ids": {
+				Description: "The object IDs of the principals to which app roles are assigned",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func appRoleAssignmentsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).AppRoleAssignments
+	appRoles := newAppRoleLookup(client.ServicePrincipalsClient)
+
+	resourceId := d.Get("resource_object_id").(string)
+	principalId := d.Get("principal_object_id").(string)
+
+	var result *[]msgraph.AppRoleAssignment
+
+	if principalId != "" {
+		directoryObject, status, err := client.DirectoryObjectsClient.Get(ctx, principalId, odata.Query{})
+		if err != nil {
+			if status == http.StatusNotFound {
+				return tf.ErrorDiagPathF(err, "principal_object_id", "Principal not found with object ID: %q", principalId)
+			}
+			return tf.ErrorDiagF(err, "Retrieving principal with object ID: %q", principalId)
+		}
+		if directoryObject == nil || directoryObject.ODataType == nil {
+			return tf.ErrorDiagF(errors.New("nil object or OData type returned"), "Bad API response for principal with object ID: %q", principalId)
+		}
+
+		var assignmentsClient *msgraph.AppRoleAssignmentsClient
+		switch *directoryObject.ODataType {
+		case odata.TypeUser:
+			assignmentsClient = client.UsersAppRoleAssignmentsClient
+		case odata.TypeGroup:
+			assignmentsClient = client.GroupsAppRoleAssignmentsClient
+		case odata.TypeServicePrincipal:
+			assignmentsClient = client.ServicePrincipalsAppRoleAssignmentsClient
+		default:
+			return tf.ErrorDiagPathF(fmt.Errorf("unsupported object type %q", *directoryObject.ODataType), "principal_object_id", "Principal with object ID %q is not a user, group or service principal", principalId)
+		}
+
+		if result, _, err = assignmentsClient.List(ctx, principalId, odata.Query{}); err != nil {
+			return tf.ErrorDiagF(err, "Listing app role assignments for principal with object ID: %q", principalId)
+		}
+	} else {
+		var status int
+		var err error
+		if result, status, err = client.AppRoleAssignedToClient.List(ctx, resourceId, odata.Query{}); err != nil {
+			if status == http.StatusNotFound {
+				return tf.ErrorDiagPathF(err, "resource_object_id", "Resource service principal not found with object ID: %q", resourceId)
+			}
+			return tf.ErrorDiagF(err, "Listing app role assignments for resource with object ID: %q", resourceId)
+		}
+	}
+	if result == nil {
+		return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+	}
+
+	appRoleValue := d.Get("app_role_value").(string)
+	principalType := d.Get("principal_type").(string)
+
+	assignments := make([]interface{}, 0)
+	assignmentIds := make([]string, 0)
+	principalIds := make([]string, 0)
+
+	for _, assignment := range *result {
+		if assignment.Id == nil || assignment.AppRoleId == nil || assignment.ResourceId == nil {
+			continue
+		}
+		if resourceId != "" && !strings.EqualFold(*assignment.ResourceId, resourceId) {
+			continue
+		}
+		if principalType != "" && (assignment.PrincipalType == nil || !strings.EqualFold(*assignment.PrincipalType, principalType)) {
+			continue
+		}
+
+		value, displayName := "", ""
+		if *assignment.AppRoleId == defaultAccessAppRoleId {
+			displayName = "Default Access"
+		} else {
+			appRole, err := appRoles.byId(ctx, *assignment.ResourceId, *assignment.AppRoleId)
+			if err != nil {
+				return tf.ErrorDiagF(err, "Resolving app role %q", *assignment.AppRoleId)
+			}
+			if appRole != nil {
+				if appRole.Value != nil {
+					value = *appRole.Value
+				}
+				if appRole.DisplayName != nil {
+					displayName = *appRole.DisplayName
+				}
+			}
+		}
+
+		if appRoleValue != "" && value != appRoleValue {
+			continue
+		}
+
+		assignments = append(assignments, map[string]interface{}{
+			"id":                     *assignment.Id,
+			"app_role_display_name":  displayName,
+			"app_role_id":            *assignment.AppRoleId,
+			"app_role_value":         value,
+			"principal_display_name": pointer.From(assignment.PrincipalDisplayName),
+			"principal_object_id":    pointer.From(assignment.PrincipalId),
+			"principal_type":         pointer.From(assignment.PrincipalType),
+			"resource_display_name":  pointer.From(assignment.ResourceDisplayName),
+			"resource_object_id":     *assignment.ResourceId,
+		})
+		assignmentIds = append(assignmentIds, *assignment.Id)
+		principalIds = append(principalIds, pointer.From(assignment.PrincipalId))
+	}
+
+	sort.Strings(assignmentIds)
+	h := sha1.New()
+	if _, err := h.Write([]byte(strings.Join(append([]string{resourceId, principalId, appRoleValue, principalType}, assignmentIds...), "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for app role assignment IDs")
+	}
+
+	d.SetId("approleassignments#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	tf.Set(d, "app_role_assignments", assignments)
+	tf.Set(d, "principal_object_ids", principalIds)
+
+	return nil
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package approleassignments_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type AppRoleAssignmentsDataSource struct{}
+
+func TestAccAppRoleAssignmentsDataSource_byResource(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_app_role_assignments", "test")
+	r := AppRoleAssignmentsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.byResource(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("app_role_assignments.#").HasValue("2"),
+				check.That(data.ResourceName).Key("principal_object_ids.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccAppRoleAssignmentsDataSource_byResourceWithFilters(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_app_role_assignments", "test")
+	r := AppRoleAssignmentsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.byResourceWithFilters(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("app_role_assignments.#").HasValue("1"),
+				check.That(data.ResourceName).Key("app_role_assignments.0.app_role_display_name").HasValue("Admin"),
+				check.That(data.ResourceName).Key("app_role_assignments.0.app_role_value").HasValue("Admin.All"),
+				check.That(data.ResourceName).Key("app_role_assignments.0.principal_display_name").HasValue(fmt.Sprintf("acctest-appRoleAssignments-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("app_role_assignments.0.principal_type").HasValue("Group"),
+			),
+		},
+	})
+}
+
+func TestAccAppRoleAssignmentsDataSource_byPrincipal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_app_role_assignments", "test")
+	r := AppRoleAssignmentsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.byPrincipal(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("app_role_assignments.#").HasValue("1"),
+				check.That(data.ResourceName).Key("app_role_assignments.0.app_role_value").HasValue("Query.All"),
+				check.That(data.ResourceName).Key("app_role_assignments.0.resource_display_name").HasValue(fmt.Sprintf("acctest-appRoleAssignments-internal-%d", data.RandomInteger)),
+			),
+		},
+	})
+}
+
+func (AppRoleAssignmentsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "internal" {
+  display_name = "acctest-appRoleAssignments-internal-%[1]d"
+
+  app_role {
+    allowed_member_types = ["Application", "User"]
+    description          = "Admins can perform all task actions"
+    display_name         = "Admin"
+    enabled              = true
+    id                   = "%[2]s"
+    value                = "Admin.All"
+  }
+
+  app_role {
+    allowed_member_types = ["Application"]
+    description          = "Apps can query the database"
+    display_name         = "Query"
+    enabled              = true
+    id                   = "%[3]s"
+    value                = "Query.All"
+  }
+}
+
+resource "azuread_service_principal" "internal" {
+  client_id = azuread_application.internal.client_id
+}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-appRoleAssignments-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+}
+
+resource "azuread_group" "test" {
+  display_name     = "acctest-appRoleAssignments-%[1]d"
+  security_enabled = true
+}
+
+resource "azuread_app_role_assignment" "test_group" {
+  app_role_id         = azuread_service_principal.internal.app_role_ids["Admin.All"]
+  principal_object_id = azuread_group.test.object_id
+  resource_object_id  = azuread_service_principal.internal.object_id
+}
+
+resource "azuread_app_role_assignment" "test_service_principal" {
+  app_role_id         = azuread_service_principal.internal.app_role_ids["Query.All"]
+  principal_object_id = azuread_service_principal.test.object_id
+  resource_object_id  = azuread_service_principal.internal.object_id
+}
+`, data.RandomInteger, data.UUID(), data.UUID())
+}
+
+func (r AppRoleAssignmentsDataSource) byResource(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_app_role_assignments" "test" {
+  resource_object_id = azuread_service_principal.internal.object_id
+
+  depends_on = [
+    azuread_app_role_assignment.test_group,
+    azuread_app_role_assignment.test_service_principal,
+  ]
+}
+`, r.template(data))
+}
+
+func (r AppRoleAssignmentsDataSource) byResourceWithFilters(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_app_role_assignments" "test" {
+  resource_object_id = azuread_service_principal.internal.object_id
+  app_role_value     = "Admin.All"
+  principal_type     = "Group"
+
+  depends_on = [
+    azuread_app_role_assignment.test_group,
+    azuread_app_role_assignment.test_service_principal,
+  ]
+}
+`, r.template(data))
+}
+
+func (r AppRoleAssignmentsDataSource) byPrincipal(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_app_role_assignments" "test" {
+  principal_object_id = azuread_service_principal.test.object_id
+
+  depends_on = [
+    azuread_app_role_assignment.test_service_principal,
+  ]
+}
+`, r.template(data))
+}
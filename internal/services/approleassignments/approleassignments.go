@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package approleassignments
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// defaultAccessAppRoleId is the app role ID used when assigning a principal to a resource which does not declare any
+// app roles
+const defaultAccessAppRoleId = "00000000-0000-0000-0000-000000000000"
+
+// appRoleLookup retrieves and caches the app roles of resource service principals, so that app role IDs can be
+// resolved to their values and display names
+type appRoleLookup struct {
+	client   *msgraph.ServicePrincipalsClient
+	appRoles map[string][]msgraph.AppRole
+}
+
+func newAppRoleLookup(client *msgraph.ServicePrincipalsClient) *appRoleLookup {
+	return &appRoleLookup{
+		client:   client,
+		appRoles: make(map[string][]msgraph.AppRole),
+	}
+}
+
+// list returns the app roles declared by a resource service principal
+func (l *appRoleLookup) list(ctx context.Context, resourceId string) ([]msgraph.AppRole, error) {
+	if appRoles, ok := l.appRoles[strings.ToLower(resourceId)]; ok {
+		return appRoles, nil
+	}
+
+	servicePrincipal, _, err := l.client.Get(ctx, resourceId, odata.Query{
+		Select: []string{"appRoles"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving resource service principal with object ID %q: %v", resourceId, err)
+	}
+
+	appRoles := make([]msgraph.AppRole, 0)
+	if servicePrincipal.AppRoles != nil {
+		appRoles = *servicePrincipal.AppRoles
+	}
+	l.appRoles[strings.ToLower(resourceId)] = appRoles
+
+	return appRoles, nil
+}
+
+// byId returns the app role with the given ID declared by a resource service principal, or nil if it is not found
+func (l *appRoleLookup) byId(ctx context.Context, resourceId, appRoleId string) (*msgraph.AppRole, error) {
+	appRoles, err := l.list(ctx, resourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, appRole := range appRoles {
+		if appRole.ID != nil && strings.EqualFold(*appRole.ID, appRoleId) {
+			return &appRole, nil
+		}
+	}
+
+	return nil, nil
+}
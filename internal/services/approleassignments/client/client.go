@@ -9,19 +9,39 @@ import (
 )
 
 type Client struct {
-	AppRoleAssignedToClient *msgraph.AppRoleAssignedToClient
-	ServicePrincipalsClient *msgraph.ServicePrincipalsClient
+	AppRoleAssignedToClient                   *msgraph.AppRoleAssignedToClient
+	DirectoryObjectsClient                    *msgraph.DirectoryObjectsClient
+	GroupsAppRoleAssignmentsClient            *msgraph.AppRoleAssignmentsClient
+	ServicePrincipalsAppRoleAssignmentsClient *msgraph.AppRoleAssignmentsClient
+	ServicePrincipalsClient                   *msgraph.ServicePrincipalsClient
+	UsersAppRoleAssignmentsClient             *msgraph.AppRoleAssignmentsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	appRoleAssignedToClient := msgraph.NewAppRoleAssignedToClient()
 	o.ConfigureClient(&appRoleAssignedToClient.BaseClient)
 
+	directoryObjectsClient := msgraph.NewDirectoryObjectsClient()
+	o.ConfigureClient(&directoryObjectsClient.BaseClient)
+
+	groupsAppRoleAssignmentsClient := msgraph.NewGroupsAppRoleAssignmentsClient()
+	o.ConfigureClient(&groupsAppRoleAssignmentsClient.BaseClient)
+
+	servicePrincipalsAppRoleAssignmentsClient := msgraph.NewServicePrincipalsAppRoleAssignmentsClient()
+	o.ConfigureClient(&servicePrincipalsAppRoleAssignmentsClient.BaseClient)
+
 	servicePrincipalsClient := msgraph.NewServicePrincipalsClient()
 	o.ConfigureClient(&servicePrincipalsClient.BaseClient)
 
+	usersAppRoleAssignmentsClient := msgraph.NewUsersAppRoleAssignmentsClient()
+	o.ConfigureClient(&usersAppRoleAssignmentsClient.BaseClient)
+
 	return &Client{
-		AppRoleAssignedToClient: appRoleAssignedToClient,
-		ServicePrincipalsClient: servicePrincipalsClient,
+		AppRoleAssignedToClient:                   appRoleAssignedToClient,
+		DirectoryObjectsClient:                    directoryObjectsClient,
+		GroupsAppRoleAssignmentsClient:            groupsAppRoleAssignmentsClient,
+		ServicePrincipalsAppRoleAssignmentsClient: servicePrincipalsAppRoleAssignmentsClient,
+		ServicePrincipalsClient:                   servicePrincipalsClient,
+		UsersAppRoleAssignmentsClient:             usersAppRoleAssignmentsClient,
 	}
 }
@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package approleassignments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+func servicePrincipalAppRoleAssignmentsResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: servicePrincipalAppRoleAssignmentsResourceCreate,
+		ReadContext:   servicePrincipalAppRoleAssignmentsResourceRead,
+		UpdateContext: servicePrincipalAppRoleAssignmentsResourceUpdate,
+		DeleteContext: servicePrincipalAppRoleAssignmentsResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_object_id": {
+				Description:      "The object ID of the service principal representing the resource",
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.IsUUID),
+			},
+
+			"assignment": {
+				Description: "The complete set of app role assignments for the resource. Any other assignments are removed",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"app_role_id": {
+							Description:      "The ID of the app role to be assigned, or `00000000-0000-0000-0000-000000000000` for default access",
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ValidateDiag(validation.IsUUID),
+						},
+
+						"principal_object_id": {
+							Description:      "The object ID of the user, group or service principal to be assigned the app role",
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ValidateDiag(validation.IsUUID),
+						},
+					},
+				},
+			},
+
+			"resource_display_name": {
+				Description: "The display name of the service principal representing the resource",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func servicePrincipalAppRoleAssignmentsResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).AppRoleAssignments.ServicePrincipalsClient
+	resourceId := d.Get("resource_object_id").(string)
+
+	if _, status, err := client.Get(ctx, resourceId, odata.Query{}); err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(err, "resource_object_id", "Service principal not found for resource (Object ID: %q)", resourceId)
+		}
+		return tf.ErrorDiagF(err, "Could not retrieve service principal for resource (Object ID: %q)", resourceId)
+	}
+
+	if err := servicePrincipalAppRoleAssignmentsReconcile(ctx, client, resourceId, d.Get("assignment").(*pluginsdk.Set).List()); err != nil {
+		return tf.ErrorDiagF(err, "Could not reconcile app role assignments for resource (Object ID: %q)", resourceId)
+	}
+
+	d.SetId(resourceId)
+
+	return servicePrincipalAppRoleAssignmentsResourceRead(ctx, d, meta)
+}
+
+func servicePrincipalAppRoleAssignmentsResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).AppRoleAssignments.ServicePrincipalsClient
+
+	if err := servicePrincipalAppRoleAssignmentsReconcile(ctx, client, d.Id(), d.Get("assignment").(*pluginsdk.Set).List()); err != nil {
+		return tf.ErrorDiagF(err, "Could not reconcile app role assignments for resource (Object ID: %q)", d.Id())
+	}
+
+	return servicePrincipalAppRoleAssignmentsResourceRead(ctx, d, meta)
+}
+
+func servicePrincipalAppRoleAssignmentsResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).AppRoleAssignments.ServicePrincipalsClient
+	resourceId := d.Id()
+
+	servicePrincipal, status, err := client.Get(ctx, resourceId, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Resource Service Principal %q was not found - removing from state!", resourceId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving service principal for resource (Object ID: %q)", resourceId)
+	}
+
+	appRoleAssignments, _, err := client.ListAppRoleAssignments(ctx, resourceId, odata.Query{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing app role assignments for resource (Object ID: %q)", resourceId)
+	}
+	if appRoleAssignments == nil {
+		return tf.ErrorDiagF(errors.New("appRoleAssignments was nil"), "Listing app role assignments for resource (Object ID: %q)", resourceId)
+	}
+
+	assignments := make([]interface{}, 0)
+	for _, assignment := range *appRoleAssignments {
+		if assignment.AppRoleId == nil || assignment.PrincipalId == nil {
+			continue
+		}
+		assignments = append(assignments, map[string]interface{}{
+			"app_role_id":         strings.ToLower(*assignment.AppRoleId),
+			"principal_object_id": strings.ToLower(*assignment.PrincipalId),
+		})
+	}
+
+	tf.Set(d, "assignment", assignments)
+	tf.Set(d, "resource_display_name", servicePrincipal.DisplayName)
+	tf.Set(d, "resource_object_id", resourceId)
+
+	return nil
+}
+
+func servicePrincipalAppRoleAssignmentsResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).AppRoleAssignments.ServicePrincipalsClient
+
+	if err := servicePrincipalAppRoleAssignmentsReconcile(ctx, client, d.Id(), []interface{}{}); err != nil {
+		return tf.ErrorDiagF(err, "Could not remove app role assignments for resource (Object ID: %q)", d.Id())
+	}
+
+	return nil
+}
+
+// servicePrincipalAppRoleAssignmentsKey returns a key identifying an app role assigned to a principal
+func servicePrincipalAppRoleAssignmentsKey(principalId, appRoleId string) string {
+	return strings.ToLower(principalId + "/" + appRoleId)
+}
+
+// servicePrincipalAppRoleAssignmentsReconcile adds the desired app role assignments which do not exist, removes any
+// existing assignments which are not desired, and waits for the assignments for the resource to match
+func servicePrincipalAppRoleAssignmentsReconcile(ctx context.Context, client *msgraph.ServicePrincipalsClient, resourceId string, desiredRaw []interface{}) error {
+	desired := make(map[string]bool)
+	for _, raw := range desiredRaw {
+		if raw == nil {
+			continue
+		}
+		assignment := raw.(map[string]interface{})
+		desired[servicePrincipalAppRoleAssignmentsKey(assignment["principal_object_id"].(string), assignment["app_role_id"].(string))] = true
+	}
+
+	existingAssignments, _, err := client.ListAppRoleAssignments(ctx, resourceId, odata.Query{})
+	if err != nil {
+		return fmt.Errorf("listing existing app role assignments: %v", err)
+	}
+	if existingAssignments == nil {
+		return errors.New("listing existing app role assignments: result was nil")
+	}
+
+	existing := make(map[string]bool)
+	for _, assignment := range *existingAssignments {
+		if assignment.Id == nil || assignment.AppRoleId == nil || assignment.PrincipalId == nil {
+			continue
+		}
+		key := servicePrincipalAppRoleAssignmentsKey(*assignment.PrincipalId, *assignment.AppRoleId)
+		if desired[key] && !existing[key] {
+			existing[key] = true
+			continue
+		}
+
+		log.Printf("[DEBUG] Removing app role assignment %q for principal %q from resource %q", *assignment.Id, *assignment.PrincipalId, resourceId)
+		if _, err := client.RemoveAppRoleAssignment(ctx, resourceId, *assignment.Id); err != nil {
+			return fmt.Errorf("removing app role assignment %q for principal %q: %v", *assignment.Id, *assignment.PrincipalId, err)
+		}
+	}
+
+	for _, raw := range desiredRaw {
+		if raw == nil {
+			continue
+		}
+		assignment := raw.(map[string]interface{})
+		principalId := assignment["principal_object_id"].(string)
+		appRoleId := assignment["app_role_id"].(string)
+
+		if existing[servicePrincipalAppRoleAssignmentsKey(principalId, appRoleId)] {
+			continue
+		}
+
+		log.Printf("[DEBUG] Assigning app role %q for resource %q to principal %q", appRoleId, resourceId, principalId)
+		if _, _, err := client.AssignAppRoleForResource(ctx, principalId, resourceId, appRoleId); err != nil {
+			return fmt.Errorf("assigning app role %q to principal %q: %v", appRoleId, principalId, err)
+		}
+	}
+
+	// Wait for the assignments to be consistent, since they are not immediately reflected when listing them
+	timeout, _ := ctx.Deadline()
+	if _, err = (&pluginsdk.StateChangeConf{ //nolint:staticcheck
+		Pending:                   []string{"Waiting"},
+		Target:                    []string{"Done"},
+		Timeout:                   time.Until(timeout),
+		MinTimeout:                1 * time.Second,
+		ContinuousTargetOccurence: 3,
+		Refresh: func() (interface{}, string, error) {
+			assignments, _, err := client.ListAppRoleAssignments(ctx, resourceId, odata.Query{})
+			if err != nil {
+				return nil, "Error", err
+			}
+			if assignments == nil {
+				return nil, "Error", errors.New("result was nil")
+			}
+
+			found := make(map[string]bool)
+			for _, assignment := range *assignments {
+				if assignment.AppRoleId == nil || assignment.PrincipalId == nil {
+					continue
+				}
+				key := servicePrincipalAppRoleAssignmentsKey(*assignment.PrincipalId, *assignment.AppRoleId)
+				if !desired[key] {
+					return assignments, "Waiting", nil
+				}
+				found[key] = true
+			}
+			if len(found) != len(desired) {
+				return assignments, "Waiting", nil
+			}
+
+			return assignments, "Done", nil
+		},
+	}).WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for app role assignments: %v", err)
+	}
+
+	return nil
+}
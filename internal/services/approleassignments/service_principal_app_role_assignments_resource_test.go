@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package approleassignments_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type ServicePrincipalAppRoleAssignmentsResource struct{}
+
+func TestAccServicePrincipalAppRoleAssignments_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_app_role_assignments", "test")
+	r := ServicePrincipalAppRoleAssignmentsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("assignment.#").HasValue("2"),
+				check.That(data.ResourceName).Key("resource_display_name").HasValue(fmt.Sprintf("acctest-spAppRoleAssignments-internal-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServicePrincipalAppRoleAssignments_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_app_role_assignments", "test")
+	r := ServicePrincipalAppRoleAssignmentsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("assignment.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("assignment.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.empty(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("assignment.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ServicePrincipalAppRoleAssignmentsResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.AppRoleAssignments.ServicePrincipalsClient
+	client.BaseClient.DisableRetries = true
+	defer func() { client.BaseClient.DisableRetries = false }()
+
+	if _, status, err := client.Get(ctx, state.ID, odata.Query{}); err != nil {
+		if status == http.StatusNotFound {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve Resource Service Principal with ID %q: %+v", state.ID, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (ServicePrincipalAppRoleAssignmentsResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "internal" {
+  display_name = "acctest-spAppRoleAssignments-internal-%[1]d"
+
+  app_role {
+    allowed_member_types = ["Application", "User"]
+    description          = "Admins can perform all task actions"
+    display_name         = "Admin"
+    enabled              = true
+    id                   = "%[2]s"
+    value                = "Admin.All"
+  }
+
+  app_role {
+    allowed_member_types = ["Application", "User"]
+    description          = "Readers can view all tasks"
+    display_name         = "Reader"
+    enabled              = true
+    id                   = "%[3]s"
+    value                = "Read.All"
+  }
+}
+
+resource "azuread_service_principal" "internal" {
+  client_id = azuread_application.internal.client_id
+}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-spAppRoleAssignments-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+}
+
+resource "azuread_group" "test" {
+  display_name     = "acctest-spAppRoleAssignments-%[1]d"
+  security_enabled = true
+}
+`, data.RandomInteger, data.UUID(), data.UUID())
+}
+
+func (r ServicePrincipalAppRoleAssignmentsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_app_role_assignments" "test" {
+  resource_object_id = azuread_service_principal.internal.object_id
+
+  assignment {
+    app_role_id         = azuread_service_principal.internal.app_role_ids["Admin.All"]
+    principal_object_id = azuread_group.test.object_id
+  }
+
+  assignment {
+    app_role_id         = azuread_service_principal.internal.app_role_ids["Read.All"]
+    principal_object_id = azuread_service_principal.test.object_id
+  }
+}
+`, r.template(data))
+}
+
+func (r ServicePrincipalAppRoleAssignmentsResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_app_role_assignments" "test" {
+  resource_object_id = azuread_service_principal.internal.object_id
+
+  assignment {
+    app_role_id         = azuread_service_principal.internal.app_role_ids["Read.All"]
+    principal_object_id = azuread_group.test.object_id
+  }
+
+  assignment {
+    app_role_id         = azuread_service_principal.internal.app_role_ids["Read.All"]
+    principal_object_id = azuread_service_principal.test.object_id
+  }
+}
+`, r.template(data))
+}
+
+func (r ServicePrincipalAppRoleAssignmentsResource) empty(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_app_role_assignments" "test" {
+  resource_object_id = azuread_service_principal.internal.object_id
+}
+`, r.template(data))
+}
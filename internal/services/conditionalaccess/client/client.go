@@ -11,6 +11,7 @@ import (
 type Client struct {
 	NamedLocationsClient *msgraph.NamedLocationsClient
 	PoliciesClient       *msgraph.ConditionalAccessPoliciesClient
+	TemplatesClient      *ConditionalAccessTemplatesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -20,8 +21,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	policiesClient := msgraph.NewConditionalAccessPoliciesClient()
 	o.ConfigureClient(&policiesClient.BaseClient)
 
+	templatesClient := NewConditionalAccessTemplatesClient()
+	o.ConfigureClient(&templatesClient.BaseClient)
+
 	return &Client{
 		NamedLocationsClient: namedLocationsClient,
 		PoliciesClient:       policiesClient,
+		TemplatesClient:      templatesClient,
 	}
 }
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// ConditionalAccessTemplate describes a template from the Microsoft catalogue of conditional access policies.
+type ConditionalAccessTemplate struct {
+	ID          *string                          `json:"id,omitempty"`
+	Name        *string                          `json:"name,omitempty"`
+	Description *string                          `json:"description,omitempty"`
+	Scenarios   *string                          `json:"scenarios,omitempty"`
+	Details     *ConditionalAccessTemplateDetail `json:"details,omitempty"`
+}
+
+// ConditionalAccessTemplateDetail holds the policy settings which are applied when a template is used.
+type ConditionalAccessTemplateDetail struct {
+	Conditions      *msgraph.ConditionalAccessConditionSet    `json:"conditions,omitempty"`
+	GrantControls   *msgraph.ConditionalAccessGrantControls   `json:"grantControls,omitempty"`
+	SessionControls *msgraph.ConditionalAccessSessionControls `json:"sessionControls,omitempty"`
+}
+
+// ConditionalAccessTemplatesClient performs operations on ConditionalAccessTemplate.
+type ConditionalAccessTemplatesClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewConditionalAccessTemplatesClient returns a new ConditionalAccessTemplatesClient
+func NewConditionalAccessTemplatesClient() *ConditionalAccessTemplatesClient {
+	return &ConditionalAccessTemplatesClient{
+		BaseClient: msgraph.NewClient(msgraph.VersionBeta),
+	}
+}
+
+// List returns a list of ConditionalAccessTemplate, optionally queried using OData.
+func (c *ConditionalAccessTemplatesClient) List(ctx context.Context, query odata.Query) (*[]ConditionalAccessTemplate, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: "/identity/conditionalAccess/templates",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ConditionalAccessTemplatesClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		Templates []ConditionalAccessTemplate `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.Templates, status, nil
+}
+
+// Get retrieves a ConditionalAccessTemplate.
+func (c *ConditionalAccessTemplatesClient) Get(ctx context.Context, id string, query odata.Query) (*ConditionalAccessTemplate, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/identity/conditionalAccess/templates/%s", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ConditionalAccessTemplatesClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var template ConditionalAccessTemplate
+	if err := json.Unmarshal(respBody, &template); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &template, status, nil
+}
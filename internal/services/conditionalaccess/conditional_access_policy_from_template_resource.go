@@ -0,0 +1,320 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/conditionalaccess/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+func conditionalAccessPolicyFromTemplateResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: conditionalAccessPolicyFromTemplateResourceCreate,
+		ReadContext:   conditionalAccessPolicyFromTemplateResourceRead,
+		UpdateContext: conditionalAccessPolicyFromTemplateResourceUpdate,
+		DeleteContext: conditionalAccessPolicyFromTemplateResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(15 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, errs := parse.ValidatePolicyFromTemplateID(id, "id")
+			if len(errs) > 0 {
+				return errs[0]
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"template_id": {
+				Description:  "The ID of the conditional access template from which to create the policy",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"display_name": {
+				Description:  "The display name for the policy. Defaults to the name of the template",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"state": {
+				Description: "Specifies the state of the policy",
+				Type:        pluginsdk.TypeString,
+				Optional:    true,
+				Default:     msgraph.ConditionalAccessPolicyStateEnabledForReportingButNotEnforced,
+				ValidateFunc: validation.StringInSlice([]string{
+					msgraph.ConditionalAccessPolicyStateDisabled,
+					msgraph.ConditionalAccessPolicyStateEnabled,
+					msgraph.ConditionalAccessPolicyStateEnabledForReportingButNotEnforced,
+				}, false),
+			},
+
+			"excluded_users": {
+				Description: "A set of user object IDs to exclude from the policy, in addition to any exclusions defined by the template",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+
+			"excluded_groups": {
+				Description: "A set of group object IDs to exclude from the policy, in addition to any exclusions defined by the template",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+
+			"excluded_roles": {
+				Description: "A set of directory role template IDs to exclude from the policy, in addition to any exclusions defined by the template",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+
+			"policy_id": {
+				Description: "The object ID of the conditional access policy",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"template_name": {
+				Description: "The name of the template from which the policy was created",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"conditions":       conditionalAccessPolicyComputedSchema("conditions"),
+			"grant_controls":   conditionalAccessPolicyComputedSchema("grant_controls"),
+			"session_controls": conditionalAccessPolicyComputedSchema("session_controls"),
+		},
+	}
+}
+
+func conditionalAccessPolicyFromTemplateResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.PoliciesClient
+	templatesClient := meta.(*clients.Client).ConditionalAccess.TemplatesClient
+
+	templateId := d.Get("template_id").(string)
+
+	template, status, err := templatesClient.Get(ctx, templateId, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "template_id", "Conditional access template with ID %q was not found", templateId)
+		}
+		return tf.ErrorDiagPathF(err, "template_id", "Retrieving conditional access template with ID %q", templateId)
+	}
+	if template == nil || template.Details == nil || template.Details.Conditions == nil {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Policy details for conditional access template with ID %q were nil", templateId)
+	}
+
+	displayName := d.Get("display_name").(string)
+	if displayName == "" {
+		displayName = pointer.From(template.Name)
+	}
+
+	properties := msgraph.ConditionalAccessPolicy{
+		DisplayName:     pointer.To(displayName),
+		State:           pointer.To(d.Get("state").(string)),
+		Conditions:      template.Details.Conditions,
+		GrantControls:   template.Details.GrantControls,
+		SessionControls: template.Details.SessionControls,
+	}
+
+	if properties.Conditions.Users == nil {
+		properties.Conditions.Users = &msgraph.ConditionalAccessUsers{}
+	}
+	sanitiseConditionalAccessTemplateUsers(properties.Conditions.Users)
+
+	users := properties.Conditions.Users
+	users.ExcludeUsers = updateConditionalAccessExclusions(users.ExcludeUsers, nil, tf.ExpandStringSlice(d.Get("excluded_users").(*pluginsdk.Set).List()))
+	users.ExcludeGroups = updateConditionalAccessExclusions(users.ExcludeGroups, nil, tf.ExpandStringSlice(d.Get("excluded_groups").(*pluginsdk.Set).List()))
+	users.ExcludeRoles = updateConditionalAccessExclusions(users.ExcludeRoles, nil, tf.ExpandStringSlice(d.Get("excluded_roles").(*pluginsdk.Set).List()))
+
+	policy, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not create conditional access policy from template %q", templateId)
+	}
+
+	if policy.ID == nil || *policy.ID == "" {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Object ID returned for conditional access policy is nil/empty")
+	}
+
+	id := parse.NewPolicyFromTemplateID(templateId, *policy.ID)
+	d.SetId(id.ID())
+
+	tf.Set(d, "template_name", template.Name)
+
+	return conditionalAccessPolicyFromTemplateResourceRead(ctx, d, meta)
+}
+
+func conditionalAccessPolicyFromTemplateResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.PoliciesClient
+
+	id, err := parse.ParsePolicyFromTemplateID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing conditional access policy from template ID %q", d.Id())
+	}
+
+	policy, _, err := client.Get(ctx, id.PolicyId, odata.Query{})
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Retrieving conditional access policy with ID %q", id.PolicyId)
+	}
+	if policy == nil || policy.Conditions == nil {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Conditions for conditional access policy with ID %q were nil", id.PolicyId)
+	}
+
+	properties := msgraph.ConditionalAccessPolicy{
+		ID:              pointer.To(id.PolicyId),
+		DisplayName:     pointer.To(d.Get("display_name").(string)),
+		State:           pointer.To(d.Get("state").(string)),
+		Conditions:      policy.Conditions,
+		GrantControls:   policy.GrantControls,
+		SessionControls: policy.SessionControls,
+	}
+
+	if d.HasChanges("excluded_users", "excluded_groups", "excluded_roles") {
+		if properties.Conditions.Users == nil {
+			properties.Conditions.Users = &msgraph.ConditionalAccessUsers{}
+		}
+		users := properties.Conditions.Users
+
+		oldUsers, newUsers := d.GetChange("excluded_users")
+		users.ExcludeUsers = updateConditionalAccessExclusions(users.ExcludeUsers, tf.ExpandStringSlice(oldUsers.(*pluginsdk.Set).List()), tf.ExpandStringSlice(newUsers.(*pluginsdk.Set).List()))
+
+		oldGroups, newGroups := d.GetChange("excluded_groups")
+		users.ExcludeGroups = updateConditionalAccessExclusions(users.ExcludeGroups, tf.ExpandStringSlice(oldGroups.(*pluginsdk.Set).List()), tf.ExpandStringSlice(newGroups.(*pluginsdk.Set).List()))
+
+		oldRoles, newRoles := d.GetChange("excluded_roles")
+		users.ExcludeRoles = updateConditionalAccessExclusions(users.ExcludeRoles, tf.ExpandStringSlice(oldRoles.(*pluginsdk.Set).List()), tf.ExpandStringSlice(newRoles.(*pluginsdk.Set).List()))
+	}
+
+	if _, err := client.Update(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Could not update conditional access policy with ID: %q", id.PolicyId)
+	}
+
+	if err := waitForConditionalAccessPolicyUpdate(ctx, client, id.PolicyId, d.Get("display_name").(string), d.Get("state").(string)); err != nil {
+		return tf.ErrorDiagF(err, "waiting for update of conditional access policy with ID %q", id.PolicyId)
+	}
+
+	return nil
+}
+
+func conditionalAccessPolicyFromTemplateResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.PoliciesClient
+	templatesClient := meta.(*clients.Client).ConditionalAccess.TemplatesClient
+
+	id, err := parse.ParsePolicyFromTemplateID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing conditional access policy from template ID %q", d.Id())
+	}
+
+	policy, status, err := client.Get(ctx, id.PolicyId, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Conditional Access Policy with Object ID %q was not found - removing from state", id.PolicyId)
+			d.SetId("")
+			return nil
+		}
+
+		return tf.ErrorDiagPathF(err, "id", "Retrieving Conditional Access Policy with object ID %q", id.PolicyId)
+	}
+
+	if d.Get("template_name").(string) == "" {
+		template, _, err := templatesClient.Get(ctx, id.TemplateId, odata.Query{})
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "template_id", "Retrieving conditional access template with ID %q", id.TemplateId)
+		}
+		tf.Set(d, "template_name", template.Name)
+	}
+
+	// Only track the exclusions which are managed by this resource, any which were added by the template are
+	// visible in the `conditions` attribute
+	users := &msgraph.ConditionalAccessUsers{}
+	if policy.Conditions != nil && policy.Conditions.Users != nil {
+		users = policy.Conditions.Users
+	}
+
+	tf.Set(d, "template_id", id.TemplateId)
+	tf.Set(d, "policy_id", id.PolicyId)
+	tf.Set(d, "display_name", policy.DisplayName)
+	tf.Set(d, "state", policy.State)
+	tf.Set(d, "excluded_users", managedConditionalAccessExclusions(d.Get("excluded_users").(*pluginsdk.Set).List(), users.ExcludeUsers))
+	tf.Set(d, "excluded_groups", managedConditionalAccessExclusions(d.Get("excluded_groups").(*pluginsdk.Set).List(), users.ExcludeGroups))
+	tf.Set(d, "excluded_roles", managedConditionalAccessExclusions(d.Get("excluded_roles").(*pluginsdk.Set).List(), users.ExcludeRoles))
+	tf.Set(d, "conditions", flattenConditionalAccessConditionSet(policy.Conditions))
+	tf.Set(d, "grant_controls", flattenConditionalAccessGrantControls(policy.GrantControls))
+	tf.Set(d, "session_controls", flattenConditionalAccessSessionControls(policy.SessionControls))
+
+	return nil
+}
+
+func conditionalAccessPolicyFromTemplateResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.PoliciesClient
+
+	id, err := parse.ParsePolicyFromTemplateID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing conditional access policy from template ID %q", d.Id())
+	}
+
+	_, status, err := client.Get(ctx, id.PolicyId, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Conditional Access Policy with ID %q already deleted", id.PolicyId)
+			return nil
+		}
+
+		return tf.ErrorDiagPathF(err, "id", "Retrieving conditional access policy with ID %q", id.PolicyId)
+	}
+
+	status, err = client.Delete(ctx, id.PolicyId)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Deleting conditional access policy with ID %q, got status %d", id.PolicyId, status)
+	}
+
+	if err := helpers.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		defer func() { client.BaseClient.DisableRetries = false }()
+		client.BaseClient.DisableRetries = true
+		if _, status, err := client.Get(ctx, id.PolicyId, odata.Query{}); err != nil {
+			if status == http.StatusNotFound {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of conditional access policy with ID %q", id.PolicyId)
+	}
+
+	return nil
+}
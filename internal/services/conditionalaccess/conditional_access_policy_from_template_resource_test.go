@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/conditionalaccess/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+)
+
+// Require multifactor authentication for admins
+const testConditionalAccessTemplateId = "c7503427-338e-4c5e-902d-abe252abfb43"
+
+type ConditionalAccessPolicyFromTemplateResource struct{}
+
+func TestAccConditionalAccessPolicyFromTemplate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_conditional_access_policy_from_template", "test")
+	r := ConditionalAccessPolicyFromTemplateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("policy_id").Exists(),
+				check.That(data.ResourceName).Key("template_name").Exists(),
+				check.That(data.ResourceName).Key("state").HasValue("enabledForReportingButNotEnforced"),
+				check.That(data.ResourceName).Key("conditions.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccConditionalAccessPolicyFromTemplate_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_conditional_access_policy_from_template", "test")
+	r := ConditionalAccessPolicyFromTemplateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctest-CONPOLICY-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("state").HasValue("disabled"),
+				check.That(data.ResourceName).Key("excluded_users.#").HasValue("1"),
+				check.That(data.ResourceName).Key("excluded_groups.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("excluded_users", "excluded_groups"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("excluded_users.#").HasValue("0"),
+				check.That(data.ResourceName).Key("excluded_groups.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ConditionalAccessPolicyFromTemplateResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	client := clients.ConditionalAccess.PoliciesClient
+	client.BaseClient.DisableRetries = true
+	defer func() { client.BaseClient.DisableRetries = false }()
+
+	id, err := parse.ParsePolicyFromTemplateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, status, err := client.Get(ctx, id.PolicyId, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("%s does not exist", id)
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %+v", id, err)
+	}
+
+	return pointer.To(policy.ID != nil && *policy.ID == id.PolicyId), nil
+}
+
+func (ConditionalAccessPolicyFromTemplateResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_conditional_access_policy_from_template" "test" {
+  template_id = "%[1]s"
+}
+`, testConditionalAccessTemplateId)
+}
+
+func (ConditionalAccessPolicyFromTemplateResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_client_config" "test" {}
+
+resource "azuread_group" "test" {
+  display_name     = "acctest-CONPOLICY-%[1]d"
+  security_enabled = true
+}
+
+resource "azuread_conditional_access_policy_from_template" "test" {
+  template_id  = "%[2]s"
+  display_name = "acctest-CONPOLICY-%[1]d"
+  state        = "disabled"
+
+  excluded_users  = [data.azuread_client_config.test.object_id]
+  excluded_groups = [azuread_group.test.object_id]
+}
+`, data.RandomInteger, testConditionalAccessTemplateId)
+}
@@ -729,37 +729,7 @@ func conditionalAccessPolicyResourceUpdate(ctx context.Context, d *pluginsdk.Res
 		return tf.ErrorDiagF(err, "Could not update conditional access policy with ID: %q", d.Id())
 	}
 
-	// Poll for 5 retrievals of the updated policy. We don't check every property as this is prone to getting stuck
-	// in a timeout loop, instead we're hoping that this allows enough time/activity for the update to be reflected.
-	log.Printf("[DEBUG] Waiting for conditional access policy %q to be updated", d.Id())
-	timeout, _ := ctx.Deadline()
-	stateConf := &pluginsdk.StateChangeConf{ //nolint:staticcheck
-		Pending:                   []string{"Pending"},
-		Target:                    []string{"Done"},
-		Timeout:                   time.Until(timeout),
-		MinTimeout:                5 * time.Second,
-		ContinuousTargetOccurence: 5,
-		Refresh: func() (interface{}, string, error) {
-			client.BaseClient.DisableRetries = true
-			policy, _, err := client.Get(ctx, d.Id(), odata.Query{})
-			if err != nil {
-				return nil, "Error", err
-			}
-
-			if policy == nil {
-				return "stub", "Pending", nil
-			}
-			if policy.DisplayName == nil || *policy.DisplayName != d.Get("display_name").(string) {
-				return "stub", "Pending", nil
-			}
-			if policy.State == nil || *policy.State != d.Get("state").(string) {
-				return "stub", "Pending", nil
-			}
-
-			return "stub", "Done", nil
-		},
-	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if err := waitForConditionalAccessPolicyUpdate(ctx, client, d.Id(), d.Get("display_name").(string), d.Get("state").(string)); err != nil {
 		return tf.ErrorDiagF(err, "waiting for update of conditional access policy with ID %q", d.Id())
 	}
 
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+)
+
+func conditionalAccessTemplatesDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: conditionalAccessTemplatesDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"template_ids": {
+				Description: "The IDs of the conditional access templates",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"templates": {
+				Description: "A list of conditional access templates",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"template_id": {
+							Description: "The ID of the conditional access template",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"name": {
+							Description: "The name of the conditional access template",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"description": {
+							Description: "The description of the conditional access template",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"scenarios": {
+							Description: "The scenarios that the conditional access template is intended for",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"conditions":       conditionalAccessPolicyComputedSchema("conditions"),
+						"grant_controls":   conditionalAccessPolicyComputedSchema("grant_controls"),
+						"session_controls": conditionalAccessPolicyComputedSchema("session_controls"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func conditionalAccessTemplatesDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.TemplatesClient
+
+	conditionalAccessTemplates, _, err := client.List(ctx, odata.Query{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not retrieve conditional access templates")
+	}
+	if conditionalAccessTemplates == nil {
+		return tf.ErrorDiagF(errors.New("API error: nil conditionalAccessTemplates were returned"), "Retrieving all conditional access templates")
+	}
+
+	templateIds := make([]string, 0)
+	templateList := make([]map[string]interface{}, 0)
+
+	for _, t := range *conditionalAccessTemplates {
+		if t.ID == nil {
+			continue
+		}
+
+		templateIds = append(templateIds, *t.ID)
+
+		scenarios := make([]string, 0)
+		if t.Scenarios != nil && *t.Scenarios != "" {
+			for _, s := range strings.Split(*t.Scenarios, ",") {
+				scenarios = append(scenarios, strings.TrimSpace(s))
+			}
+		}
+
+		template := make(map[string]interface{})
+		template["template_id"] = t.ID
+		template["name"] = t.Name
+		template["description"] = t.Description
+		template["scenarios"] = scenarios
+		template["conditions"] = []interface{}{}
+		template["grant_controls"] = []interface{}{}
+		template["session_controls"] = []interface{}{}
+		if t.Details != nil {
+			template["conditions"] = flattenConditionalAccessConditionSet(t.Details.Conditions)
+			template["grant_controls"] = flattenConditionalAccessGrantControls(t.Details.GrantControls)
+			template["session_controls"] = flattenConditionalAccessSessionControls(t.Details.SessionControls)
+		}
+		templateList = append(templateList, template)
+	}
+
+	// Generate a unique ID based on result
+	h := sha1.New()
+	if _, err := h.Write([]byte(strings.Join(templateIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for template IDs")
+	}
+
+	d.SetId("conditionalAccessTemplates#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+
+	tf.Set(d, "templates", templateList)
+	tf.Set(d, "template_ids", templateIds)
+
+	return nil
+}
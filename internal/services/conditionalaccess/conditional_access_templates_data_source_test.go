@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ConditionalAccessTemplatesDataSource struct{}
+
+func TestAccConditionalAccessTemplatesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_conditional_access_templates", "test")
+	r := ConditionalAccessTemplatesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("template_ids.#").Exists(),
+				check.That(data.ResourceName).Key("templates.0.template_id").Exists(),
+				check.That(data.ResourceName).Key("templates.0.name").Exists(),
+				check.That(data.ResourceName).Key("templates.0.scenarios.#").Exists(),
+				check.That(data.ResourceName).Key("templates.0.conditions.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (ConditionalAccessTemplatesDataSource) basic() string {
+	return `data "azuread_conditional_access_templates" "test" {}`
+}
@@ -4,8 +4,16 @@
 package conditionalaccess
 
 import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
 	"github.com/manicminer/hamilton/msgraph"
 )
 
@@ -626,3 +634,115 @@ func expandIPNamedLocationIPRange(in []interface{}) *[]msgraph.IPNamedLocationIP
 
 	return &result
 }
+
+// waitForConditionalAccessPolicyUpdate polls for 5 retrievals of an updated policy. We don't check every property as
+// this is prone to getting stuck in a timeout loop, instead we're hoping that this allows enough time/activity for the
+// update to be reflected.
+func waitForConditionalAccessPolicyUpdate(ctx context.Context, client *msgraph.ConditionalAccessPoliciesClient, id, displayName, state string) error {
+	log.Printf("[DEBUG] Waiting for conditional access policy %q to be updated", id)
+	timeout, _ := ctx.Deadline()
+	stateConf := &pluginsdk.StateChangeConf{ //nolint:staticcheck
+		Pending:                   []string{"Pending"},
+		Target:                    []string{"Done"},
+		Timeout:                   time.Until(timeout),
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 5,
+		Refresh: func() (interface{}, string, error) {
+			client.BaseClient.DisableRetries = true
+			policy, _, err := client.Get(ctx, id, odata.Query{})
+			if err != nil {
+				return nil, "Error", err
+			}
+
+			if policy == nil {
+				return "stub", "Pending", nil
+			}
+			if policy.DisplayName == nil || *policy.DisplayName != displayName {
+				return "stub", "Pending", nil
+			}
+			if policy.State == nil || *policy.State != state {
+				return "stub", "Pending", nil
+			}
+
+			return "stub", "Done", nil
+		},
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// conditionalAccessPolicyComputedSchema returns a read-only copy of the named property from the conditional access
+// policy resource schema, so that policies can be exported in the same format by other resources and data sources
+func conditionalAccessPolicyComputedSchema(key string) *pluginsdk.Schema {
+	return computedSchema(conditionalAccessPolicyResource().Schema[key])
+}
+
+func computedSchema(in *pluginsdk.Schema) *pluginsdk.Schema {
+	out := &pluginsdk.Schema{
+		Type:        in.Type,
+		Description: in.Description,
+		Computed:    true,
+	}
+
+	switch elem := in.Elem.(type) {
+	case *pluginsdk.Resource:
+		s := make(map[string]*pluginsdk.Schema, len(elem.Schema))
+		for k, v := range elem.Schema {
+			s[k] = computedSchema(v)
+		}
+		out.Elem = &pluginsdk.Resource{Schema: s}
+	case *pluginsdk.Schema:
+		out.Elem = &pluginsdk.Schema{Type: elem.Type}
+	}
+
+	return out
+}
+
+// sanitiseConditionalAccessTemplateUsers removes placeholder values from template user conditions, such as "Current
+// administrator will be excluded", which are meaningful in the portal but are rejected by the API
+func sanitiseConditionalAccessTemplateUsers(in *msgraph.ConditionalAccessUsers) {
+	if in == nil {
+		return
+	}
+
+	sanitise := func(values *[]string) *[]string {
+		if values == nil {
+			return nil
+		}
+		result := make([]string, 0, len(*values))
+		for _, v := range *values {
+			if _, err := uuid.ParseUUID(v); err == nil || strings.EqualFold(v, "All") || strings.EqualFold(v, "None") || strings.EqualFold(v, "GuestsOrExternalUsers") {
+				result = append(result, v)
+			}
+		}
+		return &result
+	}
+
+	in.IncludeUsers = sanitise(in.IncludeUsers)
+	in.ExcludeUsers = sanitise(in.ExcludeUsers)
+	in.IncludeGroups = sanitise(in.IncludeGroups)
+	in.ExcludeGroups = sanitise(in.ExcludeGroups)
+	in.IncludeRoles = sanitise(in.IncludeRoles)
+	in.ExcludeRoles = sanitise(in.ExcludeRoles)
+}
+
+// updateConditionalAccessExclusions removes the values in `remove` from `existing`, and then appends any values in
+// `add` which are not already present
+func updateConditionalAccessExclusions(existing *[]string, remove, add []string) *[]string {
+	result := make([]string, 0)
+	if existing != nil {
+		result = append(result, tf.Difference(*existing, remove)...)
+	}
+	result = append(result, tf.Difference(add, result)...)
+	return &result
+}
+
+// managedConditionalAccessExclusions returns the values from `configured` which are still present in `actual`
+func managedConditionalAccessExclusions(configured []interface{}, actual *[]string) []string {
+	if actual == nil {
+		return []string{}
+	}
+	managed := tf.ExpandStringSlice(configured)
+	return tf.Difference(managed, tf.Difference(managed, *actual))
+}
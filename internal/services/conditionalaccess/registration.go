@@ -29,14 +29,16 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_named_location": namedLocationDataSource(),
+		"azuread_conditional_access_templates": conditionalAccessTemplatesDataSource(),
+		"azuread_named_location":               namedLocationDataSource(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_named_location":            namedLocationResource(),
-		"azuread_conditional_access_policy": conditionalAccessPolicyResource(),
+		"azuread_named_location":                          namedLocationResource(),
+		"azuread_conditional_access_policy":               conditionalAccessPolicyResource(),
+		"azuread_conditional_access_policy_from_template": conditionalAccessPolicyFromTemplateResource(),
 	}
 }
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+)
+
+type Client struct {
+	CrossTenantAccessPolicyClient *CrossTenantAccessPolicyClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	crossTenantAccessPolicyClient := NewCrossTenantAccessPolicyClient()
+	o.ConfigureClient(&crossTenantAccessPolicyClient.BaseClient)
+
+	return &Client{
+		CrossTenantAccessPolicyClient: crossTenantAccessPolicyClient,
+	}
+}
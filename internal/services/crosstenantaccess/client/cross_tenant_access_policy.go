@@ -0,0 +1,320 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+const (
+	CrossTenantAccessPolicyTargetConfigurationAccessTypeAllowed = "allowed"
+	CrossTenantAccessPolicyTargetConfigurationAccessTypeBlocked = "blocked"
+)
+
+const (
+	CrossTenantAccessPolicyTargetTypeApplication = "application"
+	CrossTenantAccessPolicyTargetTypeGroup       = "group"
+	CrossTenantAccessPolicyTargetTypeUser        = "user"
+)
+
+// CrossTenantAccessPolicyConfiguration describes either the default configuration, or the configuration for a
+// partner tenant. Settings which are nil are sent as null, which for a partner configuration means that the
+// setting is inherited from the default configuration.
+type CrossTenantAccessPolicyConfiguration struct {
+	TenantId                     *string                              `json:"tenantId,omitempty"`
+	IsServiceDefault             *bool                                `json:"isServiceDefault,omitempty"`
+	IsServiceProvider            *bool                                `json:"isServiceProvider,omitempty"`
+	IsInMultiTenantOrganization  *bool                                `json:"isInMultiTenantOrganization,omitempty"`
+	AutomaticUserConsentSettings *InboundOutboundPolicyConfiguration  `json:"automaticUserConsentSettings,omitempty"`
+	B2BCollaborationInbound      *CrossTenantAccessPolicyB2BSetting   `json:"b2bCollaborationInbound"`
+	B2BCollaborationOutbound     *CrossTenantAccessPolicyB2BSetting   `json:"b2bCollaborationOutbound"`
+	B2BDirectConnectInbound      *CrossTenantAccessPolicyB2BSetting   `json:"b2bDirectConnectInbound"`
+	B2BDirectConnectOutbound     *CrossTenantAccessPolicyB2BSetting   `json:"b2bDirectConnectOutbound"`
+	InboundTrust                 *CrossTenantAccessPolicyInboundTrust `json:"inboundTrust"`
+	TenantRestrictions           *CrossTenantAccessPolicyB2BSetting   `json:"tenantRestrictions"`
+	ODataType                    *odata.Type                          `json:"@odata.type,omitempty"`
+}
+
+type CrossTenantAccessPolicyB2BSetting struct {
+	UsersAndGroups *CrossTenantAccessPolicyTargetConfiguration `json:"usersAndGroups,omitempty"`
+	Applications   *CrossTenantAccessPolicyTargetConfiguration `json:"applications,omitempty"`
+}
+
+type CrossTenantAccessPolicyTargetConfiguration struct {
+	AccessType *string                          `json:"accessType,omitempty"`
+	Targets    *[]CrossTenantAccessPolicyTarget `json:"targets,omitempty"`
+}
+
+type CrossTenantAccessPolicyTarget struct {
+	Target     *string `json:"target,omitempty"`
+	TargetType *string `json:"targetType,omitempty"`
+}
+
+type CrossTenantAccessPolicyInboundTrust struct {
+	IsMfaAccepted                       *bool `json:"isMfaAccepted,omitempty"`
+	IsCompliantDeviceAccepted           *bool `json:"isCompliantDeviceAccepted,omitempty"`
+	IsHybridAzureADJoinedDeviceAccepted *bool `json:"isHybridAzureADJoinedDeviceAccepted,omitempty"`
+}
+
+type InboundOutboundPolicyConfiguration struct {
+	InboundAllowed  *bool `json:"inboundAllowed,omitempty"`
+	OutboundAllowed *bool `json:"outboundAllowed,omitempty"`
+}
+
+// CrossTenantIdentitySyncPolicyPartner describes the user synchronization settings for a partner tenant
+type CrossTenantIdentitySyncPolicyPartner struct {
+	TenantId        *string                     `json:"tenantId,omitempty"`
+	DisplayName     *string                     `json:"displayName,omitempty"`
+	UserSyncInbound *CrossTenantUserSyncInbound `json:"userSyncInbound,omitempty"`
+}
+
+type CrossTenantUserSyncInbound struct {
+	IsSyncAllowed *bool `json:"isSyncAllowed,omitempty"`
+}
+
+// CrossTenantAccessPolicyClient performs operations on the cross-tenant access policy, its default configuration and
+// the configurations for partner tenants.
+type CrossTenantAccessPolicyClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewCrossTenantAccessPolicyClient returns a new CrossTenantAccessPolicyClient
+func NewCrossTenantAccessPolicyClient() *CrossTenantAccessPolicyClient {
+	return &CrossTenantAccessPolicyClient{
+		BaseClient: msgraph.NewClient(msgraph.VersionBeta),
+	}
+}
+
+// GetDefault retrieves the default configuration.
+func (c *CrossTenantAccessPolicyClient) GetDefault(ctx context.Context, query odata.Query) (*CrossTenantAccessPolicyConfiguration, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: "/policies/crossTenantAccessPolicy/default",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("CrossTenantAccessPolicyClient.BaseClient.Get(): %v", err)
+	}
+
+	return unmarshalCrossTenantAccessPolicyConfiguration(resp, status)
+}
+
+// UpdateDefault amends the default configuration.
+func (c *CrossTenantAccessPolicyClient) UpdateDefault(ctx context.Context, configuration CrossTenantAccessPolicyConfiguration) (int, error) {
+	var status int
+
+	body, err := json.Marshal(configuration)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: "/policies/crossTenantAccessPolicy/default",
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("CrossTenantAccessPolicyClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
+
+// ResetDefault resets the default configuration to the system defaults.
+func (c *CrossTenantAccessPolicyClient) ResetDefault(ctx context.Context) (int, error) {
+	_, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: "/policies/crossTenantAccessPolicy/default/resetToSystemDefault",
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("CrossTenantAccessPolicyClient.BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
+
+// CreatePartner creates a configuration for a partner tenant.
+func (c *CrossTenantAccessPolicyClient) CreatePartner(ctx context.Context, configuration CrossTenantAccessPolicyConfiguration) (*CrossTenantAccessPolicyConfiguration, int, error) {
+	var status int
+
+	if configuration.TenantId == nil {
+		return nil, status, errors.New("cannot create partner configuration with nil TenantId")
+	}
+
+	body, err := json.Marshal(configuration)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity: "/policies/crossTenantAccessPolicy/partners",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("CrossTenantAccessPolicyClient.BaseClient.Post(): %v", err)
+	}
+
+	return unmarshalCrossTenantAccessPolicyConfiguration(resp, status)
+}
+
+// GetPartner retrieves the configuration for a partner tenant.
+func (c *CrossTenantAccessPolicyClient) GetPartner(ctx context.Context, tenantId string, query odata.Query) (*CrossTenantAccessPolicyConfiguration, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/policies/crossTenantAccessPolicy/partners/%s", tenantId),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("CrossTenantAccessPolicyClient.BaseClient.Get(): %v", err)
+	}
+
+	return unmarshalCrossTenantAccessPolicyConfiguration(resp, status)
+}
+
+// UpdatePartner amends the configuration for a partner tenant.
+func (c *CrossTenantAccessPolicyClient) UpdatePartner(ctx context.Context, configuration CrossTenantAccessPolicyConfiguration) (int, error) {
+	var status int
+
+	if configuration.TenantId == nil {
+		return status, errors.New("cannot update partner configuration with nil TenantId")
+	}
+
+	tenantId := *configuration.TenantId
+	configuration.TenantId = nil
+
+	body, err := json.Marshal(configuration)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/policies/crossTenantAccessPolicy/partners/%s", tenantId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("CrossTenantAccessPolicyClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
+
+// DeletePartner removes the configuration for a partner tenant.
+func (c *CrossTenantAccessPolicyClient) DeletePartner(ctx context.Context, tenantId string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/policies/crossTenantAccessPolicy/partners/%s", tenantId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("CrossTenantAccessPolicyClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
+
+// GetPartnerIdentitySynchronization retrieves the user synchronization settings for a partner tenant.
+func (c *CrossTenantAccessPolicyClient) GetPartnerIdentitySynchronization(ctx context.Context, tenantId string) (*CrossTenantIdentitySyncPolicyPartner, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/policies/crossTenantAccessPolicy/partners/%s/identitySynchronization", tenantId),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("CrossTenantAccessPolicyClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var policy CrossTenantIdentitySyncPolicyPartner
+	if err := json.Unmarshal(respBody, &policy); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &policy, status, nil
+}
+
+// SetPartnerIdentitySynchronization creates or replaces the user synchronization settings for a partner tenant.
+func (c *CrossTenantAccessPolicyClient) SetPartnerIdentitySynchronization(ctx context.Context, tenantId string, policy CrossTenantIdentitySyncPolicyPartner) (int, error) {
+	var status int
+
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Put(ctx, msgraph.PutHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusCreated, http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/policies/crossTenantAccessPolicy/partners/%s/identitySynchronization", tenantId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("CrossTenantAccessPolicyClient.BaseClient.Put(): %v", err)
+	}
+
+	return status, nil
+}
+
+// DeletePartnerIdentitySynchronization removes the user synchronization settings for a partner tenant.
+func (c *CrossTenantAccessPolicyClient) DeletePartnerIdentitySynchronization(ctx context.Context, tenantId string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/policies/crossTenantAccessPolicy/partners/%s/identitySynchronization", tenantId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("CrossTenantAccessPolicyClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
+
+func unmarshalCrossTenantAccessPolicyConfiguration(resp *http.Response, status int) (*CrossTenantAccessPolicyConfiguration, int, error) {
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var configuration CrossTenantAccessPolicyConfiguration
+	if err := json.Unmarshal(respBody, &configuration); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &configuration, status, nil
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package crosstenantaccess
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/crosstenantaccess/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/crosstenantaccess/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+)
+
+type CrossTenantAccessPolicyDefaultModel struct {
+	B2BCollaborationInbound  []CrossTenantAccessPolicyB2BSettingModel   `tfschema:"b2b_collaboration_inbound"`
+	B2BCollaborationOutbound []CrossTenantAccessPolicyB2BSettingModel   `tfschema:"b2b_collaboration_outbound"`
+	B2BDirectConnectInbound  []CrossTenantAccessPolicyB2BSettingModel   `tfschema:"b2b_direct_connect_inbound"`
+	B2BDirectConnectOutbound []CrossTenantAccessPolicyB2BSettingModel   `tfschema:"b2b_direct_connect_outbound"`
+	InboundTrust             []CrossTenantAccessPolicyInboundTrustModel `tfschema:"inbound_trust"`
+	TenantRestrictions       []CrossTenantAccessPolicyB2BSettingModel   `tfschema:"tenant_restrictions"`
+	ServiceDefault           bool                                       `tfschema:"service_default"`
+}
+
+var _ sdk.ResourceWithUpdate = CrossTenantAccessPolicyDefaultResource{}
+
+type CrossTenantAccessPolicyDefaultResource struct{}
+
+func (r CrossTenantAccessPolicyDefaultResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.ValidateCrossTenantAccessPolicyDefaultID
+}
+
+func (r CrossTenantAccessPolicyDefaultResource) ResourceType() string {
+	return "azuread_cross_tenant_access_policy_default"
+}
+
+func (r CrossTenantAccessPolicyDefaultResource) ModelObject() interface{} {
+	return &CrossTenantAccessPolicyDefaultModel{}
+}
+
+func (r CrossTenantAccessPolicyDefaultResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"b2b_collaboration_inbound":   crossTenantAccessPolicyB2BSettingSchema("Default settings for users from other tenants collaborating in this tenant", true),
+		"b2b_collaboration_outbound":  crossTenantAccessPolicyB2BSettingSchema("Default settings for users in this tenant collaborating in other tenants", true),
+		"b2b_direct_connect_inbound":  crossTenantAccessPolicyB2BSettingSchema("Default settings for users from other tenants accessing this tenant via B2B direct connect", true),
+		"b2b_direct_connect_outbound": crossTenantAccessPolicyB2BSettingSchema("Default settings for users in this tenant accessing other tenants via B2B direct connect", true),
+		"inbound_trust":               crossTenantAccessPolicyInboundTrustSchema(true),
+		"tenant_restrictions":         crossTenantAccessPolicyB2BSettingSchema("Default tenant restrictions for users in this tenant accessing other tenants using external identities", true),
+	}
+}
+
+func (r CrossTenantAccessPolicyDefaultResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"service_default": {
+			Description: "Whether the default configuration is the system default and has not been customised",
+			Type:        pluginsdk.TypeBool,
+			Computed:    true,
+		},
+	}
+}
+
+func (r CrossTenantAccessPolicyDefaultResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id := parse.NewCrossTenantAccessPolicyDefaultID()
+
+			if err := r.apply(ctx, metadata, id); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r CrossTenantAccessPolicyDefaultResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.CrossTenantAccess.CrossTenantAccessPolicyClient
+
+			id, err := parse.ParseCrossTenantAccessPolicyDefaultID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			configuration, _, err := client.GetDefault(ctx, odata.Query{})
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if configuration == nil {
+				return fmt.Errorf("retrieving %s: API error, result was nil", id)
+			}
+
+			state := CrossTenantAccessPolicyDefaultModel{
+				B2BCollaborationInbound:  flattenCrossTenantAccessPolicyB2BSetting(configuration.B2BCollaborationInbound),
+				B2BCollaborationOutbound: flattenCrossTenantAccessPolicyB2BSetting(configuration.B2BCollaborationOutbound),
+				B2BDirectConnectInbound:  flattenCrossTenantAccessPolicyB2BSetting(configuration.B2BDirectConnectInbound),
+				B2BDirectConnectOutbound: flattenCrossTenantAccessPolicyB2BSetting(configuration.B2BDirectConnectOutbound),
+				InboundTrust:             flattenCrossTenantAccessPolicyInboundTrust(configuration.InboundTrust),
+				TenantRestrictions:       flattenCrossTenantAccessPolicyB2BSetting(configuration.TenantRestrictions),
+				ServiceDefault:           pointer.From(configuration.IsServiceDefault),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CrossTenantAccessPolicyDefaultResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseCrossTenantAccessPolicyDefaultID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			return r.apply(ctx, metadata, id)
+		},
+	}
+}
+
+func (r CrossTenantAccessPolicyDefaultResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.CrossTenantAccess.CrossTenantAccessPolicyClient
+
+			id, err := parse.ParseCrossTenantAccessPolicyDefaultID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// The default configuration cannot be deleted, so instead we reset it to the system defaults
+			if _, err = client.ResetDefault(ctx); err != nil {
+				return fmt.Errorf("resetting %s to system defaults: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// apply updates the default configuration with the settings specified in the configuration. Any settings not
+// specified are sent back to the API as-is, since omitting them from the request would reset them.
+func (r CrossTenantAccessPolicyDefaultResource) apply(ctx context.Context, metadata sdk.ResourceMetaData, id *parse.CrossTenantAccessPolicyDefaultId) error {
+	client := metadata.Client.CrossTenantAccess.CrossTenantAccessPolicyClient
+
+	var model CrossTenantAccessPolicyDefaultModel
+	if err := metadata.Decode(&model); err != nil {
+		return fmt.Errorf("decoding: %+v", err)
+	}
+
+	existing, _, err := client.GetDefault(ctx, odata.Query{})
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing == nil {
+		return fmt.Errorf("retrieving %s: API error, result was nil", id)
+	}
+
+	properties := clientDefaultConfiguration(existing)
+
+	if v := expandCrossTenantAccessPolicyB2BSetting(model.B2BCollaborationInbound); v != nil {
+		properties.B2BCollaborationInbound = v
+	}
+	if v := expandCrossTenantAccessPolicyB2BSetting(model.B2BCollaborationOutbound); v != nil {
+		properties.B2BCollaborationOutbound = v
+	}
+	if v := expandCrossTenantAccessPolicyB2BSetting(model.B2BDirectConnectInbound); v != nil {
+		properties.B2BDirectConnectInbound = v
+	}
+	if v := expandCrossTenantAccessPolicyB2BSetting(model.B2BDirectConnectOutbound); v != nil {
+		properties.B2BDirectConnectOutbound = v
+	}
+	if v := expandCrossTenantAccessPolicyInboundTrust(model.InboundTrust); v != nil {
+		properties.InboundTrust = v
+	}
+	if v := expandCrossTenantAccessPolicyB2BSetting(model.TenantRestrictions); v != nil {
+		properties.TenantRestrictions = v
+	}
+
+	if _, err = client.UpdateDefault(ctx, properties); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// clientDefaultConfiguration returns a copy of the updatable settings from an existing default configuration
+func clientDefaultConfiguration(in *client.CrossTenantAccessPolicyConfiguration) client.CrossTenantAccessPolicyConfiguration {
+	return client.CrossTenantAccessPolicyConfiguration{
+		B2BCollaborationInbound:  in.B2BCollaborationInbound,
+		B2BCollaborationOutbound: in.B2BCollaborationOutbound,
+		B2BDirectConnectInbound:  in.B2BDirectConnectInbound,
+		B2BDirectConnectOutbound: in.B2BDirectConnectOutbound,
+		InboundTrust:             in.InboundTrust,
+		TenantRestrictions:       in.TenantRestrictions,
+	}
+}
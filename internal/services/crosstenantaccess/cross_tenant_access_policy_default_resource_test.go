@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package crosstenantaccess_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type CrossTenantAccessPolicyDefaultResource struct{}
+
+func TestAccCrossTenantAccessPolicyDefault_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_cross_tenant_access_policy_default", "test")
+	r := CrossTenantAccessPolicyDefaultResource{}
+
+	// The default configuration always exists, it is reset to the system defaults on destroy
+	data.ResourceTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("inbound_trust.0.mfa_accepted").HasValue("true"),
+				check.That(data.ResourceName).Key("b2b_collaboration_inbound.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("b2b_direct_connect_outbound.0.users_and_groups.0.access_type").HasValue("blocked"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r CrossTenantAccessPolicyDefaultResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.CrossTenantAccess.CrossTenantAccessPolicyClient
+	client.BaseClient.DisableRetries = true
+	defer func() { client.BaseClient.DisableRetries = false }()
+
+	if _, _, err := client.GetDefault(ctx, odata.Query{}); err != nil {
+		return nil, fmt.Errorf("failed to retrieve cross-tenant access policy default configuration: %+v", err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (CrossTenantAccessPolicyDefaultResource) basic(data acceptance.TestData) string {
+	return `
+provider "azuread" {}
+
+resource "azuread_cross_tenant_access_policy_default" "test" {
+  inbound_trust {
+    mfa_accepted = true
+  }
+}
+`
+}
+
+func (CrossTenantAccessPolicyDefaultResource) complete(data acceptance.TestData) string {
+	return `
+provider "azuread" {}
+
+resource "azuread_cross_tenant_access_policy_default" "test" {
+  b2b_collaboration_inbound {
+    users_and_groups {
+      access_type = "allowed"
+
+      target {
+        target      = "AllUsers"
+        target_type = "user"
+      }
+    }
+
+    applications {
+      access_type = "allowed"
+
+      target {
+        target      = "AllApplications"
+        target_type = "application"
+      }
+    }
+  }
+
+  b2b_direct_connect_outbound {
+    users_and_groups {
+      access_type = "blocked"
+
+      target {
+        target      = "AllUsers"
+        target_type = "user"
+      }
+    }
+
+    applications {
+      access_type = "blocked"
+
+      target {
+        target      = "AllApplications"
+        target_type = "application"
+      }
+    }
+  }
+
+  inbound_trust {
+    mfa_accepted                           = true
+    compliant_device_accepted              = true
+    hybrid_azure_ad_joined_device_accepted = false
+  }
+}
+`
+}
@@ -0,0 +1,349 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package crosstenantaccess
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/crosstenantaccess/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/crosstenantaccess/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+type CrossTenantAccessPolicyPartnerModel struct {
+	TenantId                 string                                                `tfschema:"tenant_id"`
+	AutomaticUserConsent     []CrossTenantAccessPolicyAutomaticUserConsentModel    `tfschema:"automatic_user_consent"`
+	B2BCollaborationInbound  []CrossTenantAccessPolicyB2BSettingModel              `tfschema:"b2b_collaboration_inbound"`
+	B2BCollaborationOutbound []CrossTenantAccessPolicyB2BSettingModel              `tfschema:"b2b_collaboration_outbound"`
+	B2BDirectConnectInbound  []CrossTenantAccessPolicyB2BSettingModel              `tfschema:"b2b_direct_connect_inbound"`
+	B2BDirectConnectOutbound []CrossTenantAccessPolicyB2BSettingModel              `tfschema:"b2b_direct_connect_outbound"`
+	IdentitySynchronization  []CrossTenantAccessPolicyIdentitySynchronizationModel `tfschema:"identity_synchronization"`
+	InboundTrust             []CrossTenantAccessPolicyInboundTrustModel            `tfschema:"inbound_trust"`
+	TenantRestrictions       []CrossTenantAccessPolicyB2BSettingModel              `tfschema:"tenant_restrictions"`
+	InMultiTenantOrg         bool                                                  `tfschema:"in_multi_tenant_organization"`
+	ServiceProvider          bool                                                  `tfschema:"service_provider"`
+}
+
+type CrossTenantAccessPolicyAutomaticUserConsentModel struct {
+	InboundAllowed  bool `tfschema:"inbound_allowed"`
+	OutboundAllowed bool `tfschema:"outbound_allowed"`
+}
+
+type CrossTenantAccessPolicyIdentitySynchronizationModel struct {
+	DisplayName            string `tfschema:"display_name"`
+	UserSyncInboundAllowed bool   `tfschema:"user_sync_inbound_allowed"`
+}
+
+var _ sdk.ResourceWithUpdate = CrossTenantAccessPolicyPartnerResource{}
+
+type CrossTenantAccessPolicyPartnerResource struct{}
+
+func (r CrossTenantAccessPolicyPartnerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.ValidateCrossTenantAccessPolicyPartnerID
+}
+
+func (r CrossTenantAccessPolicyPartnerResource) ResourceType() string {
+	return "azuread_cross_tenant_access_policy_partner"
+}
+
+func (r CrossTenantAccessPolicyPartnerResource) ModelObject() interface{} {
+	return &CrossTenantAccessPolicyPartnerModel{}
+}
+
+func (r CrossTenantAccessPolicyPartnerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"tenant_id": {
+			Description:      "The tenant ID of the partner organization",
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validation.ValidateDiag(validation.IsUUID),
+		},
+
+		"automatic_user_consent": {
+			Description: "Whether consent prompts are automatically redeemed for users in B2B collaboration and B2B direct connect",
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"inbound_allowed": {
+						Description: "Whether consent is automatically redeemed for users from the partner tenant accessing this tenant",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+
+					"outbound_allowed": {
+						Description: "Whether consent is automatically redeemed for users in this tenant accessing the partner tenant",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		},
+
+		"b2b_collaboration_inbound":   crossTenantAccessPolicyB2BSettingSchema("Settings for users from the partner tenant collaborating in this tenant. When omitted, the default configuration is inherited", false),
+		"b2b_collaboration_outbound":  crossTenantAccessPolicyB2BSettingSchema("Settings for users in this tenant collaborating in the partner tenant. When omitted, the default configuration is inherited", false),
+		"b2b_direct_connect_inbound":  crossTenantAccessPolicyB2BSettingSchema("Settings for users from the partner tenant accessing this tenant via B2B direct connect. When omitted, the default configuration is inherited", false),
+		"b2b_direct_connect_outbound": crossTenantAccessPolicyB2BSettingSchema("Settings for users in this tenant accessing the partner tenant via B2B direct connect. When omitted, the default configuration is inherited", false),
+
+		"identity_synchronization": {
+			Description: "User synchronization settings for the partner tenant",
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"display_name": {
+						Description: "A display name for the user synchronization policy",
+						Type:        pluginsdk.TypeString,
+						Optional:    true,
+					},
+
+					"user_sync_inbound_allowed": {
+						Description: "Whether users can be synchronized from the partner tenant",
+						Type:        pluginsdk.TypeBool,
+						Required:    true,
+					},
+				},
+			},
+		},
+
+		"inbound_trust":       crossTenantAccessPolicyInboundTrustSchema(false),
+		"tenant_restrictions": crossTenantAccessPolicyB2BSettingSchema("Tenant restrictions for users in this tenant accessing the partner tenant using external identities. When omitted, the default configuration is inherited", false),
+	}
+}
+
+func (r CrossTenantAccessPolicyPartnerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"in_multi_tenant_organization": {
+			Description: "Whether the partner tenant is part of a multi-tenant organization with this tenant",
+			Type:        pluginsdk.TypeBool,
+			Computed:    true,
+		},
+
+		"service_provider": {
+			Description: "Whether the partner tenant is a cloud service provider for this tenant",
+			Type:        pluginsdk.TypeBool,
+			Computed:    true,
+		},
+	}
+}
+
+func (r CrossTenantAccessPolicyPartnerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.CrossTenantAccess.CrossTenantAccessPolicyClient
+
+			var model CrossTenantAccessPolicyPartnerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewCrossTenantAccessPolicyPartnerID(model.TenantId)
+
+			properties := expandCrossTenantAccessPolicyPartner(model)
+			properties.TenantId = pointer.To(id.TenantId)
+
+			if _, _, err := client.CreatePartner(ctx, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if len(model.IdentitySynchronization) > 0 {
+				if _, err := client.SetPartnerIdentitySynchronization(ctx, id.TenantId, expandCrossTenantAccessPolicyIdentitySynchronization(model)); err != nil {
+					return fmt.Errorf("setting identity synchronization for %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CrossTenantAccessPolicyPartnerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.CrossTenantAccess.CrossTenantAccessPolicyClient
+
+			id, err := parse.ParseCrossTenantAccessPolicyPartnerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			configuration, status, err := client.GetPartner(ctx, id.TenantId, odata.Query{})
+			if err != nil {
+				if status == http.StatusNotFound {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if configuration == nil {
+				return fmt.Errorf("retrieving %s: API error, result was nil", id)
+			}
+
+			state := CrossTenantAccessPolicyPartnerModel{
+				TenantId:                 id.TenantId,
+				AutomaticUserConsent:     []CrossTenantAccessPolicyAutomaticUserConsentModel{},
+				B2BCollaborationInbound:  flattenCrossTenantAccessPolicyB2BSetting(configuration.B2BCollaborationInbound),
+				B2BCollaborationOutbound: flattenCrossTenantAccessPolicyB2BSetting(configuration.B2BCollaborationOutbound),
+				B2BDirectConnectInbound:  flattenCrossTenantAccessPolicyB2BSetting(configuration.B2BDirectConnectInbound),
+				B2BDirectConnectOutbound: flattenCrossTenantAccessPolicyB2BSetting(configuration.B2BDirectConnectOutbound),
+				IdentitySynchronization:  []CrossTenantAccessPolicyIdentitySynchronizationModel{},
+				InboundTrust:             flattenCrossTenantAccessPolicyInboundTrust(configuration.InboundTrust),
+				TenantRestrictions:       flattenCrossTenantAccessPolicyB2BSetting(configuration.TenantRestrictions),
+				InMultiTenantOrg:         pointer.From(configuration.IsInMultiTenantOrganization),
+				ServiceProvider:          pointer.From(configuration.IsServiceProvider),
+			}
+
+			if v := configuration.AutomaticUserConsentSettings; v != nil {
+				state.AutomaticUserConsent = []CrossTenantAccessPolicyAutomaticUserConsentModel{{
+					InboundAllowed:  pointer.From(v.InboundAllowed),
+					OutboundAllowed: pointer.From(v.OutboundAllowed),
+				}}
+			}
+
+			identitySync, status, err := client.GetPartnerIdentitySynchronization(ctx, id.TenantId)
+			if err != nil && status != http.StatusNotFound {
+				return fmt.Errorf("retrieving identity synchronization for %s: %+v", id, err)
+			}
+			if identitySync != nil && status != http.StatusNotFound {
+				syncAllowed := false
+				if identitySync.UserSyncInbound != nil {
+					syncAllowed = pointer.From(identitySync.UserSyncInbound.IsSyncAllowed)
+				}
+				state.IdentitySynchronization = []CrossTenantAccessPolicyIdentitySynchronizationModel{{
+					DisplayName:            pointer.From(identitySync.DisplayName),
+					UserSyncInboundAllowed: syncAllowed,
+				}}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CrossTenantAccessPolicyPartnerResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.CrossTenantAccess.CrossTenantAccessPolicyClient
+			rd := metadata.ResourceData
+
+			id, err := parse.ParseCrossTenantAccessPolicyPartnerID(rd.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CrossTenantAccessPolicyPartnerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// Settings which are not specified are sent as null, so that they are inherited from the default configuration
+			properties := expandCrossTenantAccessPolicyPartner(model)
+			properties.TenantId = pointer.To(id.TenantId)
+
+			if _, err = client.UpdatePartner(ctx, properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			if rd.HasChange("identity_synchronization") {
+				if len(model.IdentitySynchronization) > 0 {
+					if _, err = client.SetPartnerIdentitySynchronization(ctx, id.TenantId, expandCrossTenantAccessPolicyIdentitySynchronization(model)); err != nil {
+						return fmt.Errorf("setting identity synchronization for %s: %+v", id, err)
+					}
+				} else {
+					if status, err := client.DeletePartnerIdentitySynchronization(ctx, id.TenantId); err != nil && status != http.StatusNotFound {
+						return fmt.Errorf("removing identity synchronization for %s: %+v", id, err)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CrossTenantAccessPolicyPartnerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.CrossTenantAccess.CrossTenantAccessPolicyClient
+
+			id, err := parse.ParseCrossTenantAccessPolicyPartnerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CrossTenantAccessPolicyPartnerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// The identity synchronization policy must be removed before the partner configuration can be deleted
+			if len(model.IdentitySynchronization) > 0 {
+				if status, err := client.DeletePartnerIdentitySynchronization(ctx, id.TenantId); err != nil && status != http.StatusNotFound {
+					return fmt.Errorf("removing identity synchronization for %s: %+v", id, err)
+				}
+			}
+
+			if status, err := client.DeletePartner(ctx, id.TenantId); err != nil {
+				if status == http.StatusNotFound {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandCrossTenantAccessPolicyPartner(model CrossTenantAccessPolicyPartnerModel) client.CrossTenantAccessPolicyConfiguration {
+	properties := client.CrossTenantAccessPolicyConfiguration{
+		B2BCollaborationInbound:  expandCrossTenantAccessPolicyB2BSetting(model.B2BCollaborationInbound),
+		B2BCollaborationOutbound: expandCrossTenantAccessPolicyB2BSetting(model.B2BCollaborationOutbound),
+		B2BDirectConnectInbound:  expandCrossTenantAccessPolicyB2BSetting(model.B2BDirectConnectInbound),
+		B2BDirectConnectOutbound: expandCrossTenantAccessPolicyB2BSetting(model.B2BDirectConnectOutbound),
+		InboundTrust:             expandCrossTenantAccessPolicyInboundTrust(model.InboundTrust),
+		TenantRestrictions:       expandCrossTenantAccessPolicyB2BSetting(model.TenantRestrictions),
+	}
+
+	if len(model.AutomaticUserConsent) > 0 {
+		properties.AutomaticUserConsentSettings = &client.InboundOutboundPolicyConfiguration{
+			InboundAllowed:  pointer.To(model.AutomaticUserConsent[0].InboundAllowed),
+			OutboundAllowed: pointer.To(model.AutomaticUserConsent[0].OutboundAllowed),
+		}
+	}
+
+	return properties
+}
+
+func expandCrossTenantAccessPolicyIdentitySynchronization(model CrossTenantAccessPolicyPartnerModel) client.CrossTenantIdentitySyncPolicyPartner {
+	policy := client.CrossTenantIdentitySyncPolicyPartner{
+		UserSyncInbound: &client.CrossTenantUserSyncInbound{
+			IsSyncAllowed: pointer.To(model.IdentitySynchronization[0].UserSyncInboundAllowed),
+		},
+	}
+
+	if v := model.IdentitySynchronization[0].DisplayName; v != "" {
+		policy.DisplayName = pointer.To(v)
+	}
+
+	return policy
+}
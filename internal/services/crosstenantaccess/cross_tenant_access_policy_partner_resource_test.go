@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package crosstenantaccess_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/crosstenantaccess/parse"
+)
+
+// testPartnerTenantId is the tenant ID of an external organization, which must not be the tenant under test
+const testPartnerTenantId = "72f988bf-86f1-41af-91ab-2d7cd011db47"
+
+type CrossTenantAccessPolicyPartnerResource struct{}
+
+func TestAccCrossTenantAccessPolicyPartner_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_cross_tenant_access_policy_partner", "test")
+	r := CrossTenantAccessPolicyPartnerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tenant_id").HasValue(testPartnerTenantId),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCrossTenantAccessPolicyPartner_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_cross_tenant_access_policy_partner", "test")
+	r := CrossTenantAccessPolicyPartnerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity_synchronization.0.user_sync_inbound_allowed").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCrossTenantAccessPolicyPartner_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_cross_tenant_access_policy_partner", "test")
+	r := CrossTenantAccessPolicyPartnerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity_synchronization.#").HasValue("0"),
+				check.That(data.ResourceName).Key("b2b_collaboration_inbound.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r CrossTenantAccessPolicyPartnerResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.CrossTenantAccess.CrossTenantAccessPolicyClient
+	client.BaseClient.DisableRetries = true
+	defer func() { client.BaseClient.DisableRetries = false }()
+
+	id, err := parse.ParseCrossTenantAccessPolicyPartnerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, status, err := client.GetPartner(ctx, id.TenantId, odata.Query{}); err != nil {
+		if status == http.StatusNotFound {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (CrossTenantAccessPolicyPartnerResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_cross_tenant_access_policy_partner" "test" {
+  tenant_id = "%[1]s"
+}
+`, testPartnerTenantId)
+}
+
+func (CrossTenantAccessPolicyPartnerResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[2]d"
+  security_enabled = true
+}
+
+resource "azuread_cross_tenant_access_policy_partner" "test" {
+  tenant_id = "%[1]s"
+
+  automatic_user_consent {
+    inbound_allowed  = true
+    outbound_allowed = false
+  }
+
+  b2b_collaboration_inbound {
+    users_and_groups {
+      access_type = "allowed"
+
+      target {
+        target      = "AllUsers"
+        target_type = "user"
+      }
+    }
+
+    applications {
+      access_type = "allowed"
+
+      target {
+        target      = "AllApplications"
+        target_type = "application"
+      }
+    }
+  }
+
+  b2b_collaboration_outbound {
+    users_and_groups {
+      access_type = "allowed"
+
+      target {
+        target      = azuread_group.test.object_id
+        target_type = "group"
+      }
+    }
+
+    applications {
+      access_type = "allowed"
+
+      target {
+        target      = "AllApplications"
+        target_type = "application"
+      }
+    }
+  }
+
+  identity_synchronization {
+    display_name              = "acctest-%[2]d"
+    user_sync_inbound_allowed = true
+  }
+
+  inbound_trust {
+    mfa_accepted              = true
+    compliant_device_accepted = true
+  }
+}
+`, testPartnerTenantId, data.RandomInteger)
+}
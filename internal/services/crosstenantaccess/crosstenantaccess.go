@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package crosstenantaccess
+
+import (
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/crosstenantaccess/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+type CrossTenantAccessPolicyB2BSettingModel struct {
+	UsersAndGroups []CrossTenantAccessPolicyTargetConfigurationModel `tfschema:"users_and_groups"`
+	Applications   []CrossTenantAccessPolicyTargetConfigurationModel `tfschema:"applications"`
+}
+
+type CrossTenantAccessPolicyTargetConfigurationModel struct {
+	AccessType string                               `tfschema:"access_type"`
+	Targets    []CrossTenantAccessPolicyTargetModel `tfschema:"target"`
+}
+
+type CrossTenantAccessPolicyTargetModel struct {
+	Target     string `tfschema:"target"`
+	TargetType string `tfschema:"target_type"`
+}
+
+type CrossTenantAccessPolicyInboundTrustModel struct {
+	MfaAccepted                       bool `tfschema:"mfa_accepted"`
+	CompliantDeviceAccepted           bool `tfschema:"compliant_device_accepted"`
+	HybridAzureADJoinedDeviceAccepted bool `tfschema:"hybrid_azure_ad_joined_device_accepted"`
+}
+
+func crossTenantAccessPolicyB2BSettingSchema(description string, computed bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description: description,
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		Computed:    computed,
+		MaxItems:    1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"users_and_groups": crossTenantAccessPolicyTargetConfigurationSchema("The users and groups to which this setting applies", []string{
+					client.CrossTenantAccessPolicyTargetTypeUser,
+					client.CrossTenantAccessPolicyTargetTypeGroup,
+				}),
+
+				"applications": crossTenantAccessPolicyTargetConfigurationSchema("The applications to which this setting applies", []string{
+					client.CrossTenantAccessPolicyTargetTypeApplication,
+				}),
+			},
+		},
+	}
+}
+
+func crossTenantAccessPolicyTargetConfigurationSchema(description string, targetTypes []string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description: description,
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		Computed:    true,
+		MaxItems:    1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"access_type": {
+					Description: "Whether access is allowed or blocked for the specified targets",
+					Type:        pluginsdk.TypeString,
+					Required:    true,
+					ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice([]string{
+						client.CrossTenantAccessPolicyTargetConfigurationAccessTypeAllowed,
+						client.CrossTenantAccessPolicyTargetConfigurationAccessTypeBlocked,
+					}, false)),
+				},
+
+				"target": {
+					Description: "The targets to which access is allowed or blocked",
+					Type:        pluginsdk.TypeList,
+					Required:    true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"target": {
+								Description:      "The object ID of the target, or a keyword such as `AllUsers`, `AllApplications` or `Office365`",
+								Type:             pluginsdk.TypeString,
+								Required:         true,
+								ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotEmpty),
+							},
+
+							"target_type": {
+								Description:      "The type of the target",
+								Type:             pluginsdk.TypeString,
+								Required:         true,
+								ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice(targetTypes, false)),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func crossTenantAccessPolicyInboundTrustSchema(computed bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description: "Whether to accept claims from multi-factor authentication and device compliance in the external tenant",
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		Computed:    computed,
+		MaxItems:    1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"mfa_accepted": {
+					Description: "Whether to trust multi-factor authentication performed in the external tenant",
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
+
+				"compliant_device_accepted": {
+					Description: "Whether to trust compliant device claims from the external tenant",
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
+
+				"hybrid_azure_ad_joined_device_accepted": {
+					Description: "Whether to trust hybrid Azure AD joined device claims from the external tenant",
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
+			},
+		},
+	}
+}
+
+func expandCrossTenantAccessPolicyB2BSetting(in []CrossTenantAccessPolicyB2BSettingModel) *client.CrossTenantAccessPolicyB2BSetting {
+	if len(in) == 0 {
+		return nil
+	}
+
+	return &client.CrossTenantAccessPolicyB2BSetting{
+		UsersAndGroups: expandCrossTenantAccessPolicyTargetConfiguration(in[0].UsersAndGroups),
+		Applications:   expandCrossTenantAccessPolicyTargetConfiguration(in[0].Applications),
+	}
+}
+
+func expandCrossTenantAccessPolicyTargetConfiguration(in []CrossTenantAccessPolicyTargetConfigurationModel) *client.CrossTenantAccessPolicyTargetConfiguration {
+	if len(in) == 0 {
+		return nil
+	}
+
+	targets := make([]client.CrossTenantAccessPolicyTarget, 0)
+	for _, t := range in[0].Targets {
+		targets = append(targets, client.CrossTenantAccessPolicyTarget{
+			Target:     pointer.To(t.Target),
+			TargetType: pointer.To(t.TargetType),
+		})
+	}
+
+	return &client.CrossTenantAccessPolicyTargetConfiguration{
+		AccessType: pointer.To(in[0].AccessType),
+		Targets:    &targets,
+	}
+}
+
+func expandCrossTenantAccessPolicyInboundTrust(in []CrossTenantAccessPolicyInboundTrustModel) *client.CrossTenantAccessPolicyInboundTrust {
+	if len(in) == 0 {
+		return nil
+	}
+
+	return &client.CrossTenantAccessPolicyInboundTrust{
+		IsMfaAccepted:                       pointer.To(in[0].MfaAccepted),
+		IsCompliantDeviceAccepted:           pointer.To(in[0].CompliantDeviceAccepted),
+		IsHybridAzureADJoinedDeviceAccepted: pointer.To(in[0].HybridAzureADJoinedDeviceAccepted),
+	}
+}
+
+func flattenCrossTenantAccessPolicyB2BSetting(in *client.CrossTenantAccessPolicyB2BSetting) []CrossTenantAccessPolicyB2BSettingModel {
+	if in == nil {
+		return []CrossTenantAccessPolicyB2BSettingModel{}
+	}
+
+	return []CrossTenantAccessPolicyB2BSettingModel{{
+		UsersAndGroups: flattenCrossTenantAccessPolicyTargetConfiguration(in.UsersAndGroups),
+		Applications:   flattenCrossTenantAccessPolicyTargetConfiguration(in.Applications),
+	}}
+}
+
+func flattenCrossTenantAccessPolicyTargetConfiguration(in *client.CrossTenantAccessPolicyTargetConfiguration) []CrossTenantAccessPolicyTargetConfigurationModel {
+	if in == nil {
+		return []CrossTenantAccessPolicyTargetConfigurationModel{}
+	}
+
+	targets := make([]CrossTenantAccessPolicyTargetModel, 0)
+	if in.Targets != nil {
+		for _, t := range *in.Targets {
+			targets = append(targets, CrossTenantAccessPolicyTargetModel{
+				Target:     pointer.From(t.Target),
+				TargetType: pointer.From(t.TargetType),
+			})
+		}
+	}
+
+	return []CrossTenantAccessPolicyTargetConfigurationModel{{
+		AccessType: pointer.From(in.AccessType),
+		Targets:    targets,
+	}}
+}
+
+func flattenCrossTenantAccessPolicyInboundTrust(in *client.CrossTenantAccessPolicyInboundTrust) []CrossTenantAccessPolicyInboundTrustModel {
+	if in == nil {
+		return []CrossTenantAccessPolicyInboundTrustModel{}
+	}
+
+	return []CrossTenantAccessPolicyInboundTrustModel{{
+		MfaAccepted:                       pointer.From(in.IsMfaAccepted),
+		CompliantDeviceAccepted:           pointer.From(in.IsCompliantDeviceAccepted),
+		HybridAzureADJoinedDeviceAccepted: pointer.From(in.IsHybridAzureADJoinedDeviceAccepted),
+	}}
+}
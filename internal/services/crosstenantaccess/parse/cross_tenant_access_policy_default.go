@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// CrossTenantAccessPolicyDefaultId identifies the default configuration of the cross-tenant access policy, of which
+// there is only one per tenant.
+type CrossTenantAccessPolicyDefaultId struct{}
+
+func NewCrossTenantAccessPolicyDefaultID() *CrossTenantAccessPolicyDefaultId {
+	return &CrossTenantAccessPolicyDefaultId{}
+}
+
+// ParseCrossTenantAccessPolicyDefaultID parses 'input' into a CrossTenantAccessPolicyDefaultId
+func ParseCrossTenantAccessPolicyDefaultID(input string) (*CrossTenantAccessPolicyDefaultId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&CrossTenantAccessPolicyDefaultId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := &CrossTenantAccessPolicyDefaultId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return id, nil
+}
+
+// ValidateCrossTenantAccessPolicyDefaultID checks that 'input' can be parsed as a Cross-Tenant Access Policy Default ID
+func ValidateCrossTenantAccessPolicyDefaultID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseCrossTenantAccessPolicyDefaultID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+func (id *CrossTenantAccessPolicyDefaultId) ID() string {
+	return "/policies/crossTenantAccessPolicy/default"
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this ID
+func (id *CrossTenantAccessPolicyDefaultId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("policies", "policies", "policies"),
+		resourceids.StaticSegment("crossTenantAccessPolicy", "crossTenantAccessPolicy", "crossTenantAccessPolicy"),
+		resourceids.StaticSegment("default", "default", "default"),
+	}
+}
+
+func (id *CrossTenantAccessPolicyDefaultId) String() string {
+	return "Cross-Tenant Access Policy Default Configuration"
+}
+
+func (id *CrossTenantAccessPolicyDefaultId) FromParseResult(input resourceids.ParseResult) error {
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+type CrossTenantAccessPolicyPartnerId struct {
+	TenantId string
+}
+
+func NewCrossTenantAccessPolicyPartnerID(tenantId string) *CrossTenantAccessPolicyPartnerId {
+	return &CrossTenantAccessPolicyPartnerId{
+		TenantId: tenantId,
+	}
+}
+
+// ParseCrossTenantAccessPolicyPartnerID parses 'input' into a CrossTenantAccessPolicyPartnerId
+func ParseCrossTenantAccessPolicyPartnerID(input string) (*CrossTenantAccessPolicyPartnerId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&CrossTenantAccessPolicyPartnerId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := &CrossTenantAccessPolicyPartnerId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return id, nil
+}
+
+// ValidateCrossTenantAccessPolicyPartnerID checks that 'input' can be parsed as a Cross-Tenant Access Policy Partner ID
+func ValidateCrossTenantAccessPolicyPartnerID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	id, err := ParseCrossTenantAccessPolicyPartnerID(v)
+	if err != nil {
+		errors = append(errors, err)
+		return
+	}
+
+	if warnings, errors = validation.IsUUID(id.TenantId, "ID"); len(errors) > 0 {
+		return
+	}
+
+	return
+}
+
+func (id *CrossTenantAccessPolicyPartnerId) ID() string {
+	fmtString := "/policies/crossTenantAccessPolicy/partners/%s"
+	return fmt.Sprintf(fmtString, id.TenantId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this ID
+func (id *CrossTenantAccessPolicyPartnerId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("policies", "policies", "policies"),
+		resourceids.StaticSegment("crossTenantAccessPolicy", "crossTenantAccessPolicy", "crossTenantAccessPolicy"),
+		resourceids.StaticSegment("partners", "partners", "partners"),
+		resourceids.UserSpecifiedSegment("tenantId", "00000000-0000-0000-0000-000000000000"),
+	}
+}
+
+func (id *CrossTenantAccessPolicyPartnerId) String() string {
+	return fmt.Sprintf("Cross-Tenant Access Policy Partner Configuration (Tenant ID: %q)", id.TenantId)
+}
+
+func (id *CrossTenantAccessPolicyPartnerId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.TenantId, ok = input.Parsed["tenantId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "tenantId", input)
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	policiesClient "github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+func activityBasedTimeoutPolicyResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: activityBasedTimeoutPolicyResourceCreate,
+		ReadContext:   activityBasedTimeoutPolicyResourceRead,
+		UpdateContext: activityBasedTimeoutPolicyResourceUpdate,
+		DeleteContext: activityBasedTimeoutPolicyResourceDelete,
+
+		CustomizeDiff: activityBasedTimeoutPolicyResourceCustomizeDiff,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"display_name": {
+				Description:      "The display name for the policy",
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotWhiteSpace),
+			},
+
+			"definition": {
+				Description: "The settings for the policy",
+				Type:        pluginsdk.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"application_policy": {
+							Description: "The idle timeouts for web sessions",
+							Type:        pluginsdk.TypeList,
+							Required:    true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"application_id": {
+										Description:      "The client ID of the application to which the timeout applies, or `default` for all applications",
+										Type:             pluginsdk.TypeString,
+										Optional:         true,
+										Default:          activityBasedTimeoutPolicyDefaultApplicationId,
+										ValidateDiagFunc: validation.ValidateDiag(activityBasedTimeoutPolicyValidateApplicationId),
+									},
+
+									"web_session_idle_timeout": {
+										Description:      "The idle timeout for web sessions, in the format `[d.]hh:mm:ss`",
+										Type:             pluginsdk.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validation.ValidateDiag(validation.StringMatch(activityBasedTimeoutPolicyTimeoutRegex, "must be a timespan in the format `[d.]hh:mm:ss`, e.g. `01:00:00`")),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"organization_default": {
+				Description: "Whether the policy applies to the whole tenant. Activity-based timeout policies only take effect when this is set",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
+func activityBasedTimeoutPolicyResourceCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	return activityBasedTimeoutPolicyValidateApplicationPolicies(diff.Get("definition.0.application_policy").([]interface{}))
+}
+
+func activityBasedTimeoutPolicyResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.ActivityBasedTimeoutPolicyClient
+
+	definition, err := stsPolicyEncodeDefinition(expandActivityBasedTimeoutPolicyDefinition(d.Get("definition").([]interface{})))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "definition", "Could not encode definition for activity-based timeout policy")
+	}
+
+	properties := policiesClient.StsPolicy{
+		Definition:            definition,
+		DisplayName:           pointer.To(d.Get("display_name").(string)),
+		IsOrganizationDefault: pointer.To(d.Get("organization_default").(bool)),
+	}
+
+	policy, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not create activity-based timeout policy")
+	}
+
+	if policy == nil || policy.ID == nil || *policy.ID == "" {
+		return tf.ErrorDiagF(errors.New("Object ID returned for activity-based timeout policy is nil"), "Bad API response")
+	}
+
+	d.SetId(*policy.ID)
+
+	return activityBasedTimeoutPolicyResourceRead(ctx, d, meta)
+}
+
+func activityBasedTimeoutPolicyResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.ActivityBasedTimeoutPolicyClient
+
+	definition, err := stsPolicyEncodeDefinition(expandActivityBasedTimeoutPolicyDefinition(d.Get("definition").([]interface{})))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "definition", "Could not encode definition for activity-based timeout policy")
+	}
+
+	properties := policiesClient.StsPolicy{
+		ID:                    pointer.To(d.Id()),
+		Definition:            definition,
+		DisplayName:           pointer.To(d.Get("display_name").(string)),
+		IsOrganizationDefault: pointer.To(d.Get("organization_default").(bool)),
+	}
+
+	if _, err := client.Update(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Could not update activity-based timeout policy with object ID %q", d.Id())
+	}
+
+	return activityBasedTimeoutPolicyResourceRead(ctx, d, meta)
+}
+
+func activityBasedTimeoutPolicyResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.ActivityBasedTimeoutPolicyClient
+
+	policy, status, err := client.Get(ctx, d.Id(), odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Activity-Based Timeout Policy with Object ID %q was not found - removing from state!", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return tf.ErrorDiagF(err, "Retrieving activity-based timeout policy with object ID %q", d.Id())
+	}
+	if policy == nil {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Result is nil")
+	}
+
+	var definition activityBasedTimeoutPolicyDefinition
+	if err := stsPolicyDecodeDefinition(policy.Definition, &definition); err != nil {
+		return tf.ErrorDiagPathF(err, "definition", "Could not decode definition for activity-based timeout policy with object ID %q", d.Id())
+	}
+
+	tf.Set(d, "definition", flattenActivityBasedTimeoutPolicyDefinition(definition))
+	tf.Set(d, "display_name", policy.DisplayName)
+	tf.Set(d, "organization_default", pointer.From(policy.IsOrganizationDefault))
+
+	return nil
+}
+
+func activityBasedTimeoutPolicyResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.ActivityBasedTimeoutPolicyClient
+
+	if status, err := client.Delete(ctx, d.Id()); err != nil {
+		if status == http.StatusNotFound {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Deleting activity-based timeout policy with object ID %q, received status %d", d.Id(), status)
+	}
+
+	return nil
+}
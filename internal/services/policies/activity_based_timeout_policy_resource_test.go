@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type ActivityBasedTimeoutPolicyResource struct{}
+
+func TestAccActivityBasedTimeoutPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_activity_based_timeout_policy", "test")
+	r := ActivityBasedTimeoutPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("definition.0.application_policy.0.application_id").HasValue("default"),
+				check.That(data.ResourceName).Key("organization_default").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("definition.0.application_policy.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ActivityBasedTimeoutPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.ActivityBasedTimeoutPolicyClient
+	client.BaseClient.DisableRetries = true
+	defer func() { client.BaseClient.DisableRetries = false }()
+
+	exists := false
+	_, status, err := client.Get(ctx, state.ID, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Activity-based timeout policy with object ID %q does not exist", state.ID)
+		}
+		return &exists, fmt.Errorf("failed to retrieve activity-based timeout policy with object ID %q: %+v", state.ID, err)
+	}
+
+	exists = true
+	return &exists, nil
+}
+
+func (ActivityBasedTimeoutPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_activity_based_timeout_policy" "test" {
+  display_name = "acctest-ABTPolicy-%[1]d"
+
+  definition {
+    application_policy {
+      web_session_idle_timeout = "01:00:00"
+    }
+  }
+}
+`, data.RandomInteger)
+}
+
+func (ActivityBasedTimeoutPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application_registration" "test" {
+  display_name = "acctest-ABTPolicy-%[1]d"
+}
+
+resource "azuread_activity_based_timeout_policy" "test" {
+  display_name = "acctest-ABTPolicy-%[1]d-updated"
+
+  definition {
+    application_policy {
+      application_id           = "default"
+      web_session_idle_timeout = "02:00:00"
+    }
+
+    application_policy {
+      application_id           = azuread_application_registration.test.client_id
+      web_session_idle_timeout = "00:30:00"
+    }
+  }
+}
+`, data.RandomInteger)
+}
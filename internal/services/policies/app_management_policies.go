@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	policiesClient "github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+// appManagementPolicyDurationRegex matches an ISO 8601 duration, as accepted by the `maxLifetime` property of credential
+// restrictions
+var appManagementPolicyDurationRegex = regexp.MustCompile(`^P(?:[0-9]+Y)?(?:[0-9]+M)?(?:[0-9]+W)?(?:[0-9]+D)?(?:T(?:[0-9]+H)?(?:[0-9]+M)?(?:[0-9]+S)?)?$`)
+
+// appManagementPolicyLifetimeRestrictions are the restriction types which require a `max_lifetime`. All other
+// restriction types block the addition of the credential type entirely and do not accept a `max_lifetime`.
+var appManagementPolicyLifetimeRestrictions = map[string]bool{
+	policiesClient.AppManagementPolicyRestrictionTypeAsymmetricKeyLifetime: true,
+	policiesClient.AppManagementPolicyRestrictionTypePasswordLifetime:      true,
+	policiesClient.AppManagementPolicyRestrictionTypeSymmetricKeyLifetime:  true,
+}
+
+func appManagementPolicyPasswordCredentialSchema() *pluginsdk.Schema {
+	return appManagementPolicyCredentialSchema("Restrictions on password credentials and symmetric keys", []string{
+		policiesClient.AppManagementPolicyRestrictionTypeCustomPasswordAddition,
+		policiesClient.AppManagementPolicyRestrictionTypePasswordAddition,
+		policiesClient.AppManagementPolicyRestrictionTypePasswordLifetime,
+		policiesClient.AppManagementPolicyRestrictionTypeSymmetricKeyAddition,
+		policiesClient.AppManagementPolicyRestrictionTypeSymmetricKeyLifetime,
+	})
+}
+
+func appManagementPolicyKeyCredentialSchema() *pluginsdk.Schema {
+	return appManagementPolicyCredentialSchema("Restrictions on certificates", []string{
+		policiesClient.AppManagementPolicyRestrictionTypeAsymmetricKeyLifetime,
+	})
+}
+
+func appManagementPolicyCredentialSchema(description string, restrictionTypes []string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description: description,
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"restriction_type": {
+					Description:      "The type of restriction",
+					Type:             pluginsdk.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice(restrictionTypes, false)),
+				},
+
+				"max_lifetime": {
+					Description:      "The maximum lifetime of credentials, as an ISO 8601 duration. Required for lifetime restrictions",
+					Type:             pluginsdk.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ValidateDiag(validation.StringMatch(appManagementPolicyDurationRegex, "must be an ISO 8601 duration, e.g. `P90D`")),
+				},
+
+				"restrict_for_apps_created_after": {
+					Description:      "The restriction only applies to applications and service principals created after this date",
+					Type:             pluginsdk.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validation.ValidateDiag(validation.IsRFC3339Time),
+					DiffSuppressFunc: appManagementPolicyTimeDiffSuppress,
+				},
+			},
+		},
+	}
+}
+
+func appManagementPolicyRestrictionsSchema(description string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description: description,
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"key_credential": appManagementPolicyKeyCredentialSchema(),
+
+				"password_credential": appManagementPolicyPasswordCredentialSchema(),
+			},
+		},
+	}
+}
+
+func appManagementPolicyTimeDiffSuppress(_, oldValue, newValue string, _ *pluginsdk.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, oldValue)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, newValue)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+// appManagementPolicyValidateCredentials checks that each restriction type is specified at most once, and that a
+// `max_lifetime` is specified only for lifetime restrictions. Restrictions with unknown types are skipped.
+func appManagementPolicyValidateCredentials(attr string, in []interface{}) error {
+	seen := make(map[string]bool)
+
+	for i, raw := range in {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+
+		restrictionType := v["restriction_type"].(string)
+		if restrictionType == "" {
+			continue
+		}
+
+		if seen[restrictionType] {
+			return fmt.Errorf("the restriction type %q is specified more than once in `%s`", restrictionType, attr)
+		}
+		seen[restrictionType] = true
+
+		maxLifetime := v["max_lifetime"].(string)
+		if appManagementPolicyLifetimeRestrictions[restrictionType] && maxLifetime == "" {
+			return fmt.Errorf("`%s.%d.max_lifetime` is required for the %q restriction type", attr, i, restrictionType)
+		}
+		if !appManagementPolicyLifetimeRestrictions[restrictionType] && maxLifetime != "" {
+			return fmt.Errorf("`%s.%d.max_lifetime` cannot be specified for the %q restriction type", attr, i, restrictionType)
+		}
+	}
+
+	return nil
+}
+
+func expandAppManagementConfiguration(in []interface{}) *policiesClient.AppManagementConfiguration {
+	result := policiesClient.AppManagementConfiguration{
+		KeyCredentials:      &[]policiesClient.KeyCredentialConfiguration{},
+		PasswordCredentials: &[]policiesClient.PasswordCredentialConfiguration{},
+	}
+
+	if len(in) == 0 || in[0] == nil {
+		return &result
+	}
+
+	v := in[0].(map[string]interface{})
+	result.KeyCredentials = expandAppManagementPolicyKeyCredentials(v["key_credential"].([]interface{}))
+	result.PasswordCredentials = expandAppManagementPolicyPasswordCredentials(v["password_credential"].([]interface{}))
+
+	return &result
+}
+
+func expandAppManagementPolicyKeyCredentials(in []interface{}) *[]policiesClient.KeyCredentialConfiguration {
+	result := make([]policiesClient.KeyCredentialConfiguration, 0)
+
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+
+		credential := policiesClient.KeyCredentialConfiguration{
+			RestrictionType: pointer.To(v["restriction_type"].(string)),
+		}
+		if maxLifetime := v["max_lifetime"].(string); maxLifetime != "" {
+			credential.MaxLifetime = pointer.To(maxLifetime)
+		}
+		if restrictAfter, err := time.Parse(time.RFC3339, v["restrict_for_apps_created_after"].(string)); err == nil {
+			credential.RestrictForAppsCreatedAfter = pointer.To(restrictAfter)
+		}
+
+		result = append(result, credential)
+	}
+
+	return &result
+}
+
+func expandAppManagementPolicyPasswordCredentials(in []interface{}) *[]policiesClient.PasswordCredentialConfiguration {
+	result := make([]policiesClient.PasswordCredentialConfiguration, 0)
+
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+
+		credential := policiesClient.PasswordCredentialConfiguration{
+			RestrictionType: pointer.To(v["restriction_type"].(string)),
+		}
+		if maxLifetime := v["max_lifetime"].(string); maxLifetime != "" {
+			credential.MaxLifetime = pointer.To(maxLifetime)
+		}
+		if restrictAfter, err := time.Parse(time.RFC3339, v["restrict_for_apps_created_after"].(string)); err == nil {
+			credential.RestrictForAppsCreatedAfter = pointer.To(restrictAfter)
+		}
+
+		result = append(result, credential)
+	}
+
+	return &result
+}
+
+// flattenAppManagementConfiguration returns the restrictions as a block, or an empty list when there are no
+// restrictions so that an absent block does not produce a diff
+func flattenAppManagementConfiguration(in *policiesClient.AppManagementConfiguration) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	keyCredentials := flattenAppManagementPolicyKeyCredentials(in.KeyCredentials)
+	passwordCredentials := flattenAppManagementPolicyPasswordCredentials(in.PasswordCredentials)
+
+	if len(keyCredentials) == 0 && len(passwordCredentials) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"key_credential":      keyCredentials,
+		"password_credential": passwordCredentials,
+	}}
+}
+
+func flattenAppManagementPolicyKeyCredentials(in *[]policiesClient.KeyCredentialConfiguration) []interface{} {
+	result := make([]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	for _, credential := range *in {
+		result = append(result, map[string]interface{}{
+			"max_lifetime":                    pointer.From(credential.MaxLifetime),
+			"restrict_for_apps_created_after": flattenAppManagementPolicyTime(credential.RestrictForAppsCreatedAfter),
+			"restriction_type":                pointer.From(credential.RestrictionType),
+		})
+	}
+
+	return result
+}
+
+func flattenAppManagementPolicyPasswordCredentials(in *[]policiesClient.PasswordCredentialConfiguration) []interface{} {
+	result := make([]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	for _, credential := range *in {
+		result = append(result, map[string]interface{}{
+			"max_lifetime":                    pointer.From(credential.MaxLifetime),
+			"restrict_for_apps_created_after": flattenAppManagementPolicyTime(credential.RestrictForAppsCreatedAfter),
+			"restriction_type":                pointer.From(credential.RestrictionType),
+		})
+	}
+
+	return result
+}
+
+func flattenAppManagementPolicyTime(in *time.Time) string {
+	if in == nil {
+		return ""
+	}
+	return in.UTC().Format(time.RFC3339)
+}
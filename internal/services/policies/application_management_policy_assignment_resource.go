@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	applicationsParse "github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+func applicationManagementPolicyAssignmentResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: applicationManagementPolicyAssignmentResourceCreate,
+		ReadContext:   applicationManagementPolicyAssignmentResourceRead,
+		DeleteContext: applicationManagementPolicyAssignmentResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ParseAppManagementPolicyAssignmentID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"policy_id": {
+				Description:      "The object ID of the application management policy to assign",
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.IsUUID),
+			},
+
+			"application_id": {
+				Description:      "The resource ID of the application to which the policy should be assigned",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ExactlyOneOf:     []string{"application_id", "service_principal_id"},
+				ValidateDiagFunc: validation.ValidateDiag(applicationsParse.ValidateApplicationID),
+			},
+
+			"service_principal_id": {
+				Description:      "The object ID of the service principal to which the policy should be assigned",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ExactlyOneOf:     []string{"application_id", "service_principal_id"},
+				ValidateDiagFunc: validation.ValidateDiag(validation.IsUUID),
+			},
+		},
+	}
+}
+
+func applicationManagementPolicyAssignmentResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.AppManagementPolicyClient
+	policyId := d.Get("policy_id").(string)
+
+	var id *parse.AppManagementPolicyAssignmentId
+	if v := d.Get("application_id").(string); v != "" {
+		applicationId, err := applicationsParse.ParseApplicationID(v)
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "application_id", "Parsing `application_id`: %q", v)
+		}
+		id = parse.NewAppManagementPolicyAssignmentID(parse.AppManagementPolicyAssignmentTargetApplications, applicationId.ApplicationId, policyId)
+	} else {
+		id = parse.NewAppManagementPolicyAssignmentID(parse.AppManagementPolicyAssignmentTargetServicePrincipals, d.Get("service_principal_id").(string), policyId)
+	}
+
+	// An application or service principal can only have one policy assigned, so check for an existing assignment
+	// in order to return a helpful error
+	existing, _, err := client.ListAssigned(ctx, id.Target, id.ObjectId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing application management policies assigned to %s %q", id.Target, id.ObjectId)
+	}
+	if existing != nil {
+		for _, policy := range *existing {
+			if policy.ID == nil {
+				continue
+			}
+			if strings.EqualFold(*policy.ID, policyId) {
+				return tf.ImportAsExistsDiag("azuread_application_management_policy_assignment", id.ID())
+			}
+			return tf.ErrorDiagF(fmt.Errorf("policy %q is already assigned", *policy.ID), "Could not assign application management policy %q to %s %q, only one policy can be assigned at a time", policyId, id.Target, id.ObjectId)
+		}
+	}
+
+	if _, err := client.Assign(ctx, id.Target, id.ObjectId, policyId); err != nil {
+		return tf.ErrorDiagF(err, "Could not assign application management policy %q to %s %q", policyId, id.Target, id.ObjectId)
+	}
+
+	d.SetId(id.ID())
+
+	return applicationManagementPolicyAssignmentResourceRead(ctx, d, meta)
+}
+
+func applicationManagementPolicyAssignmentResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.AppManagementPolicyClient
+
+	id, err := parse.ParseAppManagementPolicyAssignmentID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing application management policy assignment ID %q", d.Id())
+	}
+
+	policies, status, err := client.ListAssigned(ctx, id.Target, id.ObjectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] %s with object ID %q was not found - removing application management policy assignment from state!", id.Target, id.ObjectId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Listing application management policies assigned to %s %q", id.Target, id.ObjectId)
+	}
+
+	found := false
+	if policies != nil {
+		for _, policy := range *policies {
+			if policy.ID != nil && strings.EqualFold(*policy.ID, id.PolicyId) {
+				found = true
+				break
+			}
+		}
+	}
+	if !found {
+		log.Printf("[DEBUG] Application management policy %q is not assigned to %s %q - removing from state!", id.PolicyId, id.Target, id.ObjectId)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "policy_id", id.PolicyId)
+
+	switch id.Target {
+	case parse.AppManagementPolicyAssignmentTargetApplications:
+		tf.Set(d, "application_id", applicationsParse.NewApplicationID(id.ObjectId).ID())
+		tf.Set(d, "service_principal_id", "")
+	case parse.AppManagementPolicyAssignmentTargetServicePrincipals:
+		tf.Set(d, "application_id", "")
+		tf.Set(d, "service_principal_id", id.ObjectId)
+	}
+
+	return nil
+}
+
+func applicationManagementPolicyAssignmentResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.AppManagementPolicyClient
+
+	id, err := parse.ParseAppManagementPolicyAssignmentID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing application management policy assignment ID %q", d.Id())
+	}
+
+	if status, err := client.Unassign(ctx, id.Target, id.ObjectId, id.PolicyId); err != nil {
+		if status == http.StatusNotFound {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Could not remove application management policy %q from %s %q", id.PolicyId, id.Target, id.ObjectId)
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/parse"
+)
+
+type ApplicationManagementPolicyAssignmentResource struct{}
+
+func TestAccApplicationManagementPolicyAssignment_application(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_management_policy_assignment", "test")
+	r := ApplicationManagementPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.application(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationManagementPolicyAssignment_servicePrincipal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_management_policy_assignment", "test")
+	r := ApplicationManagementPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.servicePrincipal(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationManagementPolicyAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.AppManagementPolicyClient
+	client.BaseClient.DisableRetries = true
+	defer func() { client.BaseClient.DisableRetries = false }()
+
+	id, err := parse.ParseAppManagementPolicyAssignmentID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing application management policy assignment ID: %v", err)
+	}
+
+	exists := false
+	policies, status, err := client.ListAssigned(ctx, id.Target, id.ObjectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("%s with object ID %q does not exist", id.Target, id.ObjectId)
+		}
+		return &exists, fmt.Errorf("failed to list application management policies assigned to %s %q: %+v", id.Target, id.ObjectId, err)
+	}
+
+	if policies != nil {
+		for _, policy := range *policies {
+			if policy.ID != nil && strings.EqualFold(*policy.ID, id.PolicyId) {
+				exists = true
+				break
+			}
+		}
+	}
+
+	return &exists, nil
+}
+
+func (ApplicationManagementPolicyAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application_management_policy" "test" {
+  display_name = "acctest-AppManagementPolicy-%[1]d"
+  description  = "Acceptance test policy"
+
+  restrictions {
+    password_credential {
+      restriction_type                = "passwordLifetime"
+      max_lifetime                    = "P90D"
+      restrict_for_apps_created_after = "2020-01-01T00:00:00Z"
+    }
+  }
+}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-AppManagementPolicy-%[1]d"
+}
+`, data.RandomInteger)
+}
+
+func (r ApplicationManagementPolicyAssignmentResource) application(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_management_policy_assignment" "test" {
+  application_id = azuread_application.test.id
+  policy_id      = azuread_application_management_policy.test.id
+}
+`, r.template(data))
+}
+
+func (r ApplicationManagementPolicyAssignmentResource) servicePrincipal(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+}
+
+resource "azuread_application_management_policy_assignment" "test" {
+  service_principal_id = azuread_service_principal.test.object_id
+  policy_id            = azuread_application_management_policy.test.id
+}
+`, r.template(data))
+}
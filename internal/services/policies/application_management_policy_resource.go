@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	policiesClient "github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+func applicationManagementPolicyResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: applicationManagementPolicyResourceCreate,
+		ReadContext:   applicationManagementPolicyResourceRead,
+		UpdateContext: applicationManagementPolicyResourceUpdate,
+		DeleteContext: applicationManagementPolicyResourceDelete,
+
+		CustomizeDiff: applicationManagementPolicyResourceCustomizeDiff,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"display_name": {
+				Description:      "The display name for the policy",
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotWhiteSpace),
+			},
+
+			"description": {
+				Description:      "The description for the policy",
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotWhiteSpace),
+			},
+
+			"enabled": {
+				Description: "Whether the policy is enabled",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+
+			"restrictions": appManagementPolicyRestrictionsSchema("The credential restrictions enforced for applications and service principals to which the policy is assigned"),
+		},
+	}
+}
+
+func applicationManagementPolicyResourceCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	if err := appManagementPolicyValidateCredentials("restrictions.0.key_credential", diff.Get("restrictions.0.key_credential").([]interface{})); err != nil {
+		return err
+	}
+	return appManagementPolicyValidateCredentials("restrictions.0.password_credential", diff.Get("restrictions.0.password_credential").([]interface{}))
+}
+
+func applicationManagementPolicyResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.AppManagementPolicyClient
+
+	properties := policiesClient.AppManagementPolicy{
+		Description:  pointer.To(d.Get("description").(string)),
+		DisplayName:  pointer.To(d.Get("display_name").(string)),
+		IsEnabled:    pointer.To(d.Get("enabled").(bool)),
+		Restrictions: expandAppManagementConfiguration(d.Get("restrictions").([]interface{})),
+	}
+
+	policy, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not create application management policy")
+	}
+
+	if policy == nil || policy.ID == nil || *policy.ID == "" {
+		return tf.ErrorDiagF(errors.New("Object ID returned for application management policy is nil"), "Bad API response")
+	}
+
+	d.SetId(*policy.ID)
+
+	return applicationManagementPolicyResourceRead(ctx, d, meta)
+}
+
+func applicationManagementPolicyResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.AppManagementPolicyClient
+
+	properties := policiesClient.AppManagementPolicy{
+		ID:           pointer.To(d.Id()),
+		Description:  pointer.To(d.Get("description").(string)),
+		DisplayName:  pointer.To(d.Get("display_name").(string)),
+		IsEnabled:    pointer.To(d.Get("enabled").(bool)),
+		Restrictions: expandAppManagementConfiguration(d.Get("restrictions").([]interface{})),
+	}
+
+	if _, err := client.Update(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Could not update application management policy with object ID %q", d.Id())
+	}
+
+	return applicationManagementPolicyResourceRead(ctx, d, meta)
+}
+
+func applicationManagementPolicyResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.AppManagementPolicyClient
+
+	policy, status, err := client.Get(ctx, d.Id(), odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Application Management Policy with Object ID %q was not found - removing from state!", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return tf.ErrorDiagF(err, "Retrieving application management policy with object ID %q", d.Id())
+	}
+	if policy == nil {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Result is nil")
+	}
+
+	tf.Set(d, "description", policy.Description)
+	tf.Set(d, "display_name", policy.DisplayName)
+	tf.Set(d, "enabled", pointer.From(policy.IsEnabled))
+	tf.Set(d, "restrictions", flattenAppManagementConfiguration(policy.Restrictions))
+
+	return nil
+}
+
+func applicationManagementPolicyResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.AppManagementPolicyClient
+
+	if status, err := client.Delete(ctx, d.Id()); err != nil {
+		if status == http.StatusNotFound {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Deleting application management policy with object ID %q, received status %d", d.Id(), status)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type ApplicationManagementPolicyResource struct{}
+
+func TestAccApplicationManagementPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_management_policy", "test")
+	r := ApplicationManagementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationManagementPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_management_policy", "test")
+	r := ApplicationManagementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("restrictions.0.key_credential.#").HasValue("1"),
+				check.That(data.ResourceName).Key("restrictions.0.password_credential.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("restrictions.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationManagementPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.AppManagementPolicyClient
+	client.BaseClient.DisableRetries = true
+	defer func() { client.BaseClient.DisableRetries = false }()
+
+	exists := false
+	_, status, err := client.Get(ctx, state.ID, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Application management policy with object ID %q does not exist", state.ID)
+		}
+		return &exists, fmt.Errorf("failed to retrieve application management policy with object ID %q: %+v", state.ID, err)
+	}
+
+	exists = true
+	return &exists, nil
+}
+
+func (ApplicationManagementPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application_management_policy" "test" {
+  display_name = "acctest-AppManagementPolicy-%[1]d"
+  description  = "Acceptance test policy"
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationManagementPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application_management_policy" "test" {
+  display_name = "acctest-AppManagementPolicy-%[1]d"
+  description  = "Acceptance test policy with restrictions"
+  enabled      = false
+
+  restrictions {
+    key_credential {
+      restriction_type                = "asymmetricKeyLifetime"
+      max_lifetime                    = "P365D"
+      restrict_for_apps_created_after = "2020-01-01T00:00:00Z"
+    }
+
+    password_credential {
+      restriction_type                = "passwordAddition"
+      restrict_for_apps_created_after = "2021-01-01T00:00:00Z"
+    }
+
+    password_credential {
+      restriction_type                = "passwordLifetime"
+      max_lifetime                    = "P90D"
+      restrict_for_apps_created_after = "2020-01-01T00:00:00Z"
+    }
+  }
+}
+`, data.RandomInteger)
+}
@@ -0,0 +1,662 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	policiesClient "github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+// authenticationMethodConfigurationBlocks maps each method-specific block to the authentication method it configures
+var authenticationMethodConfigurationBlocks = map[string]string{
+	"email":                   policiesClient.AuthenticationMethodConfigurationIdEmail,
+	"fido2":                   policiesClient.AuthenticationMethodConfigurationIdFido2,
+	"microsoft_authenticator": policiesClient.AuthenticationMethodConfigurationIdMicrosoftAuthenticator,
+	"temporary_access_pass":   policiesClient.AuthenticationMethodConfigurationIdTemporaryAccessPass,
+	"x509_certificate":        policiesClient.AuthenticationMethodConfigurationIdX509Certificate,
+}
+
+func authenticationMethodConfigurationResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: authenticationMethodConfigurationResourceCreateUpdate,
+		ReadContext:   authenticationMethodConfigurationResourceRead,
+		UpdateContext: authenticationMethodConfigurationResourceCreateUpdate,
+		DeleteContext: authenticationMethodConfigurationResourceDelete,
+
+		CustomizeDiff: authenticationMethodConfigurationResourceCustomizeDiff,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, ok := policiesClient.AuthenticationMethodConfigurationODataTypes[id]; !ok {
+				return fmt.Errorf("specified ID (%q) is not a supported authentication method", id)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"authentication_method": {
+				Description:      "The authentication method to configure",
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice(possibleValuesForAuthenticationMethodConfigurationId(), false)),
+			},
+
+			"state": {
+				Description: "Whether the authentication method is enabled",
+				Type:        pluginsdk.TypeString,
+				Required:    true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice([]string{
+					policiesClient.AuthenticationMethodStateDisabled,
+					policiesClient.AuthenticationMethodStateEnabled,
+				}, false)),
+			},
+
+			"include_target": {
+				Description: "The users and groups who can use the authentication method",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Description:      "The object ID of a user or group, or `all_users`",
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotEmpty),
+						},
+
+						"target_type": {
+							Description:      "The type of the target",
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice(possibleValuesForAuthenticationMethodTargetType(), false)),
+						},
+
+						"registration_required": {
+							Description: "Whether users are required to register the authentication method",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+
+			"exclude_target": authenticationMethodExcludeTargetSchema("The users and groups who cannot use the authentication method"),
+
+			"email": {
+				Description: "Settings for the email one-time passcode authentication method",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"allow_external_id_to_use_email_otp": {
+							Description:      "Whether external users can use email one-time passcodes to sign in",
+							Type:             pluginsdk.TypeString,
+							Optional:         true,
+							Default:          policiesClient.AdvancedConfigStateDefault,
+							ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice(possibleValuesForAdvancedConfigState(), false)),
+						},
+					},
+				},
+			},
+
+			"fido2": {
+				Description: "Settings for the FIDO2 security key authentication method",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"attestation_enforced": {
+							Description: "Whether security keys must be attested during registration",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+
+						"self_service_registration_allowed": {
+							Description: "Whether users can register security keys themselves",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+
+						"key_restrictions": {
+							Description: "Restrictions on the types of security keys which can be registered",
+							Type:        pluginsdk.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"aaguids": {
+										Description: "The AAGUIDs of the security key models to allow or block",
+										Type:        pluginsdk.TypeSet,
+										Optional:    true,
+										Elem: &pluginsdk.Schema{
+											Type:             pluginsdk.TypeString,
+											ValidateDiagFunc: validation.ValidateDiag(validation.IsUUID),
+										},
+									},
+
+									"enforced": {
+										Description: "Whether the key restrictions are enforced",
+										Type:        pluginsdk.TypeBool,
+										Optional:    true,
+										Default:     true,
+									},
+
+									"enforcement_type": {
+										Description: "Whether the specified security key models are allowed or blocked",
+										Type:        pluginsdk.TypeString,
+										Required:    true,
+										ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice([]string{
+											policiesClient.Fido2RestrictionEnforcementTypeAllow,
+											policiesClient.Fido2RestrictionEnforcementTypeBlock,
+										}, false)),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"microsoft_authenticator": {
+				Description: "Settings for the Microsoft Authenticator authentication method",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"display_app_information_required_state": {
+							Description:      "Whether the name of the application requesting authentication is shown in notifications",
+							Type:             pluginsdk.TypeString,
+							Optional:         true,
+							Default:          policiesClient.AdvancedConfigStateDefault,
+							ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice(possibleValuesForAdvancedConfigState(), false)),
+						},
+
+						"display_location_information_required_state": {
+							Description:      "Whether the geographic location of the sign-in is shown in notifications",
+							Type:             pluginsdk.TypeString,
+							Optional:         true,
+							Default:          policiesClient.AdvancedConfigStateDefault,
+							ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice(possibleValuesForAdvancedConfigState(), false)),
+						},
+
+						"number_matching_required_state": {
+							Description:      "Whether users are required to enter the number shown on the sign-in screen when approving notifications",
+							Type:             pluginsdk.TypeString,
+							Optional:         true,
+							Default:          policiesClient.AdvancedConfigStateDefault,
+							ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice(possibleValuesForAdvancedConfigState(), false)),
+						},
+
+						"software_oath_enabled": {
+							Description: "Whether users can use the one-time passcodes generated by the Microsoft Authenticator app",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+
+			"temporary_access_pass": {
+				Description: "Settings for the Temporary Access Pass authentication method",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"default_length": {
+							Description:      "The default length of a Temporary Access Pass",
+							Type:             pluginsdk.TypeInt,
+							Optional:         true,
+							Default:          8,
+							ValidateDiagFunc: validation.ValidateDiag(validation.IntBetween(8, 48)),
+						},
+
+						"default_lifetime_in_minutes": {
+							Description:      "The default lifetime of a Temporary Access Pass, in minutes",
+							Type:             pluginsdk.TypeInt,
+							Optional:         true,
+							Default:          60,
+							ValidateDiagFunc: validation.ValidateDiag(validation.IntBetween(10, 43200)),
+						},
+
+						"maximum_lifetime_in_minutes": {
+							Description:      "The maximum lifetime of a Temporary Access Pass, in minutes",
+							Type:             pluginsdk.TypeInt,
+							Optional:         true,
+							Default:          480,
+							ValidateDiagFunc: validation.ValidateDiag(validation.IntBetween(10, 43200)),
+						},
+
+						"minimum_lifetime_in_minutes": {
+							Description:      "The minimum lifetime of a Temporary Access Pass, in minutes",
+							Type:             pluginsdk.TypeInt,
+							Optional:         true,
+							Default:          60,
+							ValidateDiagFunc: validation.ValidateDiag(validation.IntBetween(10, 43200)),
+						},
+
+						"usable_once": {
+							Description: "Whether a Temporary Access Pass can only be used once by default",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+
+			"x509_certificate": {
+				Description: "Settings for the certificate-based authentication method",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"authentication_default_mode": {
+							Description: "Whether certificate-based authentication satisfies single-factor or multi-factor authentication by default",
+							Type:        pluginsdk.TypeString,
+							Optional:    true,
+							Default:     policiesClient.X509CertificateAuthenticationModeSingleFactor,
+							ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice([]string{
+								policiesClient.X509CertificateAuthenticationModeMultiFactor,
+								policiesClient.X509CertificateAuthenticationModeSingleFactor,
+							}, false)),
+						},
+
+						"certificate_user_binding": {
+							Description: "Bindings between certificate fields and user properties, used to identify the user",
+							Type:        pluginsdk.TypeList,
+							Optional:    true,
+							Computed:    true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"priority": {
+										Description:      "The priority of the binding, where lower numbers take precedence",
+										Type:             pluginsdk.TypeInt,
+										Required:         true,
+										ValidateDiagFunc: validation.ValidateDiag(validation.IntAtLeast(1)),
+									},
+
+									"user_property": {
+										Description:      "The user property to match, e.g. `userPrincipalName` or `onPremisesUserPrincipalName`",
+										Type:             pluginsdk.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotEmpty),
+									},
+
+									"x509_certificate_field": {
+										Description:      "The certificate field to match, e.g. `PrincipalName` or `RFC822Name`",
+										Type:             pluginsdk.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotEmpty),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func authenticationMethodConfigurationResourceCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	method := diff.Get("authentication_method").(string)
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	for block, blockMethod := range authenticationMethodConfigurationBlocks {
+		v := rawConfig.GetAttr(block)
+		if blockMethod != method && !v.IsNull() && v.IsKnown() && v.LengthInt() > 0 {
+			return fmt.Errorf("the `%s` block can only be specified when `authentication_method` is %q", block, blockMethod)
+		}
+	}
+
+	return nil
+}
+
+func authenticationMethodConfigurationResourceCreateUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthenticationMethodsPolicyClient
+	method := d.Get("authentication_method").(string)
+
+	properties := policiesClient.AuthenticationMethodConfiguration{
+		ID:             pointer.To(method),
+		ODataType:      pointer.To(policiesClient.AuthenticationMethodConfigurationODataTypes[method]),
+		State:          pointer.To(d.Get("state").(string)),
+		ExcludeTargets: expandAuthenticationMethodExcludeTargets(d.Get("exclude_target").([]interface{})),
+	}
+
+	if includeTargets := expandAuthenticationMethodTargets(d.Get("include_target").([]interface{})); len(*includeTargets) > 0 {
+		properties.IncludeTargets = includeTargets
+	}
+
+	switch method {
+	case policiesClient.AuthenticationMethodConfigurationIdEmail:
+		if v := d.Get("email").([]interface{}); len(v) > 0 && v[0] != nil {
+			config := v[0].(map[string]interface{})
+			properties.AllowExternalIdToUseEmailOtp = pointer.To(config["allow_external_id_to_use_email_otp"].(string))
+		}
+
+	case policiesClient.AuthenticationMethodConfigurationIdFido2:
+		if v := d.Get("fido2").([]interface{}); len(v) > 0 && v[0] != nil {
+			config := v[0].(map[string]interface{})
+			properties.IsAttestationEnforced = pointer.To(config["attestation_enforced"].(bool))
+			properties.IsSelfServiceRegistrationAllowed = pointer.To(config["self_service_registration_allowed"].(bool))
+			properties.KeyRestrictions = &policiesClient.Fido2KeyRestrictions{
+				AaGuids:         &[]string{},
+				EnforcementType: pointer.To(policiesClient.Fido2RestrictionEnforcementTypeBlock),
+				IsEnforced:      pointer.To(false),
+			}
+			if r := config["key_restrictions"].([]interface{}); len(r) > 0 && r[0] != nil {
+				restrictions := r[0].(map[string]interface{})
+				properties.KeyRestrictions = &policiesClient.Fido2KeyRestrictions{
+					AaGuids:         tf.ExpandStringSlicePtr(restrictions["aaguids"].(*pluginsdk.Set).List()),
+					EnforcementType: pointer.To(restrictions["enforcement_type"].(string)),
+					IsEnforced:      pointer.To(restrictions["enforced"].(bool)),
+				}
+			}
+		}
+
+	case policiesClient.AuthenticationMethodConfigurationIdMicrosoftAuthenticator:
+		if v := d.Get("microsoft_authenticator").([]interface{}); len(v) > 0 && v[0] != nil {
+			config := v[0].(map[string]interface{})
+			properties.IsSoftwareOathEnabled = pointer.To(config["software_oath_enabled"].(bool))
+			properties.FeatureSettings = &policiesClient.MicrosoftAuthenticatorFeatureSettings{
+				DisplayAppInformationRequiredState: &policiesClient.AuthenticationMethodFeatureConfiguration{
+					State: pointer.To(config["display_app_information_required_state"].(string)),
+				},
+				DisplayLocationInformationRequiredState: &policiesClient.AuthenticationMethodFeatureConfiguration{
+					State: pointer.To(config["display_location_information_required_state"].(string)),
+				},
+				NumberMatchingRequiredState: &policiesClient.AuthenticationMethodFeatureConfiguration{
+					State: pointer.To(config["number_matching_required_state"].(string)),
+				},
+			}
+		}
+
+	case policiesClient.AuthenticationMethodConfigurationIdTemporaryAccessPass:
+		if v := d.Get("temporary_access_pass").([]interface{}); len(v) > 0 && v[0] != nil {
+			config := v[0].(map[string]interface{})
+			properties.DefaultLength = pointer.To(config["default_length"].(int))
+			properties.DefaultLifetimeInMinutes = pointer.To(config["default_lifetime_in_minutes"].(int))
+			properties.IsUsableOnce = pointer.To(config["usable_once"].(bool))
+			properties.MaximumLifetimeInMinutes = pointer.To(config["maximum_lifetime_in_minutes"].(int))
+			properties.MinimumLifetimeInMinutes = pointer.To(config["minimum_lifetime_in_minutes"].(int))
+		}
+
+	case policiesClient.AuthenticationMethodConfigurationIdX509Certificate:
+		if v := d.Get("x509_certificate").([]interface{}); len(v) > 0 && v[0] != nil {
+			config := v[0].(map[string]interface{})
+			properties.AuthenticationModeConfiguration = &policiesClient.X509CertificateAuthenticationModeConfiguration{
+				X509CertificateAuthenticationDefaultMode: pointer.To(config["authentication_default_mode"].(string)),
+			}
+
+			if bindings := config["certificate_user_binding"].([]interface{}); len(bindings) > 0 {
+				userBindings := make([]policiesClient.X509CertificateUserBinding, 0)
+				for _, raw := range bindings {
+					binding := raw.(map[string]interface{})
+					userBindings = append(userBindings, policiesClient.X509CertificateUserBinding{
+						Priority:             pointer.To(binding["priority"].(int)),
+						UserProperty:         pointer.To(binding["user_property"].(string)),
+						X509CertificateField: pointer.To(binding["x509_certificate_field"].(string)),
+					})
+				}
+				properties.CertificateUserBindings = &userBindings
+			}
+		}
+	}
+
+	if _, err := client.UpdateMethodConfiguration(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Could not update authentication method configuration %q", method)
+	}
+
+	d.SetId(method)
+
+	return authenticationMethodConfigurationResourceRead(ctx, d, meta)
+}
+
+func authenticationMethodConfigurationResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthenticationMethodsPolicyClient
+
+	configuration, status, err := client.GetMethodConfiguration(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(err, "authentication_method", "Authentication method %q is not available in this tenant", d.Id())
+		}
+		return tf.ErrorDiagF(err, "Could not retrieve authentication method configuration %q", d.Id())
+	}
+	if configuration == nil {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Result is nil")
+	}
+
+	includeTargets := make([]interface{}, 0)
+	if configuration.IncludeTargets != nil {
+		for _, target := range *configuration.IncludeTargets {
+			includeTargets = append(includeTargets, map[string]interface{}{
+				"id":                    pointer.From(target.ID),
+				"registration_required": pointer.From(target.IsRegistrationRequired),
+				"target_type":           pointer.From(target.TargetType),
+			})
+		}
+	}
+
+	tf.Set(d, "authentication_method", d.Id())
+	tf.Set(d, "state", configuration.State)
+	tf.Set(d, "include_target", includeTargets)
+	tf.Set(d, "exclude_target", flattenAuthenticationMethodExcludeTargets(configuration.ExcludeTargets))
+
+	switch d.Id() {
+	case policiesClient.AuthenticationMethodConfigurationIdEmail:
+		tf.Set(d, "email", []interface{}{map[string]interface{}{
+			"allow_external_id_to_use_email_otp": pointer.From(configuration.AllowExternalIdToUseEmailOtp),
+		}})
+
+	case policiesClient.AuthenticationMethodConfigurationIdFido2:
+		keyRestrictions := make([]interface{}, 0)
+		if r := configuration.KeyRestrictions; r != nil && pointer.From(r.IsEnforced) {
+			keyRestrictions = append(keyRestrictions, map[string]interface{}{
+				"aaguids":          tf.FlattenStringSlicePtr(r.AaGuids),
+				"enforced":         pointer.From(r.IsEnforced),
+				"enforcement_type": pointer.From(r.EnforcementType),
+			})
+		}
+		tf.Set(d, "fido2", []interface{}{map[string]interface{}{
+			"attestation_enforced":              pointer.From(configuration.IsAttestationEnforced),
+			"key_restrictions":                  keyRestrictions,
+			"self_service_registration_allowed": pointer.From(configuration.IsSelfServiceRegistrationAllowed),
+		}})
+
+	case policiesClient.AuthenticationMethodConfigurationIdMicrosoftAuthenticator:
+		displayAppInformation, displayLocationInformation, numberMatching := "", "", ""
+		if s := configuration.FeatureSettings; s != nil {
+			if s.DisplayAppInformationRequiredState != nil {
+				displayAppInformation = pointer.From(s.DisplayAppInformationRequiredState.State)
+			}
+			if s.DisplayLocationInformationRequiredState != nil {
+				displayLocationInformation = pointer.From(s.DisplayLocationInformationRequiredState.State)
+			}
+			if s.NumberMatchingRequiredState != nil {
+				numberMatching = pointer.From(s.NumberMatchingRequiredState.State)
+			}
+		}
+		tf.Set(d, "microsoft_authenticator", []interface{}{map[string]interface{}{
+			"display_app_information_required_state":      displayAppInformation,
+			"display_location_information_required_state": displayLocationInformation,
+			"number_matching_required_state":              numberMatching,
+			"software_oath_enabled":                       pointer.From(configuration.IsSoftwareOathEnabled),
+		}})
+
+	case policiesClient.AuthenticationMethodConfigurationIdTemporaryAccessPass:
+		tf.Set(d, "temporary_access_pass", []interface{}{map[string]interface{}{
+			"default_length":              pointer.From(configuration.DefaultLength),
+			"default_lifetime_in_minutes": pointer.From(configuration.DefaultLifetimeInMinutes),
+			"maximum_lifetime_in_minutes": pointer.From(configuration.MaximumLifetimeInMinutes),
+			"minimum_lifetime_in_minutes": pointer.From(configuration.MinimumLifetimeInMinutes),
+			"usable_once":                 pointer.From(configuration.IsUsableOnce),
+		}})
+
+	case policiesClient.AuthenticationMethodConfigurationIdX509Certificate:
+		defaultMode := ""
+		if configuration.AuthenticationModeConfiguration != nil {
+			defaultMode = pointer.From(configuration.AuthenticationModeConfiguration.X509CertificateAuthenticationDefaultMode)
+		}
+		bindings := make([]interface{}, 0)
+		if configuration.CertificateUserBindings != nil {
+			for _, binding := range *configuration.CertificateUserBindings {
+				bindings = append(bindings, map[string]interface{}{
+					"priority":               pointer.From(binding.Priority),
+					"user_property":          pointer.From(binding.UserProperty),
+					"x509_certificate_field": pointer.From(binding.X509CertificateField),
+				})
+			}
+		}
+		tf.Set(d, "x509_certificate", []interface{}{map[string]interface{}{
+			"authentication_default_mode": defaultMode,
+			"certificate_user_binding":    bindings,
+		}})
+	}
+
+	return nil
+}
+
+func authenticationMethodConfigurationResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthenticationMethodsPolicyClient
+	method := d.Id()
+
+	// Authentication method configurations cannot be deleted, so instead we disable the method
+	properties := policiesClient.AuthenticationMethodConfiguration{
+		ID:        pointer.To(method),
+		ODataType: pointer.To(policiesClient.AuthenticationMethodConfigurationODataTypes[method]),
+		State:     pointer.To(policiesClient.AuthenticationMethodStateDisabled),
+	}
+
+	if _, err := client.UpdateMethodConfiguration(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Could not disable authentication method %q", method)
+	}
+
+	return nil
+}
+
+func possibleValuesForAuthenticationMethodConfigurationId() []string {
+	return []string{
+		policiesClient.AuthenticationMethodConfigurationIdEmail,
+		policiesClient.AuthenticationMethodConfigurationIdFido2,
+		policiesClient.AuthenticationMethodConfigurationIdHardwareOath,
+		policiesClient.AuthenticationMethodConfigurationIdMicrosoftAuthenticator,
+		policiesClient.AuthenticationMethodConfigurationIdSms,
+		policiesClient.AuthenticationMethodConfigurationIdSoftwareOath,
+		policiesClient.AuthenticationMethodConfigurationIdTemporaryAccessPass,
+		policiesClient.AuthenticationMethodConfigurationIdVoice,
+		policiesClient.AuthenticationMethodConfigurationIdX509Certificate,
+	}
+}
+
+func expandAuthenticationMethodTargets(in []interface{}) *[]policiesClient.AuthenticationMethodTarget {
+	result := make([]policiesClient.AuthenticationMethodTarget, 0)
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		target := raw.(map[string]interface{})
+		result = append(result, policiesClient.AuthenticationMethodTarget{
+			ID:                     pointer.To(target["id"].(string)),
+			IsRegistrationRequired: pointer.To(target["registration_required"].(bool)),
+			TargetType:             pointer.To(target["target_type"].(string)),
+		})
+	}
+
+	return &result
+}
+
+// authenticationMethodIdsForModes maps authentication method modes, as used in the allowed combinations of an
+// authentication strength policy, to the authentication methods which must be enabled for them to be usable. Modes
+// which are not governed by the authentication methods policy, such as `password`, are omitted.
+var authenticationMethodIdsForModes = map[string]string{
+	"deviceBasedPush":             policiesClient.AuthenticationMethodConfigurationIdMicrosoftAuthenticator,
+	"email":                       policiesClient.AuthenticationMethodConfigurationIdEmail,
+	"fido2":                       policiesClient.AuthenticationMethodConfigurationIdFido2,
+	"hardwareOath":                policiesClient.AuthenticationMethodConfigurationIdHardwareOath,
+	"microsoftAuthenticatorPush":  policiesClient.AuthenticationMethodConfigurationIdMicrosoftAuthenticator,
+	"sms":                         policiesClient.AuthenticationMethodConfigurationIdSms,
+	"softwareOath":                policiesClient.AuthenticationMethodConfigurationIdSoftwareOath,
+	"temporaryAccessPassMultiUse": policiesClient.AuthenticationMethodConfigurationIdTemporaryAccessPass,
+	"temporaryAccessPassOneTime":  policiesClient.AuthenticationMethodConfigurationIdTemporaryAccessPass,
+	"voice":                       policiesClient.AuthenticationMethodConfigurationIdVoice,
+	"x509CertificateMultiFactor":  policiesClient.AuthenticationMethodConfigurationIdX509Certificate,
+	"x509CertificateSingleFactor": policiesClient.AuthenticationMethodConfigurationIdX509Certificate,
+}
+
+// authenticationMethodStates retrieves the authentication methods policy and returns whether each authentication
+// method configured within it is enabled
+func authenticationMethodStates(ctx context.Context, client *policiesClient.AuthenticationMethodsPolicyClient) (map[string]bool, error) {
+	policy, _, err := client.Get(ctx, odata.Query{})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving authentication methods policy: %+v", err)
+	}
+	if policy == nil {
+		return nil, errors.New("retrieving authentication methods policy: result was nil")
+	}
+
+	enabled := make(map[string]bool)
+	if policy.AuthenticationMethodConfigurations != nil {
+		for _, configuration := range *policy.AuthenticationMethodConfigurations {
+			enabled[pointer.From(configuration.ID)] = pointer.From(configuration.State) == policiesClient.AuthenticationMethodStateEnabled
+		}
+	}
+
+	return enabled, nil
+}
+
+// validateAuthenticationStrengthAllowedCombinations checks that every authentication method required by the specified
+// combinations is not disabled, according to the provided authentication method states
+func validateAuthenticationStrengthAllowedCombinations(enabled map[string]bool, combinations []string) error {
+	for _, combination := range combinations {
+		for _, mode := range strings.Split(combination, ",") {
+			method, ok := authenticationMethodIdsForModes[mode]
+			if !ok {
+				continue
+			}
+			if isEnabled, found := enabled[method]; found && !isEnabled {
+				return fmt.Errorf("the combination %q requires the %q authentication method, which is not enabled in the authentication methods policy", combination, method)
+			}
+		}
+	}
+
+	return nil
+}
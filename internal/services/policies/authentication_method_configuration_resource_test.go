@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type AuthenticationMethodConfigurationResource struct{}
+
+func TestAccAuthenticationMethodConfiguration_temporaryAccessPass(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_method_configuration", "test")
+	r := AuthenticationMethodConfigurationResource{}
+
+	// Authentication method configurations always exist, they are disabled on destroy
+	data.ResourceTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.temporaryAccessPass(data, 8),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("enabled"),
+				check.That(data.ResourceName).Key("temporary_access_pass.0.default_length").HasValue("8"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.temporaryAccessPass(data, 12),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("temporary_access_pass.0.default_length").HasValue("12"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAuthenticationMethodConfiguration_fido2(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_method_configuration", "test")
+	r := AuthenticationMethodConfigurationResource{}
+
+	data.ResourceTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.fido2(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fido2.0.key_restrictions.0.aaguids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AuthenticationMethodConfigurationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.AuthenticationMethodsPolicyClient
+	client.BaseClient.DisableRetries = true
+	defer func() { client.BaseClient.DisableRetries = false }()
+
+	configuration, _, err := client.GetMethodConfiguration(ctx, state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve authentication method configuration %q: %+v", state.ID, err)
+	}
+
+	return pointer.To(configuration != nil && pointer.From(configuration.State) == "enabled"), nil
+}
+
+func (AuthenticationMethodConfigurationResource) temporaryAccessPass(data acceptance.TestData, defaultLength int) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  security_enabled = true
+}
+
+resource "azuread_authentication_method_configuration" "test" {
+  authentication_method = "TemporaryAccessPass"
+  state                 = "enabled"
+
+  include_target {
+    id          = azuread_group.test.object_id
+    target_type = "group"
+  }
+
+  temporary_access_pass {
+    default_length              = %[2]d
+    default_lifetime_in_minutes = 60
+    maximum_lifetime_in_minutes = 480
+    minimum_lifetime_in_minutes = 60
+    usable_once                 = true
+  }
+}
+`, data.RandomInteger, defaultLength)
+}
+
+func (AuthenticationMethodConfigurationResource) fido2(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  security_enabled = true
+}
+
+resource "azuread_authentication_method_configuration" "test" {
+  authentication_method = "Fido2"
+  state                 = "enabled"
+
+  include_target {
+    id          = azuread_group.test.object_id
+    target_type = "group"
+  }
+
+  fido2 {
+    attestation_enforced              = true
+    self_service_registration_allowed = true
+
+    key_restrictions {
+      enforcement_type = "allow"
+      aaguids          = ["cb69481e-8ff7-4039-93ec-0a2729a154a8"]
+    }
+  }
+}
+`, data.RandomInteger)
+}
@@ -0,0 +1,458 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	policiesClient "github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+const authenticationMethodsPolicyId = "authenticationMethodsPolicy"
+
+// authenticationMethodsPolicyAllUsers is the special target ID which refers to all users in the tenant
+const authenticationMethodsPolicyAllUsers = "all_users"
+
+func authenticationMethodsPolicyResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: authenticationMethodsPolicyResourceCreateUpdate,
+		ReadContext:   authenticationMethodsPolicyResourceRead,
+		UpdateContext: authenticationMethodsPolicyResourceCreateUpdate,
+		DeleteContext: authenticationMethodsPolicyResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if id != authenticationMethodsPolicyId {
+				return fmt.Errorf("specified ID (%q) is not valid, expected %q", id, authenticationMethodsPolicyId)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"reconfirmation_in_days": {
+				Description:      "The number of days before users are asked to reconfirm their authentication methods",
+				Type:             pluginsdk.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.IntBetween(0, 365)),
+			},
+
+			"registration_campaign": {
+				Description: "A campaign prompting users to register for the Microsoft Authenticator app",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"state": {
+							Description:      "Whether the registration campaign is enabled",
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice(possibleValuesForAdvancedConfigState(), false)),
+						},
+
+						"enforce_registration_after_allowed_snoozes": {
+							Description: "Whether users must register once they have snoozed the prompt the maximum number of times",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+
+						"snooze_duration_in_days": {
+							Description:      "The number of days a user can snooze the registration prompt",
+							Type:             pluginsdk.TypeInt,
+							Optional:         true,
+							Default:          1,
+							ValidateDiagFunc: validation.ValidateDiag(validation.IntBetween(0, 14)),
+						},
+
+						"include_target": {
+							Description: "The users and groups targeted by the registration campaign",
+							Type:        pluginsdk.TypeList,
+							Optional:    true,
+							Computed:    true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"id": {
+										Description:      "The object ID of a user or group, or `all_users`",
+										Type:             pluginsdk.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotEmpty),
+									},
+
+									"target_type": {
+										Description:      "The type of the target",
+										Type:             pluginsdk.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice(possibleValuesForAuthenticationMethodTargetType(), false)),
+									},
+
+									"targeted_authentication_method": {
+										Description: "The authentication method that users are prompted to register",
+										Type:        pluginsdk.TypeString,
+										Optional:    true,
+										Default:     "microsoftAuthenticator",
+									},
+								},
+							},
+						},
+
+						"exclude_target": authenticationMethodExcludeTargetSchema("The users and groups excluded from the registration campaign"),
+					},
+				},
+			},
+
+			"report_suspicious_activity": {
+				Description: "Settings for users to report unexpected voice call or phone app notification multi-factor authentication prompts as suspicious",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"state": {
+							Description:      "Whether users can report suspicious activity",
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice(possibleValuesForAdvancedConfigState(), false)),
+						},
+
+						"include_target": {
+							Description: "The users or group who can report suspicious activity",
+							Type:        pluginsdk.TypeList,
+							Optional:    true,
+							Computed:    true,
+							MaxItems:    1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"id": {
+										Description:      "The object ID of a group, or `all_users`",
+										Type:             pluginsdk.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotEmpty),
+									},
+
+									"target_type": {
+										Description:      "The type of the target",
+										Type:             pluginsdk.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice(possibleValuesForAuthenticationMethodTargetType(), false)),
+									},
+								},
+							},
+						},
+
+						"voice_reporting_code": {
+							Description:      "The code which users enter to report suspicious activity during a voice call",
+							Type:             pluginsdk.TypeInt,
+							Optional:         true,
+							Default:          0,
+							ValidateDiagFunc: validation.ValidateDiag(validation.IntBetween(0, 9)),
+						},
+					},
+				},
+			},
+
+			"description": {
+				Description: "The description of the authentication methods policy",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"display_name": {
+				Description: "The display name of the authentication methods policy",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"policy_version": {
+				Description: "The version of the authentication methods policy",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func authenticationMethodsPolicyResourceCreateUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthenticationMethodsPolicyClient
+
+	properties := policiesClient.AuthenticationMethodsPolicy{}
+
+	if v, ok := d.GetOk("reconfirmation_in_days"); ok && (d.IsNewResource() || d.HasChange("reconfirmation_in_days")) {
+		properties.ReconfirmationInDays = pointer.To(v.(int))
+	}
+
+	if d.IsNewResource() || d.HasChange("registration_campaign") {
+		if campaign := expandAuthenticationMethodsRegistrationCampaign(d.Get("registration_campaign").([]interface{})); campaign != nil {
+			properties.RegistrationEnforcement = &policiesClient.RegistrationEnforcement{
+				AuthenticationMethodsRegistrationCampaign: campaign,
+			}
+		}
+	}
+
+	if d.IsNewResource() || d.HasChange("report_suspicious_activity") {
+		properties.ReportSuspiciousActivitySettings = expandReportSuspiciousActivitySettings(d.Get("report_suspicious_activity").([]interface{}))
+	}
+
+	if _, err := client.Update(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Could not update authentication methods policy")
+	}
+
+	d.SetId(authenticationMethodsPolicyId)
+
+	return authenticationMethodsPolicyResourceRead(ctx, d, meta)
+}
+
+func authenticationMethodsPolicyResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthenticationMethodsPolicyClient
+
+	policy, _, err := client.Get(ctx, odata.Query{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not retrieve authentication methods policy")
+	}
+	if policy == nil {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Result is nil")
+	}
+
+	var campaign *policiesClient.AuthenticationMethodsRegistrationCampaign
+	if policy.RegistrationEnforcement != nil {
+		campaign = policy.RegistrationEnforcement.AuthenticationMethodsRegistrationCampaign
+	}
+
+	tf.Set(d, "description", policy.Description)
+	tf.Set(d, "display_name", policy.DisplayName)
+	tf.Set(d, "policy_version", policy.PolicyVersion)
+	tf.Set(d, "reconfirmation_in_days", pointer.From(policy.ReconfirmationInDays))
+	tf.Set(d, "registration_campaign", flattenAuthenticationMethodsRegistrationCampaign(campaign))
+	tf.Set(d, "report_suspicious_activity", flattenReportSuspiciousActivitySettings(policy.ReportSuspiciousActivitySettings))
+
+	return nil
+}
+
+func authenticationMethodsPolicyResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthenticationMethodsPolicyClient
+
+	// The authentication methods policy cannot be deleted, so we reset the settings managed by this resource to their
+	// defaults, targeting all users
+	properties := policiesClient.AuthenticationMethodsPolicy{
+		RegistrationEnforcement: &policiesClient.RegistrationEnforcement{
+			AuthenticationMethodsRegistrationCampaign: &policiesClient.AuthenticationMethodsRegistrationCampaign{
+				State:                pointer.To(policiesClient.AdvancedConfigStateDefault),
+				SnoozeDurationInDays: pointer.To(1),
+				ExcludeTargets:       &[]policiesClient.ExcludeTarget{},
+				IncludeTargets: &[]policiesClient.AuthenticationMethodsRegistrationCampaignIncludeTarget{
+					{
+						ID:                           pointer.To(authenticationMethodsPolicyAllUsers),
+						TargetType:                   pointer.To(policiesClient.AuthenticationMethodTargetTypeGroup),
+						TargetedAuthenticationMethod: pointer.To("microsoftAuthenticator"),
+					},
+				},
+			},
+		},
+		ReportSuspiciousActivitySettings: &policiesClient.ReportSuspiciousActivitySettings{
+			State: pointer.To(policiesClient.AdvancedConfigStateDefault),
+			IncludeTarget: &policiesClient.IncludeTarget{
+				ID:         pointer.To(authenticationMethodsPolicyAllUsers),
+				TargetType: pointer.To(policiesClient.AuthenticationMethodTargetTypeGroup),
+			},
+			VoiceReportingCode: pointer.To(0),
+		},
+	}
+
+	if _, err := client.Update(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Could not reset authentication methods policy")
+	}
+
+	return nil
+}
+
+func authenticationMethodExcludeTargetSchema(description string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description: description,
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"id": {
+					Description:      "The object ID of the user or group",
+					Type:             pluginsdk.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validation.ValidateDiag(validation.IsUUID),
+				},
+
+				"target_type": {
+					Description:      "The type of the target",
+					Type:             pluginsdk.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice(possibleValuesForAuthenticationMethodTargetType(), false)),
+				},
+			},
+		},
+	}
+}
+
+func possibleValuesForAdvancedConfigState() []string {
+	return []string{
+		policiesClient.AdvancedConfigStateDefault,
+		policiesClient.AdvancedConfigStateDisabled,
+		policiesClient.AdvancedConfigStateEnabled,
+	}
+}
+
+func possibleValuesForAuthenticationMethodTargetType() []string {
+	return []string{
+		policiesClient.AuthenticationMethodTargetTypeGroup,
+		policiesClient.AuthenticationMethodTargetTypeUser,
+	}
+}
+
+func expandAuthenticationMethodsRegistrationCampaign(in []interface{}) *policiesClient.AuthenticationMethodsRegistrationCampaign {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+	config := in[0].(map[string]interface{})
+
+	includeTargets := make([]policiesClient.AuthenticationMethodsRegistrationCampaignIncludeTarget, 0)
+	for _, raw := range config["include_target"].([]interface{}) {
+		target := raw.(map[string]interface{})
+		includeTargets = append(includeTargets, policiesClient.AuthenticationMethodsRegistrationCampaignIncludeTarget{
+			ID:                           pointer.To(target["id"].(string)),
+			TargetType:                   pointer.To(target["target_type"].(string)),
+			TargetedAuthenticationMethod: pointer.To(target["targeted_authentication_method"].(string)),
+		})
+	}
+
+	result := policiesClient.AuthenticationMethodsRegistrationCampaign{
+		ExcludeTargets:       expandAuthenticationMethodExcludeTargets(config["exclude_target"].([]interface{})),
+		SnoozeDurationInDays: pointer.To(config["snooze_duration_in_days"].(int)),
+		State:                pointer.To(config["state"].(string)),
+	}
+
+	if len(includeTargets) > 0 {
+		result.IncludeTargets = &includeTargets
+	}
+
+	if v, ok := config["enforce_registration_after_allowed_snoozes"].(bool); ok {
+		result.EnforceRegistrationAfterAllowedSnoozes = pointer.To(v)
+	}
+
+	return &result
+}
+
+func expandReportSuspiciousActivitySettings(in []interface{}) *policiesClient.ReportSuspiciousActivitySettings {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+	config := in[0].(map[string]interface{})
+
+	result := policiesClient.ReportSuspiciousActivitySettings{
+		State:              pointer.To(config["state"].(string)),
+		VoiceReportingCode: pointer.To(config["voice_reporting_code"].(int)),
+	}
+
+	if v := config["include_target"].([]interface{}); len(v) > 0 && v[0] != nil {
+		target := v[0].(map[string]interface{})
+		result.IncludeTarget = &policiesClient.IncludeTarget{
+			ID:         pointer.To(target["id"].(string)),
+			TargetType: pointer.To(target["target_type"].(string)),
+		}
+	}
+
+	return &result
+}
+
+func expandAuthenticationMethodExcludeTargets(in []interface{}) *[]policiesClient.ExcludeTarget {
+	result := make([]policiesClient.ExcludeTarget, 0)
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		target := raw.(map[string]interface{})
+		result = append(result, policiesClient.ExcludeTarget{
+			ID:         pointer.To(target["id"].(string)),
+			TargetType: pointer.To(target["target_type"].(string)),
+		})
+	}
+
+	return &result
+}
+
+func flattenAuthenticationMethodsRegistrationCampaign(in *policiesClient.AuthenticationMethodsRegistrationCampaign) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	includeTargets := make([]interface{}, 0)
+	if in.IncludeTargets != nil {
+		for _, target := range *in.IncludeTargets {
+			includeTargets = append(includeTargets, map[string]interface{}{
+				"id":                             pointer.From(target.ID),
+				"target_type":                    pointer.From(target.TargetType),
+				"targeted_authentication_method": pointer.From(target.TargetedAuthenticationMethod),
+			})
+		}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"enforce_registration_after_allowed_snoozes": pointer.From(in.EnforceRegistrationAfterAllowedSnoozes),
+		"exclude_target":          flattenAuthenticationMethodExcludeTargets(in.ExcludeTargets),
+		"include_target":          includeTargets,
+		"snooze_duration_in_days": pointer.From(in.SnoozeDurationInDays),
+		"state":                   pointer.From(in.State),
+	}}
+}
+
+func flattenReportSuspiciousActivitySettings(in *policiesClient.ReportSuspiciousActivitySettings) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	includeTarget := make([]interface{}, 0)
+	if in.IncludeTarget != nil {
+		includeTarget = append(includeTarget, map[string]interface{}{
+			"id":          pointer.From(in.IncludeTarget.ID),
+			"target_type": pointer.From(in.IncludeTarget.TargetType),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"include_target":       includeTarget,
+		"state":                pointer.From(in.State),
+		"voice_reporting_code": pointer.From(in.VoiceReportingCode),
+	}}
+}
+
+func flattenAuthenticationMethodExcludeTargets(in *[]policiesClient.ExcludeTarget) []interface{} {
+	result := make([]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	for _, target := range *in {
+		result = append(result, map[string]interface{}{
+			"id":          pointer.From(target.ID),
+			"target_type": pointer.From(target.TargetType),
+		})
+	}
+
+	return result
+}
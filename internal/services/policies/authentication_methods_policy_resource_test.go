@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type AuthenticationMethodsPolicyResource struct{}
+
+func TestAccAuthenticationMethodsPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_methods_policy", "test")
+	r := AuthenticationMethodsPolicyResource{}
+
+	// The authentication methods policy always exists, its settings are reset on destroy
+	data.ResourceTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("registration_campaign.0.state").HasValue("default"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("registration_campaign.0.state").HasValue("enabled"),
+				check.That(data.ResourceName).Key("report_suspicious_activity.0.state").HasValue("enabled"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AuthenticationMethodsPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.AuthenticationMethodsPolicyClient
+	client.BaseClient.DisableRetries = true
+	defer func() { client.BaseClient.DisableRetries = false }()
+
+	if _, _, err := client.Get(ctx, odata.Query{}); err != nil {
+		return nil, fmt.Errorf("failed to retrieve authentication methods policy: %+v", err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (AuthenticationMethodsPolicyResource) basic(data acceptance.TestData) string {
+	return `
+provider "azuread" {}
+
+resource "azuread_authentication_methods_policy" "test" {
+  registration_campaign {
+    state = "default"
+
+    include_target {
+      id          = "all_users"
+      target_type = "group"
+    }
+  }
+}
+`
+}
+
+func (AuthenticationMethodsPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  security_enabled = true
+}
+
+resource "azuread_authentication_methods_policy" "test" {
+  reconfirmation_in_days = 180
+
+  registration_campaign {
+    state                   = "enabled"
+    snooze_duration_in_days = 3
+
+    include_target {
+      id          = "all_users"
+      target_type = "group"
+    }
+
+    exclude_target {
+      id          = azuread_group.test.object_id
+      target_type = "group"
+    }
+  }
+
+  report_suspicious_activity {
+    state                = "enabled"
+    voice_reporting_code = 8
+
+    include_target {
+      id          = "all_users"
+      target_type = "group"
+    }
+  }
+}
+`, data.RandomInteger)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+func authenticationStrengthPolicyDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: authenticationStrengthPolicyDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"policy_id": {
+				Description:      "The ID of the authentication strength policy",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"display_name", "policy_id"},
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotEmpty),
+			},
+
+			"display_name": {
+				Description:      "The display name of the authentication strength policy",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"display_name", "policy_id"},
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotEmpty),
+			},
+
+			"allowed_combinations": {
+				Description: "The authentication method combinations allowed by the authentication strength policy",
+				Type:        pluginsdk.TypeSet,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"description": {
+				Description: "The description of the authentication strength policy",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"policy_type": {
+				Description: "Whether the authentication strength policy is built-in or custom",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func authenticationStrengthPolicyDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthenticationStrengthPoliciesClient
+
+	var policy *msgraph.AuthenticationStrengthPolicy
+
+	if policyId, ok := d.GetOk("policy_id"); ok {
+		result, status, err := client.Get(ctx, policyId.(string), odata.Query{})
+		if err != nil {
+			if status == http.StatusNotFound {
+				return tf.ErrorDiagPathF(nil, "policy_id", "No authentication strength policy found with ID: %q", policyId)
+			}
+			return tf.ErrorDiagF(err, "Retrieving authentication strength policy with ID: %q", policyId)
+		}
+		policy = result
+	} else {
+		displayName := d.Get("display_name").(string)
+
+		// The API does not support filtering by display name, so all policies, both built-in and custom, are listed
+		policies, _, err := client.List(ctx, odata.Query{})
+		if err != nil {
+			return tf.ErrorDiagF(err, "Listing authentication strength policies")
+		}
+		if policies == nil {
+			return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API response")
+		}
+
+		for _, p := range *policies {
+			if strings.EqualFold(pointer.From(p.DisplayName), displayName) {
+				if policy != nil {
+					return tf.ErrorDiagPathF(nil, "display_name", "More than one authentication strength policy found with display name: %q", displayName)
+				}
+				policy = pointer.To(p)
+			}
+		}
+
+		if policy == nil {
+			return tf.ErrorDiagPathF(nil, "display_name", "No authentication strength policy found with display name: %q", displayName)
+		}
+	}
+
+	if policy == nil || policy.ID == nil {
+		return tf.ErrorDiagF(fmt.Errorf("API returned authentication strength policy with nil ID"), "Bad API response")
+	}
+
+	d.SetId(*policy.ID)
+
+	tf.Set(d, "allowed_combinations", tf.FlattenStringSlicePtr(policy.AllowedCombinations))
+	tf.Set(d, "description", policy.Description)
+	tf.Set(d, "display_name", policy.DisplayName)
+	tf.Set(d, "policy_id", policy.ID)
+	tf.Set(d, "policy_type", policy.PolicyType)
+
+	return nil
+}
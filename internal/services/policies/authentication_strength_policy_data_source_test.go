@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type AuthenticationStrengthPolicyDataSource struct{}
+
+func TestAccAuthenticationStrengthPolicyDataSource_builtInByDisplayName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_authentication_strength_policy", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: AuthenticationStrengthPolicyDataSource{}.builtInByDisplayName(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("policy_id").HasValue("00000000-0000-0000-0000-000000000004"),
+				check.That(data.ResourceName).Key("policy_type").HasValue("builtIn"),
+				check.That(data.ResourceName).Key("allowed_combinations.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccAuthenticationStrengthPolicyDataSource_builtInById(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_authentication_strength_policy", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: AuthenticationStrengthPolicyDataSource{}.builtInById(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("display_name").HasValue("Multifactor authentication"),
+				check.That(data.ResourceName).Key("policy_type").HasValue("builtIn"),
+			),
+		},
+	})
+}
+
+func TestAccAuthenticationStrengthPolicyDataSource_custom(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_authentication_strength_policy", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: AuthenticationStrengthPolicyDataSource{}.custom(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("policy_id").Exists(),
+				check.That(data.ResourceName).Key("description").HasValue("test"),
+				check.That(data.ResourceName).Key("policy_type").HasValue("custom"),
+				check.That(data.ResourceName).Key("allowed_combinations.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (AuthenticationStrengthPolicyDataSource) builtInByDisplayName() string {
+	return `
+provider "azuread" {}
+
+data "azuread_authentication_strength_policy" "test" {
+  display_name = "Phishing-resistant MFA"
+}
+`
+}
+
+func (AuthenticationStrengthPolicyDataSource) builtInById() string {
+	return `
+provider "azuread" {}
+
+data "azuread_authentication_strength_policy" "test" {
+  policy_id = "00000000-0000-0000-0000-000000000002"
+}
+`
+}
+
+func (AuthenticationStrengthPolicyDataSource) custom(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_authentication_strength_policy" "test" {
+  display_name = azuread_authentication_strength_policy.test.display_name
+}
+`, AuthenticationStrengthPolicyResource{}.basic(data))
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
+	policiesClient "github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
@@ -72,11 +73,17 @@ func authenticationStrengthPolicyResource() *pluginsdk.Resource {
 
 func authenticationStrengthPolicyCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Policies.AuthenticationStrengthPoliciesClient
+	authenticationMethodsPolicyClient := meta.(*clients.Client).Policies.AuthenticationMethodsPolicyClient
+
+	allowedCombinations := tf.ExpandStringSlice(d.Get("allowed_combinations").(*pluginsdk.Set).List())
+	if diags := authenticationStrengthPolicyValidateAllowedCombinations(ctx, authenticationMethodsPolicyClient, allowedCombinations); diags != nil {
+		return diags
+	}
 
 	properties := msgraph.AuthenticationStrengthPolicy{
 		DisplayName:         pointer.To(d.Get("display_name").(string)),
 		Description:         pointer.To(d.Get("description").(string)),
-		AllowedCombinations: tf.ExpandStringSlicePtr(d.Get("allowed_combinations").(*pluginsdk.Set).List()),
+		AllowedCombinations: &allowedCombinations,
 	}
 
 	authenticationStrengthPolicy, _, err := client.Create(ctx, properties)
@@ -91,6 +98,14 @@ func authenticationStrengthPolicyCreate(ctx context.Context, d *pluginsdk.Resour
 
 func authenticationStrengthPolicyUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Policies.AuthenticationStrengthPoliciesClient
+	authenticationMethodsPolicyClient := meta.(*clients.Client).Policies.AuthenticationMethodsPolicyClient
+
+	allowedCombinations := tf.ExpandStringSlice(d.Get("allowed_combinations").(*pluginsdk.Set).List())
+	if d.HasChange("allowed_combinations") {
+		if diags := authenticationStrengthPolicyValidateAllowedCombinations(ctx, authenticationMethodsPolicyClient, allowedCombinations); diags != nil {
+			return diags
+		}
+	}
 
 	properties := msgraph.AuthenticationStrengthPolicy{
 		ID:          pointer.To(d.Id()),
@@ -104,7 +119,7 @@ func authenticationStrengthPolicyUpdate(ctx context.Context, d *pluginsdk.Resour
 	}
 
 	if d.HasChange("allowed_combinations") {
-		properties.AllowedCombinations = tf.ExpandStringSlicePtr(d.Get("allowed_combinations").(*pluginsdk.Set).List())
+		properties.AllowedCombinations = &allowedCombinations
 		_, err := client.UpdateAllowedCombinations(ctx, properties)
 		if err != nil {
 			return tf.ErrorDiagF(err, "Could not update authentication strength policy allowed combinations")
@@ -171,3 +186,19 @@ func authenticationStrengthPolicyDelete(ctx context.Context, d *pluginsdk.Resour
 
 	return nil
 }
+
+// authenticationStrengthPolicyValidateAllowedCombinations checks that each of the allowed combinations only requires
+// authentication methods which are enabled in the authentication methods policy. This is performed at apply time, so
+// that any methods being enabled in the same configuration are taken into account.
+func authenticationStrengthPolicyValidateAllowedCombinations(ctx context.Context, client *policiesClient.AuthenticationMethodsPolicyClient, allowedCombinations []string) diag.Diagnostics {
+	enabled, err := authenticationMethodStates(ctx, client)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not retrieve authentication methods policy to validate allowed combinations")
+	}
+
+	if err = validateAuthenticationStrengthAllowedCombinations(enabled, allowedCombinations); err != nil {
+		return tf.ErrorDiagPathF(err, "allowed_combinations", "Invalid allowed combinations for authentication strength policy")
+	}
+
+	return nil
+}
@@ -0,0 +1,525 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	policiesClient "github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+const authorizationPolicyId = "authorizationPolicy"
+
+// authorizationPolicyProperties maps top-level schema fields to their corresponding API properties
+var authorizationPolicyProperties = map[string]string{
+	"allow_email_verified_users_to_join_organization": "allowEmailVerifiedUsersToJoinOrganization",
+	"allow_invites_from":                              "allowInvitesFrom",
+	"allow_user_consent_for_risky_apps":               "allowUserConsentForRiskyApps",
+	"allowed_to_sign_up_email_based_subscriptions":    "allowedToSignUpEmailBasedSubscriptions",
+	"allowed_to_use_sspr":                             "allowedToUseSSPR",
+	"block_msol_powershell":                           "blockMsolPowerShell",
+	"guest_user_role_id":                              "guestUserRoleId",
+}
+
+// authorizationPolicyDefaultUserRolePermissions maps fields in the `default_user_role_permissions` block to their
+// corresponding API properties
+var authorizationPolicyDefaultUserRolePermissions = map[string]string{
+	"allowed_to_create_apps":                          "allowedToCreateApps",
+	"allowed_to_create_security_groups":               "allowedToCreateSecurityGroups",
+	"allowed_to_create_tenants":                       "allowedToCreateTenants",
+	"allowed_to_read_bitlocker_keys_for_owned_device": "allowedToReadBitlockerKeysForOwnedDevice",
+	"allowed_to_read_other_users":                     "allowedToReadOtherUsers",
+	"permission_grant_policies_assigned":              "permissionGrantPoliciesAssigned",
+}
+
+func authorizationPolicyResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: authorizationPolicyResourceCreate,
+		ReadContext:   authorizationPolicyResourceRead,
+		UpdateContext: authorizationPolicyResourceUpdate,
+		DeleteContext: authorizationPolicyResourceDelete,
+
+		CustomizeDiff: authorizationPolicyResourceCustomizeDiff,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if id != authorizationPolicyId {
+				return fmt.Errorf("specified ID (%q) is not valid, expected %q", id, authorizationPolicyId)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"allow_email_verified_users_to_join_organization": {
+				Description: "Whether users can join the tenant by email validation",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"allow_invites_from": {
+				Description: "Who can invite external users to the organization",
+				Type:        pluginsdk.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice([]string{
+					policiesClient.AuthorizationPolicyAllowInvitesFromAdminsAndGuestInviters,
+					policiesClient.AuthorizationPolicyAllowInvitesFromAdminsGuestInvitersAndAllMembers,
+					policiesClient.AuthorizationPolicyAllowInvitesFromEveryone,
+					policiesClient.AuthorizationPolicyAllowInvitesFromNone,
+				}, false)),
+			},
+
+			"allow_user_consent_for_risky_apps": {
+				Description: "Whether users can consent to risky applications",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"allowed_to_sign_up_email_based_subscriptions": {
+				Description: "Whether users can sign up for email based subscriptions",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"allowed_to_use_sspr": {
+				Description: "Whether users can use the self-service password reset feature",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"block_msol_powershell": {
+				Description: "Whether to block the legacy MSOnline PowerShell module for non-administrative users",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"default_user_role_permissions": {
+				Description: "Permissions granted to the default user role",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"allowed_to_create_apps": {
+							Description: "Whether users can register applications",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+
+						"allowed_to_create_security_groups": {
+							Description: "Whether users can create security groups",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+
+						"allowed_to_create_tenants": {
+							Description: "Whether users can create tenants",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+
+						"allowed_to_read_bitlocker_keys_for_owned_device": {
+							Description: "Whether users can read the BitLocker recovery keys for devices they own",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+
+						"allowed_to_read_other_users": {
+							Description: "Whether users can read other users",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+
+						"permission_grant_policies_assigned": {
+							Description: "The IDs of the permission grant policies which determine the apps that users can consent to",
+							Type:        pluginsdk.TypeSet,
+							Optional:    true,
+							Computed:    true,
+							Elem: &pluginsdk.Schema{
+								Type:             pluginsdk.TypeString,
+								ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotEmpty),
+							},
+						},
+					},
+				},
+			},
+
+			"guest_user_role_id": {
+				Description: "The ID of the role which determines the permissions of guest users",
+				Type:        pluginsdk.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice([]string{
+					policiesClient.AuthorizationPolicyGuestUserRoleIdGuestUser,
+					policiesClient.AuthorizationPolicyGuestUserRoleIdRestrictedGuestUser,
+					policiesClient.AuthorizationPolicyGuestUserRoleIdUser,
+				}, false)),
+			},
+
+			"baseline": {
+				Description: "The original values of the managed settings, encoded as JSON, which are restored when this resource is destroyed",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"description": {
+				Description: "The description of the authorization policy",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"display_name": {
+				Description: "The display name of the authorization policy",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func authorizationPolicyResourceCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	baseline := make(map[string]interface{})
+	if v := diff.Get("baseline").(string); v != "" {
+		if err := json.Unmarshal([]byte(v), &baseline); err != nil {
+			return fmt.Errorf("decoding recorded baseline for authorization policy: %+v", err)
+		}
+	}
+	baselinePermissions, _ := baseline["defaultUserRolePermissions"].(map[string]interface{})
+
+	// The baseline changes when settings are added to or removed from the configuration, in which case any removed
+	// settings must also be restored, so we ensure an update is planned
+	rawConfig := diff.GetRawConfig()
+	changed := false
+
+	for key, property := range authorizationPolicyProperties {
+		_, recorded := baseline[property]
+		if authorizationPolicyIsConfigured(rawConfig, key) != recorded {
+			changed = true
+		}
+	}
+
+	for key, property := range authorizationPolicyDefaultUserRolePermissions {
+		_, recorded := baselinePermissions[property]
+		if authorizationPolicyIsConfigured(rawConfig, "default_user_role_permissions", key) != recorded {
+			changed = true
+		}
+	}
+
+	if changed {
+		if err := diff.SetNewComputed("baseline"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func authorizationPolicyResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := authorizationPolicyResourceApply(ctx, d, meta, map[string]interface{}{}, false); diags != nil {
+		return diags
+	}
+
+	d.SetId(authorizationPolicyId)
+
+	return authorizationPolicyResourceRead(ctx, d, meta)
+}
+
+func authorizationPolicyResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	baseline := make(map[string]interface{})
+	if v := d.Get("baseline").(string); v != "" {
+		if err := json.Unmarshal([]byte(v), &baseline); err != nil {
+			return tf.ErrorDiagPathF(err, "baseline", "Could not decode recorded baseline for authorization policy")
+		}
+	}
+
+	if diags := authorizationPolicyResourceApply(ctx, d, meta, baseline, true); diags != nil {
+		return diags
+	}
+
+	return authorizationPolicyResourceRead(ctx, d, meta)
+}
+
+func authorizationPolicyResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthorizationPolicyClient
+
+	policy, _, err := client.Get(ctx, odata.Query{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not retrieve authorization policy")
+	}
+	if policy == nil {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Result is nil")
+	}
+
+	tf.Set(d, "allow_email_verified_users_to_join_organization", policy.AllowEmailVerifiedUsersToJoinOrganization)
+	tf.Set(d, "allow_invites_from", policy.AllowInvitesFrom)
+	tf.Set(d, "allow_user_consent_for_risky_apps", policy.AllowUserConsentForRiskyApps)
+	tf.Set(d, "allowed_to_sign_up_email_based_subscriptions", policy.AllowedToSignUpEmailBasedSubscriptions)
+	tf.Set(d, "allowed_to_use_sspr", policy.AllowedToUseSSPR)
+	tf.Set(d, "block_msol_powershell", policy.BlockMsolPowerShell)
+	tf.Set(d, "default_user_role_permissions", flattenAuthorizationPolicyDefaultUserRolePermissions(policy.DefaultUserRolePermissions))
+	tf.Set(d, "description", policy.Description)
+	tf.Set(d, "display_name", policy.DisplayName)
+	tf.Set(d, "guest_user_role_id", policy.GuestUserRoleId)
+
+	return nil
+}
+
+func authorizationPolicyResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthorizationPolicyClient
+
+	baseline := make(map[string]interface{})
+	if v := d.Get("baseline").(string); v != "" {
+		if err := json.Unmarshal([]byte(v), &baseline); err != nil {
+			return tf.ErrorDiagPathF(err, "baseline", "Could not decode recorded baseline for authorization policy")
+		}
+	}
+
+	// The authorization policy cannot be deleted, so we restore the recorded baseline. When no baseline was recorded,
+	// for example after importing, we restore the Microsoft defaults instead.
+	var properties policiesClient.AuthorizationPolicy
+	if len(baseline) == 0 {
+		properties = authorizationPolicyDefaults()
+	} else {
+		existing, _, err := client.Get(ctx, odata.Query{})
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not retrieve authorization policy")
+		}
+		if existing == nil {
+			return tf.ErrorDiagF(errors.New("Bad API response"), "Result is nil")
+		}
+
+		patch := baseline
+		if v, ok := baseline["defaultUserRolePermissions"].(map[string]interface{}); ok {
+			patch["defaultUserRolePermissions"] = mergeAuthorizationPolicyDefaultUserRolePermissions(existing.DefaultUserRolePermissions, v)
+		}
+
+		if err := decodeAuthorizationPolicyPatch(patch, &properties); err != nil {
+			return tf.ErrorDiagF(err, "Could not build request to restore authorization policy")
+		}
+	}
+
+	if _, err := client.Update(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Could not restore authorization policy")
+	}
+
+	return nil
+}
+
+// authorizationPolicyResourceApply patches the settings which are present in the configuration. For each setting
+// being managed for the first time, its existing value is recorded in the baseline so that it can later be restored.
+// Settings which have been removed from the configuration are restored to their baseline value.
+func authorizationPolicyResourceApply(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, baseline map[string]interface{}, isUpdate bool) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthorizationPolicyClient
+
+	existingPolicy, _, err := client.Get(ctx, odata.Query{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not retrieve authorization policy")
+	}
+	if existingPolicy == nil {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Result is nil")
+	}
+
+	existing, err := encodeAuthorizationPolicy(*existingPolicy)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not encode existing authorization policy")
+	}
+
+	rawConfig := d.GetRawConfig()
+	patch := make(map[string]interface{})
+
+	for key, property := range authorizationPolicyProperties {
+		if authorizationPolicyIsConfigured(rawConfig, key) {
+			if _, ok := baseline[property]; !ok {
+				baseline[property] = existing[property]
+			}
+			if !isUpdate || d.HasChange(key) {
+				patch[property] = d.Get(key)
+			}
+		} else if v, ok := baseline[property]; ok {
+			patch[property] = v
+			delete(baseline, property)
+		}
+	}
+
+	existingPermissions, _ := existing["defaultUserRolePermissions"].(map[string]interface{})
+	baselinePermissions, _ := baseline["defaultUserRolePermissions"].(map[string]interface{})
+	if baselinePermissions == nil {
+		baselinePermissions = make(map[string]interface{})
+	}
+	patchPermissions := make(map[string]interface{})
+
+	for key, property := range authorizationPolicyDefaultUserRolePermissions {
+		fullKey := fmt.Sprintf("default_user_role_permissions.0.%s", key)
+		if authorizationPolicyIsConfigured(rawConfig, "default_user_role_permissions", key) {
+			if _, ok := baselinePermissions[property]; !ok {
+				baselinePermissions[property] = existingPermissions[property]
+			}
+			if !isUpdate || d.HasChange(fullKey) {
+				if v, ok := d.Get(fullKey).(*pluginsdk.Set); ok {
+					patchPermissions[property] = tf.ExpandStringSlice(v.List())
+				} else {
+					patchPermissions[property] = d.Get(fullKey)
+				}
+			}
+		} else if v, ok := baselinePermissions[property]; ok {
+			patchPermissions[property] = v
+			delete(baselinePermissions, property)
+		}
+	}
+
+	if len(baselinePermissions) > 0 {
+		baseline["defaultUserRolePermissions"] = baselinePermissions
+	} else {
+		delete(baseline, "defaultUserRolePermissions")
+	}
+
+	if len(patchPermissions) > 0 {
+		// The API replaces the entire object, so any permissions not being changed are sent with their existing values
+		patch["defaultUserRolePermissions"] = mergeAuthorizationPolicyDefaultUserRolePermissions(existingPolicy.DefaultUserRolePermissions, patchPermissions)
+	}
+
+	if len(patch) > 0 {
+		var properties policiesClient.AuthorizationPolicy
+		if err := decodeAuthorizationPolicyPatch(patch, &properties); err != nil {
+			return tf.ErrorDiagF(err, "Could not build request to update authorization policy")
+		}
+
+		if _, err := client.Update(ctx, properties); err != nil {
+			return tf.ErrorDiagF(err, "Could not update authorization policy")
+		}
+	}
+
+	baselineJson, err := json.Marshal(baseline)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not encode baseline for authorization policy")
+	}
+	tf.Set(d, "baseline", string(baselineJson))
+
+	return nil
+}
+
+// authorizationPolicyIsConfigured returns whether the field at the specified path is present in the configuration.
+// Paths with two elements refer to a field within the `default_user_role_permissions` block.
+func authorizationPolicyIsConfigured(rawConfig cty.Value, path ...string) bool {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+
+	v := rawConfig.GetAttr(path[0])
+	if len(path) == 1 {
+		return !v.IsNull()
+	}
+
+	if v.IsNull() || !v.IsKnown() || v.LengthInt() == 0 {
+		return false
+	}
+
+	return !v.Index(cty.NumberIntVal(0)).GetAttr(path[1]).IsNull()
+}
+
+func authorizationPolicyDefaults() policiesClient.AuthorizationPolicy {
+	return policiesClient.AuthorizationPolicy{
+		AllowEmailVerifiedUsersToJoinOrganization: pointer.To(true),
+		AllowInvitesFrom:                       pointer.To(policiesClient.AuthorizationPolicyAllowInvitesFromEveryone),
+		AllowUserConsentForRiskyApps:           pointer.To(false),
+		AllowedToSignUpEmailBasedSubscriptions: pointer.To(true),
+		AllowedToUseSSPR:                       pointer.To(true),
+		BlockMsolPowerShell:                    pointer.To(false),
+		DefaultUserRolePermissions: &policiesClient.DefaultUserRolePermissions{
+			AllowedToCreateApps:                      pointer.To(true),
+			AllowedToCreateSecurityGroups:            pointer.To(true),
+			AllowedToCreateTenants:                   pointer.To(true),
+			AllowedToReadBitlockerKeysForOwnedDevice: pointer.To(true),
+			AllowedToReadOtherUsers:                  pointer.To(true),
+			PermissionGrantPoliciesAssigned:          &[]string{"ManagePermissionGrantsForSelf.microsoft-user-default-legacy"},
+		},
+		GuestUserRoleId: pointer.To(policiesClient.AuthorizationPolicyGuestUserRoleIdGuestUser),
+	}
+}
+
+func encodeAuthorizationPolicy(in policiesClient.AuthorizationPolicy) (map[string]interface{}, error) {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]interface{})
+	if err = json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func decodeAuthorizationPolicyPatch(in map[string]interface{}, out *policiesClient.AuthorizationPolicy) error {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, out)
+}
+
+func mergeAuthorizationPolicyDefaultUserRolePermissions(existing *policiesClient.DefaultUserRolePermissions, changes map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{})
+	if existing != nil {
+		if b, err := json.Marshal(existing); err == nil {
+			_ = json.Unmarshal(b, &out)
+		}
+	}
+
+	for k, v := range changes {
+		out[k] = v
+	}
+
+	return out
+}
+
+func flattenAuthorizationPolicyDefaultUserRolePermissions(in *policiesClient.DefaultUserRolePermissions) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"allowed_to_create_apps":                          pointer.From(in.AllowedToCreateApps),
+		"allowed_to_create_security_groups":               pointer.From(in.AllowedToCreateSecurityGroups),
+		"allowed_to_create_tenants":                       pointer.From(in.AllowedToCreateTenants),
+		"allowed_to_read_bitlocker_keys_for_owned_device": pointer.From(in.AllowedToReadBitlockerKeysForOwnedDevice),
+		"allowed_to_read_other_users":                     pointer.From(in.AllowedToReadOtherUsers),
+		"permission_grant_policies_assigned":              tf.FlattenStringSlicePtr(in.PermissionGrantPoliciesAssigned),
+	}}
+}
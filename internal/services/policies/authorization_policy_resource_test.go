@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type AuthorizationPolicyResource struct{}
+
+func TestAccAuthorizationPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authorization_policy", "test")
+	r := AuthorizationPolicyResource{}
+
+	// The authorization policy always exists, it is restored to its baseline on destroy
+	data.ResourceTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("allow_invites_from").HasValue("adminsAndGuestInviters"),
+				check.That(data.ResourceName).Key("baseline").Exists(),
+			),
+		},
+		data.ImportStep("baseline"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_user_role_permissions.0.allowed_to_create_apps").HasValue("false"),
+				check.That(data.ResourceName).Key("guest_user_role_id").HasValue("2af84b1e-32c8-42b7-82bc-daa82404023b"),
+			),
+		},
+		data.ImportStep("baseline"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("baseline"),
+	})
+}
+
+func (r AuthorizationPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.AuthorizationPolicyClient
+	client.BaseClient.DisableRetries = true
+	defer func() { client.BaseClient.DisableRetries = false }()
+
+	if _, _, err := client.Get(ctx, odata.Query{}); err != nil {
+		return nil, fmt.Errorf("failed to retrieve authorization policy: %+v", err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (AuthorizationPolicyResource) basic(data acceptance.TestData) string {
+	return `
+provider "azuread" {}
+
+resource "azuread_authorization_policy" "test" {
+  allow_invites_from = "adminsAndGuestInviters"
+}
+`
+}
+
+func (AuthorizationPolicyResource) complete(data acceptance.TestData) string {
+	return `
+provider "azuread" {}
+
+resource "azuread_authorization_policy" "test" {
+  allow_email_verified_users_to_join_organization = false
+  allow_invites_from                              = "adminsAndGuestInviters"
+  allowed_to_use_sspr                             = true
+  guest_user_role_id                              = "2af84b1e-32c8-42b7-82bc-daa82404023b"
+
+  default_user_role_permissions {
+    allowed_to_create_apps            = false
+    allowed_to_create_security_groups = false
+    allowed_to_read_other_users       = true
+  }
+}
+`
+}
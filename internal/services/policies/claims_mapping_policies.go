@@ -0,0 +1,522 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+// claimsMappingPolicySamlNameIdClaimType is the SAML claim type of the claim schema entry which sets the NameID of
+// SAML tokens
+const claimsMappingPolicySamlNameIdClaimType = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/nameidentifier"
+
+const (
+	claimsMappingPolicyTransformationMethodExtractMailPrefix = "ExtractMailPrefix"
+	claimsMappingPolicyTransformationMethodJoin              = "Join"
+	claimsMappingPolicyTransformationMethodRegexReplace      = "RegexReplace"
+	claimsMappingPolicyTransformationMethodToLowercase       = "ToLowercase"
+	claimsMappingPolicyTransformationMethodToUppercase       = "ToUppercase"
+)
+
+// claimsMappingPolicySourceTransformation is the source of claim schema entries whose value is produced by a claims
+// transformation
+const claimsMappingPolicySourceTransformation = "transformation"
+
+// claimsMappingPolicyTypedAttributes are the attributes which describe the policy definition as structured data, as an
+// alternative to the `definition` attribute
+var claimsMappingPolicyTypedAttributes = []string{"claim_schema", "claims_transformation", "include_basic_claim_set", "saml_name_id"}
+
+// stsPolicyQuotedBool is a boolean in a policy definition which is encoded as a string, as in the examples given for
+// claims mapping policies. Both forms are accepted when decoding.
+type stsPolicyQuotedBool bool
+
+func (b stsPolicyQuotedBool) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fmt.Sprintf("%t", bool(b)))
+}
+
+func (b *stsPolicyQuotedBool) UnmarshalJSON(data []byte) error {
+	var value stsPolicyBool
+	if err := value.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*b = stsPolicyQuotedBool(value)
+	return nil
+}
+
+type claimsMappingPolicyDefinition struct {
+	ClaimsMappingPolicy claimsMappingPolicyProperties `json:"ClaimsMappingPolicy"`
+}
+
+type claimsMappingPolicyProperties struct {
+	Version               int                                       `json:"Version"`
+	IncludeBasicClaimSet  stsPolicyQuotedBool                       `json:"IncludeBasicClaimSet"`
+	ClaimsSchema          []claimsMappingPolicyClaimSchema          `json:"ClaimsSchema,omitempty"`
+	ClaimsTransformations []claimsMappingPolicyClaimsTransformation `json:"ClaimsTransformations,omitempty"`
+}
+
+type claimsMappingPolicyClaimSchema struct {
+	Source           string `json:"Source,omitempty"`
+	ID               string `json:"ID,omitempty"`
+	Value            string `json:"Value,omitempty"`
+	TransformationId string `json:"TransformationId,omitempty"`
+	JwtClaimType     string `json:"JwtClaimType,omitempty"`
+	SamlClaimType    string `json:"SamlClaimType,omitempty"`
+	SamlNameFormat   string `json:"SamlNameFormat,omitempty"`
+}
+
+type claimsMappingPolicyClaimsTransformation struct {
+	ID                   string                                   `json:"ID"`
+	TransformationMethod string                                   `json:"TransformationMethod"`
+	InputClaims          []claimsMappingPolicyTransformationClaim `json:"InputClaims,omitempty"`
+	InputParameters      []claimsMappingPolicyInputParameter      `json:"InputParameters,omitempty"`
+	OutputClaims         []claimsMappingPolicyTransformationClaim `json:"OutputClaims,omitempty"`
+}
+
+type claimsMappingPolicyTransformationClaim struct {
+	ClaimTypeReferenceId    string `json:"ClaimTypeReferenceId"`
+	TransformationClaimType string `json:"TransformationClaimType"`
+}
+
+type claimsMappingPolicyInputParameter struct {
+	ID    string `json:"ID"`
+	Value string `json:"Value"`
+}
+
+func claimsMappingPolicyClaimSchemaSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description:   "The claims to emit in tokens, and the sources of their values",
+		Type:          pluginsdk.TypeList,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"definition"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"source": {
+					Description:      "The source of the claim value, e.g. `user`, `application`, `resource`, `audience`, `company` or `transformation`",
+					Type:             pluginsdk.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice([]string{"application", "audience", "company", "resource", "transformation", "user"}, false)),
+				},
+
+				"id": {
+					Description:      "The attribute of the source from which the claim value is taken",
+					Type:             pluginsdk.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotWhiteSpace),
+				},
+
+				"value": {
+					Description:      "A constant value for the claim, instead of a `source` and `id`",
+					Type:             pluginsdk.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotWhiteSpace),
+				},
+
+				"transformation_id": {
+					Description:      "The ID of the claims transformation which produces the claim value, when `source` is `transformation`",
+					Type:             pluginsdk.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotWhiteSpace),
+				},
+
+				"jwt_claim_type": {
+					Description:      "The name of the claim in JWT tokens",
+					Type:             pluginsdk.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotWhiteSpace),
+				},
+
+				"saml_claim_type": {
+					Description:      "The URI of the claim in SAML tokens",
+					Type:             pluginsdk.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotWhiteSpace),
+				},
+
+				"saml_name_format": {
+					Description:      "The value of the NameFormat attribute of the claim in SAML tokens",
+					Type:             pluginsdk.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotWhiteSpace),
+				},
+			},
+		},
+	}
+}
+
+func claimsMappingPolicyClaimsTransformationSchema() *pluginsdk.Schema {
+	transformationClaim := func(description string) *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Description: description,
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"claim_type_reference_id": {
+						Description:      "The `id` of the claim schema entry",
+						Type:             pluginsdk.TypeString,
+						Required:         true,
+						ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotWhiteSpace),
+					},
+
+					"transformation_claim_type": {
+						Description:      "The name of the claim within the transformation",
+						Type:             pluginsdk.TypeString,
+						Required:         true,
+						ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotWhiteSpace),
+					},
+				},
+			},
+		}
+	}
+
+	return &pluginsdk.Schema{
+		Description:   "Transformations which produce claim values from other claims",
+		Type:          pluginsdk.TypeList,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"definition"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"id": {
+					Description:      "The ID of the transformation, which is referenced by the `transformation_id` of claim schema entries",
+					Type:             pluginsdk.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotWhiteSpace),
+				},
+
+				"transformation_method": {
+					Description: "The transformation to perform",
+					Type:        pluginsdk.TypeString,
+					Required:    true,
+					ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice([]string{
+						claimsMappingPolicyTransformationMethodExtractMailPrefix,
+						claimsMappingPolicyTransformationMethodJoin,
+						claimsMappingPolicyTransformationMethodRegexReplace,
+						claimsMappingPolicyTransformationMethodToLowercase,
+						claimsMappingPolicyTransformationMethodToUppercase,
+					}, false)),
+				},
+
+				"input_claim": transformationClaim("The claims which are input to the transformation"),
+
+				"input_parameter": {
+					Description: "Constant values which are input to the transformation",
+					Type:        pluginsdk.TypeList,
+					Optional:    true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"id": {
+								Description:      "The name of the parameter, e.g. `separator`",
+								Type:             pluginsdk.TypeString,
+								Required:         true,
+								ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotWhiteSpace),
+							},
+
+							"value": {
+								Description: "The value of the parameter",
+								Type:        pluginsdk.TypeString,
+								Required:    true,
+							},
+						},
+					},
+				},
+
+				"output_claim": transformationClaim("The claims which are output by the transformation"),
+			},
+		},
+	}
+}
+
+func claimsMappingPolicySamlNameIdSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description:   "The source of the NameID claim in SAML tokens",
+		Type:          pluginsdk.TypeList,
+		Optional:      true,
+		Computed:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"definition"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"source": {
+					Description:      "The source of the NameID value, e.g. `user` or `transformation`",
+					Type:             pluginsdk.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice([]string{"application", "company", "resource", "transformation", "user"}, false)),
+				},
+
+				"id": {
+					Description:      "The attribute of the source from which the NameID value is taken",
+					Type:             pluginsdk.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotWhiteSpace),
+				},
+
+				"transformation_id": {
+					Description:      "The ID of the claims transformation which produces the NameID value, when `source` is `transformation`",
+					Type:             pluginsdk.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotWhiteSpace),
+				},
+			},
+		},
+	}
+}
+
+func expandClaimsMappingPolicyDefinition(includeBasicClaimSet bool, claimSchema, claimsTransformations, samlNameId []interface{}) claimsMappingPolicyDefinition {
+	result := claimsMappingPolicyDefinition{
+		ClaimsMappingPolicy: claimsMappingPolicyProperties{
+			Version:              1,
+			IncludeBasicClaimSet: stsPolicyQuotedBool(includeBasicClaimSet),
+		},
+	}
+
+	for _, raw := range claimSchema {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+
+		result.ClaimsMappingPolicy.ClaimsSchema = append(result.ClaimsMappingPolicy.ClaimsSchema, claimsMappingPolicyClaimSchema{
+			Source:           v["source"].(string),
+			ID:               v["id"].(string),
+			Value:            v["value"].(string),
+			TransformationId: v["transformation_id"].(string),
+			JwtClaimType:     v["jwt_claim_type"].(string),
+			SamlClaimType:    v["saml_claim_type"].(string),
+			SamlNameFormat:   v["saml_name_format"].(string),
+		})
+	}
+
+	if len(samlNameId) > 0 && samlNameId[0] != nil {
+		v := samlNameId[0].(map[string]interface{})
+
+		result.ClaimsMappingPolicy.ClaimsSchema = append(result.ClaimsMappingPolicy.ClaimsSchema, claimsMappingPolicyClaimSchema{
+			Source:           v["source"].(string),
+			ID:               v["id"].(string),
+			TransformationId: v["transformation_id"].(string),
+			SamlClaimType:    claimsMappingPolicySamlNameIdClaimType,
+		})
+	}
+
+	for _, raw := range claimsTransformations {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+
+		transformation := claimsMappingPolicyClaimsTransformation{
+			ID:                   v["id"].(string),
+			TransformationMethod: v["transformation_method"].(string),
+			InputClaims:          expandClaimsMappingPolicyTransformationClaims(v["input_claim"].([]interface{})),
+			OutputClaims:         expandClaimsMappingPolicyTransformationClaims(v["output_claim"].([]interface{})),
+		}
+
+		for _, rawParameter := range v["input_parameter"].([]interface{}) {
+			if rawParameter == nil {
+				continue
+			}
+			parameter := rawParameter.(map[string]interface{})
+			transformation.InputParameters = append(transformation.InputParameters, claimsMappingPolicyInputParameter{
+				ID:    parameter["id"].(string),
+				Value: parameter["value"].(string),
+			})
+		}
+
+		result.ClaimsMappingPolicy.ClaimsTransformations = append(result.ClaimsMappingPolicy.ClaimsTransformations, transformation)
+	}
+
+	return result
+}
+
+func expandClaimsMappingPolicyTransformationClaims(in []interface{}) []claimsMappingPolicyTransformationClaim {
+	result := make([]claimsMappingPolicyTransformationClaim, 0)
+
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+
+		result = append(result, claimsMappingPolicyTransformationClaim{
+			ClaimTypeReferenceId:    v["claim_type_reference_id"].(string),
+			TransformationClaimType: v["transformation_claim_type"].(string),
+		})
+	}
+
+	return result
+}
+
+// flattenClaimsMappingPolicyDefinition returns the values of the `include_basic_claim_set`, `claim_schema`,
+// `claims_transformation` and `saml_name_id` attributes. The claim schema entry which sets the SAML NameID is returned
+// in `saml_name_id` rather than in `claim_schema`.
+func flattenClaimsMappingPolicyDefinition(in claimsMappingPolicyDefinition) (bool, []interface{}, []interface{}, []interface{}) {
+	properties := in.ClaimsMappingPolicy
+
+	claimSchema := make([]interface{}, 0)
+	samlNameId := make([]interface{}, 0)
+
+	for _, entry := range properties.ClaimsSchema {
+		if len(samlNameId) == 0 && strings.EqualFold(entry.SamlClaimType, claimsMappingPolicySamlNameIdClaimType) && entry.JwtClaimType == "" && entry.Value == "" && entry.SamlNameFormat == "" {
+			samlNameId = append(samlNameId, map[string]interface{}{
+				"id":                entry.ID,
+				"source":            strings.ToLower(entry.Source),
+				"transformation_id": entry.TransformationId,
+			})
+			continue
+		}
+
+		claimSchema = append(claimSchema, map[string]interface{}{
+			"id":                entry.ID,
+			"jwt_claim_type":    entry.JwtClaimType,
+			"saml_claim_type":   entry.SamlClaimType,
+			"saml_name_format":  entry.SamlNameFormat,
+			"source":            strings.ToLower(entry.Source),
+			"transformation_id": entry.TransformationId,
+			"value":             entry.Value,
+		})
+	}
+
+	claimsTransformations := make([]interface{}, 0)
+	for _, transformation := range properties.ClaimsTransformations {
+		inputParameters := make([]interface{}, 0)
+		for _, parameter := range transformation.InputParameters {
+			inputParameters = append(inputParameters, map[string]interface{}{
+				"id":    parameter.ID,
+				"value": parameter.Value,
+			})
+		}
+
+		claimsTransformations = append(claimsTransformations, map[string]interface{}{
+			"id":                    transformation.ID,
+			"input_claim":           flattenClaimsMappingPolicyTransformationClaims(transformation.InputClaims),
+			"input_parameter":       inputParameters,
+			"output_claim":          flattenClaimsMappingPolicyTransformationClaims(transformation.OutputClaims),
+			"transformation_method": transformation.TransformationMethod,
+		})
+	}
+
+	return bool(properties.IncludeBasicClaimSet), claimSchema, claimsTransformations, samlNameId
+}
+
+func flattenClaimsMappingPolicyTransformationClaims(in []claimsMappingPolicyTransformationClaim) []interface{} {
+	result := make([]interface{}, 0)
+
+	for _, claim := range in {
+		result = append(result, map[string]interface{}{
+			"claim_type_reference_id":   claim.ClaimTypeReferenceId,
+			"transformation_claim_type": claim.TransformationClaimType,
+		})
+	}
+
+	return result
+}
+
+// claimsMappingPolicyValidateDefinition checks the claim schema entries and claims transformations of a claims mapping
+// policy for consistency, and that transformations are referenced correctly. Claim schema entries after the first
+// claimSchemaCount entries are assumed to have been expanded from `saml_name_id`.
+func claimsMappingPolicyValidateDefinition(in claimsMappingPolicyDefinition, claimSchemaCount int) error {
+	properties := in.ClaimsMappingPolicy
+
+	transformationIds := make(map[string]bool)
+	inputClaimIds := make(map[string]bool)
+	for i, transformation := range properties.ClaimsTransformations {
+		attr := fmt.Sprintf("claims_transformation.%d", i)
+
+		if transformationIds[transformation.ID] {
+			return fmt.Errorf("the transformation ID %q is specified more than once in `claims_transformation`", transformation.ID)
+		}
+		transformationIds[transformation.ID] = true
+
+		for _, claim := range transformation.InputClaims {
+			inputClaimIds[claim.ClaimTypeReferenceId] = true
+		}
+
+		if len(transformation.InputClaims) == 0 {
+			return fmt.Errorf("`%s` must have at least one `input_claim`", attr)
+		}
+		if len(transformation.OutputClaims) == 0 {
+			return fmt.Errorf("`%s` must have at least one `output_claim`", attr)
+		}
+
+		switch transformation.TransformationMethod {
+		case claimsMappingPolicyTransformationMethodJoin:
+			hasSeparator := false
+			for _, parameter := range transformation.InputParameters {
+				if parameter.ID == "separator" {
+					hasSeparator = true
+				}
+			}
+			if !hasSeparator {
+				return fmt.Errorf("`%s` must have an `input_parameter` with the ID %q for the %q transformation method", attr, "separator", transformation.TransformationMethod)
+			}
+		case claimsMappingPolicyTransformationMethodExtractMailPrefix:
+			if len(transformation.InputParameters) > 0 {
+				return fmt.Errorf("`%s` cannot have an `input_parameter` for the %q transformation method", attr, transformation.TransformationMethod)
+			}
+		}
+	}
+
+	for i, entry := range properties.ClaimsSchema {
+		attr := fmt.Sprintf("claim_schema.%d", i)
+		if i >= claimSchemaCount {
+			attr = "saml_name_id.0"
+		}
+
+		switch {
+		case entry.Value != "":
+			if entry.Source != "" || entry.ID != "" || entry.TransformationId != "" {
+				return fmt.Errorf("`%s.value` cannot be specified together with `source`, `id` or `transformation_id`", attr)
+			}
+		case entry.TransformationId != "":
+			if !strings.EqualFold(entry.Source, claimsMappingPolicySourceTransformation) {
+				return fmt.Errorf("`%s.source` must be %q when `transformation_id` is specified", attr, claimsMappingPolicySourceTransformation)
+			}
+			if !transformationIds[entry.TransformationId] {
+				return fmt.Errorf("`%s.transformation_id` references the transformation %q, which is not specified in `claims_transformation`", attr, entry.TransformationId)
+			}
+		case strings.EqualFold(entry.Source, claimsMappingPolicySourceTransformation):
+			return fmt.Errorf("`%s.transformation_id` is required when `source` is %q", attr, claimsMappingPolicySourceTransformation)
+		default:
+			if entry.Source == "" || entry.ID == "" {
+				return fmt.Errorf("`%s` must specify both `source` and `id`, a `value`, or a `transformation_id`", attr)
+			}
+		}
+
+		// Entries without a claim type are not emitted, and only serve as inputs to transformations
+		if entry.JwtClaimType == "" && entry.SamlClaimType == "" && !inputClaimIds[entry.ID] {
+			return fmt.Errorf("`%s` must specify at least one of `jwt_claim_type` or `saml_claim_type`, unless it is an `input_claim` of a transformation", attr)
+		}
+	}
+
+	return nil
+}
+
+// claimsMappingPolicyDefinitionsEqual compares policy definitions semantically, disregarding whitespace and the order
+// of object keys
+func claimsMappingPolicyDefinitionsEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	var aValue, bValue interface{}
+	if err := json.Unmarshal([]byte(a), &aValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &bValue); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(aValue, bValue)
+}
+
+func claimsMappingPolicyDefinitionDiffSuppress(_, oldValue, newValue string, _ *pluginsdk.ResourceData) bool {
+	return claimsMappingPolicyDefinitionsEqual(oldValue, newValue)
+}
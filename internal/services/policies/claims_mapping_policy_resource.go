@@ -26,6 +26,8 @@ func claimsMappingPolicyResource() *pluginsdk.Resource {
 		UpdateContext: claimsMappingPolicyResourceUpdate,
 		DeleteContext: claimsMappingPolicyResourceDelete,
 
+		CustomizeDiff: claimsMappingPolicyResourceCustomizeDiff,
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -42,14 +44,31 @@ func claimsMappingPolicyResource() *pluginsdk.Resource {
 
 		Schema: map[string]*pluginsdk.Schema{
 			"definition": {
-				Description: "A string collection containing a JSON string that defines the rules and settings for this policy",
-				Type:        pluginsdk.TypeList,
-				Required:    true,
+				Description:      "A string collection containing a JSON string that defines the rules and settings for this policy",
+				Type:             pluginsdk.TypeList,
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    claimsMappingPolicyTypedAttributes,
+				DiffSuppressFunc: claimsMappingPolicyDefinitionDiffSuppress,
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
 			},
 
+			"include_basic_claim_set": {
+				Description:   "Whether the basic claim set is included in tokens affected by this policy",
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"definition"},
+			},
+
+			"claim_schema": claimsMappingPolicyClaimSchemaSchema(),
+
+			"claims_transformation": claimsMappingPolicyClaimsTransformationSchema(),
+
+			"saml_name_id": claimsMappingPolicySamlNameIdSchema(),
+
 			"display_name": {
 				Description: "Display name for this policy",
 				Type:        pluginsdk.TypeString,
@@ -59,11 +78,79 @@ func claimsMappingPolicyResource() *pluginsdk.Resource {
 	}
 }
 
+func claimsMappingPolicyResourceCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	config := diff.GetRawConfig()
+
+	if !config.GetAttr("definition").IsNull() {
+		// The typed attributes are read from the definition, so they are not known until it has been applied
+		if diff.HasChange("definition") {
+			for _, attr := range claimsMappingPolicyTypedAttributes {
+				if err := diff.SetNewComputed(attr); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	typed := !config.GetAttr("include_basic_claim_set").IsNull()
+	for _, attr := range []string{"claim_schema", "claims_transformation", "saml_name_id"} {
+		if v := config.GetAttr(attr); !v.IsKnown() || (!v.IsNull() && v.LengthInt() > 0) {
+			typed = true
+			continue
+		}
+
+		// Typed attributes are also computed, so those omitted from configuration must be cleared explicitly
+		if err := diff.SetNew(attr, []interface{}{}); err != nil {
+			return err
+		}
+	}
+	if !typed {
+		return fmt.Errorf("one of `definition`, `include_basic_claim_set`, `claim_schema`, `claims_transformation` or `saml_name_id` must be specified")
+	}
+	if config.GetAttr("include_basic_claim_set").IsNull() {
+		if err := diff.SetNew("include_basic_claim_set", false); err != nil {
+			return err
+		}
+	}
+
+	for _, attr := range claimsMappingPolicyTypedAttributes {
+		if !config.GetAttr(attr).IsWhollyKnown() {
+			return diff.SetNewComputed("definition")
+		}
+	}
+
+	claimSchema := diff.Get("claim_schema").([]interface{})
+	definition := expandClaimsMappingPolicyDefinition(diff.Get("include_basic_claim_set").(bool), claimSchema, diff.Get("claims_transformation").([]interface{}), diff.Get("saml_name_id").([]interface{}))
+	if err := claimsMappingPolicyValidateDefinition(definition, len(claimSchema)); err != nil {
+		return err
+	}
+
+	encoded, err := stsPolicyEncodeDefinition(definition)
+	if err != nil {
+		return fmt.Errorf("encoding definition: %v", err)
+	}
+
+	// Only update the definition when it has changed semantically, since the API may reformat it
+	if existing := diff.Get("definition").([]interface{}); len(existing) == 1 {
+		if v, ok := existing[0].(string); ok && claimsMappingPolicyDefinitionsEqual(v, (*encoded)[0]) {
+			return nil
+		}
+	}
+
+	return diff.SetNew("definition", tf.FlattenStringSlicePtr(encoded))
+}
+
 func claimsMappingPolicyResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).Policies.ClaimsMappingPolicyClient
 
+	definition, err := claimsMappingPolicyExpandDefinition(d)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "definition", "Could not encode definition for Claims Mapping Policy")
+	}
+
 	claimsMappingPolicy := msgraph.ClaimsMappingPolicy{
-		Definition:  tf.ExpandStringSlicePtr(d.Get("definition").([]interface{})),
+		Definition:  definition,
 		DisplayName: pointer.To(d.Get("display_name").(string)),
 	}
 	policy, _, err := client.Create(ctx, claimsMappingPolicy)
@@ -98,6 +185,20 @@ func claimsMappingPolicyResourceRead(ctx context.Context, d *pluginsdk.ResourceD
 	tf.Set(d, "definition", policy.Definition)
 	tf.Set(d, "display_name", policy.DisplayName)
 
+	// Definitions which cannot be decoded are still supported with the `definition` attribute, so the typed
+	// attributes are left empty rather than raising an error
+	var definition claimsMappingPolicyDefinition
+	if err := stsPolicyDecodeDefinition(policy.Definition, &definition); err != nil {
+		log.Printf("[DEBUG] Could not decode definition for Claims Mapping Policy with Object ID %q: %v", objectId, err)
+		definition = claimsMappingPolicyDefinition{}
+	}
+
+	includeBasicClaimSet, claimSchema, claimsTransformations, samlNameId := flattenClaimsMappingPolicyDefinition(definition)
+	tf.Set(d, "claim_schema", claimSchema)
+	tf.Set(d, "claims_transformation", claimsTransformations)
+	tf.Set(d, "include_basic_claim_set", includeBasicClaimSet)
+	tf.Set(d, "saml_name_id", samlNameId)
+
 	return nil
 }
 
@@ -105,14 +206,19 @@ func claimsMappingPolicyResourceUpdate(ctx context.Context, d *pluginsdk.Resourc
 	client := meta.(*clients.Client).Policies.ClaimsMappingPolicyClient
 	objectId := d.Id()
 
+	definition, err := claimsMappingPolicyExpandDefinition(d)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "definition", "Could not encode definition for Claims Mapping Policy")
+	}
+
 	claimsMappingPolicy := msgraph.ClaimsMappingPolicy{
 		DirectoryObject: msgraph.DirectoryObject{
 			Id: &objectId,
 		},
-		Definition:  tf.ExpandStringSlicePtr(d.Get("definition").([]interface{})),
+		Definition:  definition,
 		DisplayName: pointer.To(d.Get("display_name").(string)),
 	}
-	_, err := client.Update(ctx, claimsMappingPolicy)
+	_, err = client.Update(ctx, claimsMappingPolicy)
 	if err != nil {
 		return tf.ErrorDiagF(err, "Could not update Claims Mapping Policy with object ID %q", objectId)
 	}
@@ -140,3 +246,15 @@ func claimsMappingPolicyResourceDelete(ctx context.Context, d *pluginsdk.Resourc
 
 	return nil
 }
+
+// claimsMappingPolicyExpandDefinition returns the policy definition, either as specified with the `definition`
+// attribute, or encoded from the typed attributes
+func claimsMappingPolicyExpandDefinition(d *pluginsdk.ResourceData) (*[]string, error) {
+	if !d.GetRawConfig().GetAttr("definition").IsNull() {
+		return tf.ExpandStringSlicePtr(d.Get("definition").([]interface{})), nil
+	}
+
+	definition := expandClaimsMappingPolicyDefinition(d.Get("include_basic_claim_set").(bool), d.Get("claim_schema").([]interface{}), d.Get("claims_transformation").([]interface{}), d.Get("saml_name_id").([]interface{}))
+
+	return stsPolicyEncodeDefinition(definition)
+}
@@ -40,6 +40,65 @@ func TestClaimsMappingPolicy_basic(t *testing.T) {
 	})
 }
 
+func TestClaimsMappingPolicy_typed(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_claims_mapping_policy", "test")
+	r := ClaimsMappingPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.typed(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("claim_schema.#").HasValue("2"),
+				check.That(data.ResourceName).Key("definition.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.typedComplete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("claims_transformation.#").HasValue("1"),
+				check.That(data.ResourceName).Key("include_basic_claim_set").HasValue("true"),
+				check.That(data.ResourceName).Key("saml_name_id.0.source").HasValue("user"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.typed(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("claims_transformation.#").HasValue("0"),
+				check.That(data.ResourceName).Key("saml_name_id.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestClaimsMappingPolicy_definitionToTyped(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_claims_mapping_policy", "test")
+	r := ClaimsMappingPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("claim_schema.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.typed(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (ClaimsMappingPolicyResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
@@ -66,6 +125,95 @@ resource "azuread_claims_mapping_policy" "test" {
 `, data.RandomString)
 }
 
+func (ClaimsMappingPolicyResource) typed(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_claims_mapping_policy" "test" {
+  display_name = "acctest-%[1]s"
+
+  claim_schema {
+    source          = "user"
+    id              = "employeeid"
+    jwt_claim_type  = "name"
+    saml_claim_type = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/name"
+  }
+
+  claim_schema {
+    source          = "company"
+    id              = "tenantcountry"
+    jwt_claim_type  = "country"
+    saml_claim_type = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/country"
+  }
+}
+`, data.RandomString)
+}
+
+func (ClaimsMappingPolicyResource) typedComplete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_claims_mapping_policy" "test" {
+  display_name            = "acctest-%[1]s-updated"
+  include_basic_claim_set = true
+
+  claim_schema {
+    source          = "user"
+    id              = "employeeid"
+    jwt_claim_type  = "name"
+    saml_claim_type = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/name"
+  }
+
+  claim_schema {
+    source = "user"
+    id     = "givenname"
+  }
+
+  claim_schema {
+    source = "user"
+    id     = "surname"
+  }
+
+  claim_schema {
+    source            = "transformation"
+    id                = "fullname"
+    transformation_id = "JoinTheData"
+    jwt_claim_type    = "fullname"
+  }
+
+  claims_transformation {
+    id                    = "JoinTheData"
+    transformation_method = "Join"
+
+    input_claim {
+      claim_type_reference_id   = "givenname"
+      transformation_claim_type = "string1"
+    }
+
+    input_claim {
+      claim_type_reference_id   = "surname"
+      transformation_claim_type = "string2"
+    }
+
+    input_parameter {
+      id    = "separator"
+      value = " "
+    }
+
+    output_claim {
+      claim_type_reference_id   = "fullname"
+      transformation_claim_type = "outputClaim"
+    }
+  }
+
+  saml_name_id {
+    source = "user"
+    id     = "userprincipalname"
+  }
+}
+`, data.RandomString)
+}
+
 func (r ClaimsMappingPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.Policies.ClaimsMappingPolicyClient
 	client.BaseClient.DisableRetries = true
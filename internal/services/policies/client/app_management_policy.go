@@ -0,0 +1,320 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+const (
+	AppManagementPolicyRestrictionTypeCustomPasswordAddition      = "customPasswordAddition"
+	AppManagementPolicyRestrictionTypePasswordAddition            = "passwordAddition"
+	AppManagementPolicyRestrictionTypePasswordLifetime            = "passwordLifetime"
+	AppManagementPolicyRestrictionTypeSymmetricKeyAddition        = "symmetricKeyAddition"
+	AppManagementPolicyRestrictionTypeSymmetricKeyLifetime        = "symmetricKeyLifetime"
+	AppManagementPolicyRestrictionTypeAsymmetricKeyLifetime       = "asymmetricKeyLifetime"
+	AppManagementPolicyRestrictionTypeTrustedCertificateAuthority = "trustedCertificateAuthority"
+)
+
+// AppManagementPolicy describes a policy which enforces credential restrictions for the applications and service
+// principals to which it is assigned.
+type AppManagementPolicy struct {
+	ID           *string                     `json:"id,omitempty"`
+	Description  *string                     `json:"description,omitempty"`
+	DisplayName  *string                     `json:"displayName,omitempty"`
+	IsEnabled    *bool                       `json:"isEnabled,omitempty"`
+	Restrictions *AppManagementConfiguration `json:"restrictions,omitempty"`
+}
+
+// TenantAppManagementPolicy describes the default credential restrictions for all applications and service principals
+// in the tenant. There is exactly one per tenant.
+type TenantAppManagementPolicy struct {
+	ID                           *string                     `json:"id,omitempty"`
+	ApplicationRestrictions      *AppManagementConfiguration `json:"applicationRestrictions,omitempty"`
+	Description                  *string                     `json:"description,omitempty"`
+	DisplayName                  *string                     `json:"displayName,omitempty"`
+	IsEnabled                    *bool                       `json:"isEnabled,omitempty"`
+	ServicePrincipalRestrictions *AppManagementConfiguration `json:"servicePrincipalRestrictions,omitempty"`
+}
+
+type AppManagementConfiguration struct {
+	KeyCredentials      *[]KeyCredentialConfiguration      `json:"keyCredentials,omitempty"`
+	PasswordCredentials *[]PasswordCredentialConfiguration `json:"passwordCredentials,omitempty"`
+}
+
+type KeyCredentialConfiguration struct {
+	MaxLifetime                 *string    `json:"maxLifetime,omitempty"`
+	RestrictForAppsCreatedAfter *time.Time `json:"restrictForAppsCreatedAfter,omitempty"`
+	RestrictionType             *string    `json:"restrictionType,omitempty"`
+}
+
+type PasswordCredentialConfiguration struct {
+	MaxLifetime                 *string    `json:"maxLifetime,omitempty"`
+	RestrictForAppsCreatedAfter *time.Time `json:"restrictForAppsCreatedAfter,omitempty"`
+	RestrictionType             *string    `json:"restrictionType,omitempty"`
+}
+
+// AppManagementPolicyClient performs operations on AppManagementPolicies.
+type AppManagementPolicyClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewAppManagementPolicyClient returns a new AppManagementPolicyClient
+func NewAppManagementPolicyClient() *AppManagementPolicyClient {
+	return &AppManagementPolicyClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10),
+	}
+}
+
+// Create creates a new AppManagementPolicy.
+func (c *AppManagementPolicyClient) Create(ctx context.Context, policy AppManagementPolicy) (*AppManagementPolicy, int, error) {
+	var status int
+
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity: "/policies/appManagementPolicies",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AppManagementPolicyClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var newPolicy AppManagementPolicy
+	if err := json.Unmarshal(respBody, &newPolicy); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &newPolicy, status, nil
+}
+
+// Get retrieves an AppManagementPolicy.
+func (c *AppManagementPolicyClient) Get(ctx context.Context, id string, query odata.Query) (*AppManagementPolicy, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/policies/appManagementPolicies/%s", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AppManagementPolicyClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var policy AppManagementPolicy
+	if err := json.Unmarshal(respBody, &policy); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &policy, status, nil
+}
+
+// Update amends an existing AppManagementPolicy.
+func (c *AppManagementPolicyClient) Update(ctx context.Context, policy AppManagementPolicy) (int, error) {
+	var status int
+
+	if policy.ID == nil {
+		return status, errors.New("AppManagementPolicyClient.Update(): cannot update policy with nil ID")
+	}
+
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/policies/appManagementPolicies/%s", *policy.ID),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AppManagementPolicyClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
+
+// Delete removes an AppManagementPolicy.
+func (c *AppManagementPolicyClient) Delete(ctx context.Context, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/policies/appManagementPolicies/%s", id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AppManagementPolicyClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
+
+// ListAssigned returns the AppManagementPolicies assigned to an application or service principal, where target is
+// one of `applications` or `servicePrincipals`.
+func (c *AppManagementPolicyClient) ListAssigned(ctx context.Context, target, objectId string) (*[]AppManagementPolicy, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/%s/%s/appManagementPolicies", target, objectId),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AppManagementPolicyClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		Policies []AppManagementPolicy `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.Policies, status, nil
+}
+
+// Assign assigns an AppManagementPolicy to an application or service principal, where target is one of
+// `applications` or `servicePrincipals`.
+func (c *AppManagementPolicyClient) Assign(ctx context.Context, target, objectId, policyId string) (int, error) {
+	var status int
+
+	body, err := json.Marshal(msgraph.DirectoryObject{
+		ODataId: (*odata.Id)(pointer.To(fmt.Sprintf("%s/%s/policies/appManagementPolicies/%s", c.BaseClient.Endpoint, c.BaseClient.ApiVersion, policyId))),
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/%s/%s/appManagementPolicies/$ref", target, objectId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AppManagementPolicyClient.BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
+
+// Unassign removes an AppManagementPolicy from an application or service principal, where target is one of
+// `applications` or `servicePrincipals`.
+func (c *AppManagementPolicyClient) Unassign(ctx context.Context, target, objectId, policyId string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/%s/%s/appManagementPolicies/%s/$ref", target, objectId, policyId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AppManagementPolicyClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
+
+// TenantAppManagementPolicyClient performs operations on the TenantAppManagementPolicy.
+type TenantAppManagementPolicyClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewTenantAppManagementPolicyClient returns a new TenantAppManagementPolicyClient
+func NewTenantAppManagementPolicyClient() *TenantAppManagementPolicyClient {
+	return &TenantAppManagementPolicyClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10),
+	}
+}
+
+// Get retrieves the TenantAppManagementPolicy.
+func (c *TenantAppManagementPolicyClient) Get(ctx context.Context, query odata.Query) (*TenantAppManagementPolicy, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: "/policies/defaultAppManagementPolicy",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("TenantAppManagementPolicyClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var policy TenantAppManagementPolicy
+	if err := json.Unmarshal(respBody, &policy); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &policy, status, nil
+}
+
+// Update amends the TenantAppManagementPolicy. Restrictions are replaced in their entirety.
+func (c *TenantAppManagementPolicyClient) Update(ctx context.Context, policy TenantAppManagementPolicy) (int, error) {
+	var status int
+
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: "/policies/defaultAppManagementPolicy",
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("TenantAppManagementPolicyClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+const (
+	AuthenticationMethodConfigurationIdEmail                  = "Email"
+	AuthenticationMethodConfigurationIdFido2                  = "Fido2"
+	AuthenticationMethodConfigurationIdHardwareOath           = "HardwareOath"
+	AuthenticationMethodConfigurationIdMicrosoftAuthenticator = "MicrosoftAuthenticator"
+	AuthenticationMethodConfigurationIdSms                    = "Sms"
+	AuthenticationMethodConfigurationIdSoftwareOath           = "SoftwareOath"
+	AuthenticationMethodConfigurationIdTemporaryAccessPass    = "TemporaryAccessPass"
+	AuthenticationMethodConfigurationIdVoice                  = "Voice"
+	AuthenticationMethodConfigurationIdX509Certificate        = "X509Certificate"
+)
+
+// AuthenticationMethodConfigurationODataTypes maps each authentication method configuration ID to its OData type,
+// which must be specified when updating the configuration.
+var AuthenticationMethodConfigurationODataTypes = map[string]string{
+	AuthenticationMethodConfigurationIdEmail:                  "#microsoft.graph.emailAuthenticationMethodConfiguration",
+	AuthenticationMethodConfigurationIdFido2:                  "#microsoft.graph.fido2AuthenticationMethodConfiguration",
+	AuthenticationMethodConfigurationIdHardwareOath:           "#microsoft.graph.hardwareOathAuthenticationMethodConfiguration",
+	AuthenticationMethodConfigurationIdMicrosoftAuthenticator: "#microsoft.graph.microsoftAuthenticatorAuthenticationMethodConfiguration",
+	AuthenticationMethodConfigurationIdSms:                    "#microsoft.graph.smsAuthenticationMethodConfiguration",
+	AuthenticationMethodConfigurationIdSoftwareOath:           "#microsoft.graph.softwareOathAuthenticationMethodConfiguration",
+	AuthenticationMethodConfigurationIdTemporaryAccessPass:    "#microsoft.graph.temporaryAccessPassAuthenticationMethodConfiguration",
+	AuthenticationMethodConfigurationIdVoice:                  "#microsoft.graph.voiceAuthenticationMethodConfiguration",
+	AuthenticationMethodConfigurationIdX509Certificate:        "#microsoft.graph.x509CertificateAuthenticationMethodConfiguration",
+}
+
+const (
+	AuthenticationMethodStateDisabled = "disabled"
+	AuthenticationMethodStateEnabled  = "enabled"
+)
+
+const (
+	AdvancedConfigStateDefault  = "default"
+	AdvancedConfigStateDisabled = "disabled"
+	AdvancedConfigStateEnabled  = "enabled"
+)
+
+const (
+	AuthenticationMethodTargetTypeGroup = "group"
+	AuthenticationMethodTargetTypeUser  = "user"
+)
+
+const (
+	Fido2RestrictionEnforcementTypeAllow = "allow"
+	Fido2RestrictionEnforcementTypeBlock = "block"
+)
+
+const (
+	X509CertificateAuthenticationModeMultiFactor  = "x509CertificateMultiFactor"
+	X509CertificateAuthenticationModeSingleFactor = "x509CertificateSingleFactor"
+)
+
+// AuthenticationMethodsPolicy describes the tenant-wide authentication methods policy. There is exactly one per tenant.
+type AuthenticationMethodsPolicy struct {
+	ID                                 *string                              `json:"id,omitempty"`
+	Description                        *string                              `json:"description,omitempty"`
+	DisplayName                        *string                              `json:"displayName,omitempty"`
+	PolicyVersion                      *string                              `json:"policyVersion,omitempty"`
+	ReconfirmationInDays               *int                                 `json:"reconfirmationInDays,omitempty"`
+	RegistrationEnforcement            *RegistrationEnforcement             `json:"registrationEnforcement,omitempty"`
+	ReportSuspiciousActivitySettings   *ReportSuspiciousActivitySettings    `json:"reportSuspiciousActivitySettings,omitempty"`
+	AuthenticationMethodConfigurations *[]AuthenticationMethodConfiguration `json:"authenticationMethodConfigurations,omitempty"`
+}
+
+type RegistrationEnforcement struct {
+	AuthenticationMethodsRegistrationCampaign *AuthenticationMethodsRegistrationCampaign `json:"authenticationMethodsRegistrationCampaign,omitempty"`
+}
+
+type AuthenticationMethodsRegistrationCampaign struct {
+	EnforceRegistrationAfterAllowedSnoozes *bool                                                     `json:"enforceRegistrationAfterAllowedSnoozes,omitempty"`
+	ExcludeTargets                         *[]ExcludeTarget                                          `json:"excludeTargets,omitempty"`
+	IncludeTargets                         *[]AuthenticationMethodsRegistrationCampaignIncludeTarget `json:"includeTargets,omitempty"`
+	SnoozeDurationInDays                   *int                                                      `json:"snoozeDurationInDays,omitempty"`
+	State                                  *string                                                   `json:"state,omitempty"`
+}
+
+type AuthenticationMethodsRegistrationCampaignIncludeTarget struct {
+	ID                           *string `json:"id,omitempty"`
+	TargetType                   *string `json:"targetType,omitempty"`
+	TargetedAuthenticationMethod *string `json:"targetedAuthenticationMethod,omitempty"`
+}
+
+type ReportSuspiciousActivitySettings struct {
+	IncludeTarget      *IncludeTarget `json:"includeTarget,omitempty"`
+	State              *string        `json:"state,omitempty"`
+	VoiceReportingCode *int           `json:"voiceReportingCode,omitempty"`
+}
+
+type IncludeTarget struct {
+	ID         *string `json:"id,omitempty"`
+	TargetType *string `json:"targetType,omitempty"`
+}
+
+type ExcludeTarget struct {
+	ID         *string `json:"id,omitempty"`
+	TargetType *string `json:"targetType,omitempty"`
+}
+
+// AuthenticationMethodConfiguration describes the configuration of a single authentication method. Method-specific
+// properties are only populated for the corresponding method.
+type AuthenticationMethodConfiguration struct {
+	ID             *string                       `json:"id,omitempty"`
+	ODataType      *odata.Type                   `json:"@odata.type,omitempty"`
+	State          *string                       `json:"state,omitempty"`
+	IncludeTargets *[]AuthenticationMethodTarget `json:"includeTargets,omitempty"`
+	ExcludeTargets *[]ExcludeTarget              `json:"excludeTargets,omitempty"`
+
+	// Email
+	AllowExternalIdToUseEmailOtp *string `json:"allowExternalIdToUseEmailOtp,omitempty"`
+
+	// FIDO2
+	IsAttestationEnforced            *bool                 `json:"isAttestationEnforced,omitempty"`
+	IsSelfServiceRegistrationAllowed *bool                 `json:"isSelfServiceRegistrationAllowed,omitempty"`
+	KeyRestrictions                  *Fido2KeyRestrictions `json:"keyRestrictions,omitempty"`
+
+	// Microsoft Authenticator
+	FeatureSettings       *MicrosoftAuthenticatorFeatureSettings `json:"featureSettings,omitempty"`
+	IsSoftwareOathEnabled *bool                                  `json:"isSoftwareOathEnabled,omitempty"`
+
+	// Temporary Access Pass
+	DefaultLength            *int  `json:"defaultLength,omitempty"`
+	DefaultLifetimeInMinutes *int  `json:"defaultLifetimeInMinutes,omitempty"`
+	IsUsableOnce             *bool `json:"isUsableOnce,omitempty"`
+	MaximumLifetimeInMinutes *int  `json:"maximumLifetimeInMinutes,omitempty"`
+	MinimumLifetimeInMinutes *int  `json:"minimumLifetimeInMinutes,omitempty"`
+
+	// X.509 Certificate
+	AuthenticationModeConfiguration *X509CertificateAuthenticationModeConfiguration `json:"authenticationModeConfiguration,omitempty"`
+	CertificateUserBindings         *[]X509CertificateUserBinding                   `json:"certificateUserBindings,omitempty"`
+}
+
+type AuthenticationMethodTarget struct {
+	ID                     *string `json:"id,omitempty"`
+	IsRegistrationRequired *bool   `json:"isRegistrationRequired,omitempty"`
+	TargetType             *string `json:"targetType,omitempty"`
+}
+
+type Fido2KeyRestrictions struct {
+	AaGuids         *[]string `json:"aaGuids,omitempty"`
+	EnforcementType *string   `json:"enforcementType,omitempty"`
+	IsEnforced      *bool     `json:"isEnforced,omitempty"`
+}
+
+type MicrosoftAuthenticatorFeatureSettings struct {
+	DisplayAppInformationRequiredState      *AuthenticationMethodFeatureConfiguration `json:"displayAppInformationRequiredState,omitempty"`
+	DisplayLocationInformationRequiredState *AuthenticationMethodFeatureConfiguration `json:"displayLocationInformationRequiredState,omitempty"`
+	NumberMatchingRequiredState             *AuthenticationMethodFeatureConfiguration `json:"numberMatchingRequiredState,omitempty"`
+}
+
+type AuthenticationMethodFeatureConfiguration struct {
+	State *string `json:"state,omitempty"`
+}
+
+type X509CertificateAuthenticationModeConfiguration struct {
+	X509CertificateAuthenticationDefaultMode *string `json:"x509CertificateAuthenticationDefaultMode,omitempty"`
+}
+
+type X509CertificateUserBinding struct {
+	Priority             *int    `json:"priority,omitempty"`
+	UserProperty         *string `json:"userProperty,omitempty"`
+	X509CertificateField *string `json:"x509CertificateField,omitempty"`
+}
+
+// AuthenticationMethodsPolicyClient performs operations on the AuthenticationMethodsPolicy and its
+// AuthenticationMethodConfiguration.
+type AuthenticationMethodsPolicyClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewAuthenticationMethodsPolicyClient returns a new AuthenticationMethodsPolicyClient
+func NewAuthenticationMethodsPolicyClient() *AuthenticationMethodsPolicyClient {
+	return &AuthenticationMethodsPolicyClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10),
+	}
+}
+
+// Get retrieves the AuthenticationMethodsPolicy.
+func (c *AuthenticationMethodsPolicyClient) Get(ctx context.Context, query odata.Query) (*AuthenticationMethodsPolicy, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: "/policies/authenticationMethodsPolicy",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AuthenticationMethodsPolicyClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var policy AuthenticationMethodsPolicy
+	if err := json.Unmarshal(respBody, &policy); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &policy, status, nil
+}
+
+// Update amends the AuthenticationMethodsPolicy.
+func (c *AuthenticationMethodsPolicyClient) Update(ctx context.Context, policy AuthenticationMethodsPolicy) (int, error) {
+	var status int
+
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusOK, http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: "/policies/authenticationMethodsPolicy",
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AuthenticationMethodsPolicyClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
+
+// GetMethodConfiguration retrieves an AuthenticationMethodConfiguration.
+func (c *AuthenticationMethodsPolicyClient) GetMethodConfiguration(ctx context.Context, id string) (*AuthenticationMethodConfiguration, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/policies/authenticationMethodsPolicy/authenticationMethodConfigurations/%s", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AuthenticationMethodsPolicyClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var configuration AuthenticationMethodConfiguration
+	if err := json.Unmarshal(respBody, &configuration); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &configuration, status, nil
+}
+
+// UpdateMethodConfiguration amends an AuthenticationMethodConfiguration.
+func (c *AuthenticationMethodsPolicyClient) UpdateMethodConfiguration(ctx context.Context, configuration AuthenticationMethodConfiguration) (int, error) {
+	var status int
+
+	if configuration.ID == nil {
+		return status, errors.New("cannot update authentication method configuration with nil ID")
+	}
+
+	body, err := json.Marshal(configuration)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusOK, http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/policies/authenticationMethodsPolicy/authenticationMethodConfigurations/%s", *configuration.ID),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AuthenticationMethodsPolicyClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
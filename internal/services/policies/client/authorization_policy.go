@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+const (
+	AuthorizationPolicyAllowInvitesFromAdminsAndGuestInviters           = "adminsAndGuestInviters"
+	AuthorizationPolicyAllowInvitesFromAdminsGuestInvitersAndAllMembers = "adminsGuestInvitersAndAllMembers"
+	AuthorizationPolicyAllowInvitesFromEveryone                         = "everyone"
+	AuthorizationPolicyAllowInvitesFromNone                             = "none"
+)
+
+const (
+	AuthorizationPolicyGuestUserRoleIdGuestUser           = "10dae51f-b6af-4016-8d66-8c2a99b929b3"
+	AuthorizationPolicyGuestUserRoleIdRestrictedGuestUser = "2af84b1e-32c8-42b7-82bc-daa82404023b"
+	AuthorizationPolicyGuestUserRoleIdUser                = "a0b1b346-4d3e-4e8b-98f8-753987be4970"
+)
+
+// AuthorizationPolicy describes the tenant-wide authorization settings. There is exactly one per tenant.
+type AuthorizationPolicy struct {
+	ID                                        *string                     `json:"id,omitempty"`
+	AllowEmailVerifiedUsersToJoinOrganization *bool                       `json:"allowEmailVerifiedUsersToJoinOrganization,omitempty"`
+	AllowInvitesFrom                          *string                     `json:"allowInvitesFrom,omitempty"`
+	AllowUserConsentForRiskyApps              *bool                       `json:"allowUserConsentForRiskyApps,omitempty"`
+	AllowedToSignUpEmailBasedSubscriptions    *bool                       `json:"allowedToSignUpEmailBasedSubscriptions,omitempty"`
+	AllowedToUseSSPR                          *bool                       `json:"allowedToUseSSPR,omitempty"`
+	BlockMsolPowerShell                       *bool                       `json:"blockMsolPowerShell,omitempty"`
+	DefaultUserRolePermissions                *DefaultUserRolePermissions `json:"defaultUserRolePermissions,omitempty"`
+	Description                               *string                     `json:"description,omitempty"`
+	DisplayName                               *string                     `json:"displayName,omitempty"`
+	GuestUserRoleId                           *string                     `json:"guestUserRoleId,omitempty"`
+}
+
+type DefaultUserRolePermissions struct {
+	AllowedToCreateApps                      *bool     `json:"allowedToCreateApps,omitempty"`
+	AllowedToCreateSecurityGroups            *bool     `json:"allowedToCreateSecurityGroups,omitempty"`
+	AllowedToCreateTenants                   *bool     `json:"allowedToCreateTenants,omitempty"`
+	AllowedToReadBitlockerKeysForOwnedDevice *bool     `json:"allowedToReadBitlockerKeysForOwnedDevice,omitempty"`
+	AllowedToReadOtherUsers                  *bool     `json:"allowedToReadOtherUsers,omitempty"`
+	PermissionGrantPoliciesAssigned          *[]string `json:"permissionGrantPoliciesAssigned,omitempty"`
+}
+
+// AuthorizationPolicyClient performs operations on the AuthorizationPolicy.
+type AuthorizationPolicyClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewAuthorizationPolicyClient returns a new AuthorizationPolicyClient
+func NewAuthorizationPolicyClient() *AuthorizationPolicyClient {
+	return &AuthorizationPolicyClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10),
+	}
+}
+
+// Get retrieves the AuthorizationPolicy.
+func (c *AuthorizationPolicyClient) Get(ctx context.Context, query odata.Query) (*AuthorizationPolicy, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: "/policies/authorizationPolicy",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AuthorizationPolicyClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var policy AuthorizationPolicy
+	if err := json.Unmarshal(respBody, &policy); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &policy, status, nil
+}
+
+// Update amends the AuthorizationPolicy. Only the properties which are set will be changed.
+func (c *AuthorizationPolicyClient) Update(ctx context.Context, policy AuthorizationPolicy) (int, error) {
+	var status int
+
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: "/policies/authorizationPolicy",
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AuthorizationPolicyClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
@@ -9,20 +9,50 @@ import (
 )
 
 type Client struct {
-	AuthenticationStrengthPoliciesClient *msgraph.AuthenticationStrengthPoliciesClient
-	ClaimsMappingPolicyClient            *msgraph.ClaimsMappingPolicyClient
-	RoleManagementPolicyAssignmentClient *msgraph.RoleManagementPolicyAssignmentClient
-	RoleManagementPolicyClient           *msgraph.RoleManagementPolicyClient
-	RoleManagementPolicyRuleClient       *msgraph.RoleManagementPolicyRuleClient
+	ActivityBasedTimeoutPolicyClient        *StsPolicyClient
+	AppManagementPolicyClient               *AppManagementPolicyClient
+	AuthenticationMethodsPolicyClient       *AuthenticationMethodsPolicyClient
+	AuthenticationStrengthPoliciesClient    *msgraph.AuthenticationStrengthPoliciesClient
+	AuthorizationPolicyClient               *AuthorizationPolicyClient
+	ClaimsMappingPolicyClient               *msgraph.ClaimsMappingPolicyClient
+	DelegatedPermissionClassificationClient *DelegatedPermissionClassificationClient
+	HomeRealmDiscoveryPolicyClient          *StsPolicyClient
+	PermissionGrantPolicyClient             *PermissionGrantPolicyClient
+	RoleManagementPolicyAssignmentClient    *msgraph.RoleManagementPolicyAssignmentClient
+	RoleManagementPolicyClient              *msgraph.RoleManagementPolicyClient
+	RoleManagementPolicyRuleClient          *msgraph.RoleManagementPolicyRuleClient
+	ServicePrincipalPolicyClient            *ServicePrincipalPolicyClient
+	TenantAppManagementPolicyClient         *TenantAppManagementPolicyClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	activityBasedTimeoutPolicyClient := NewActivityBasedTimeoutPolicyClient()
+	o.ConfigureClient(&activityBasedTimeoutPolicyClient.BaseClient)
+
+	appManagementPolicyClient := NewAppManagementPolicyClient()
+	o.ConfigureClient(&appManagementPolicyClient.BaseClient)
+
+	authenticationMethodsPolicyClient := NewAuthenticationMethodsPolicyClient()
+	o.ConfigureClient(&authenticationMethodsPolicyClient.BaseClient)
+
 	authenticationStrengthpoliciesClient := msgraph.NewAuthenticationStrengthPoliciesClient()
 	o.ConfigureClient(&authenticationStrengthpoliciesClient.BaseClient)
 
+	authorizationPolicyClient := NewAuthorizationPolicyClient()
+	o.ConfigureClient(&authorizationPolicyClient.BaseClient)
+
 	claimsMappingPolicyClient := msgraph.NewClaimsMappingPolicyClient()
 	o.ConfigureClient(&claimsMappingPolicyClient.BaseClient)
 
+	delegatedPermissionClassificationClient := NewDelegatedPermissionClassificationClient()
+	o.ConfigureClient(&delegatedPermissionClassificationClient.BaseClient)
+
+	homeRealmDiscoveryPolicyClient := NewHomeRealmDiscoveryPolicyClient()
+	o.ConfigureClient(&homeRealmDiscoveryPolicyClient.BaseClient)
+
+	permissionGrantPolicyClient := NewPermissionGrantPolicyClient()
+	o.ConfigureClient(&permissionGrantPolicyClient.BaseClient)
+
 	roleManagementPolicyAssignmentClient := msgraph.NewRoleManagementPolicyAssignmentClient()
 	o.ConfigureClient(&roleManagementPolicyAssignmentClient.BaseClient)
 
@@ -32,11 +62,26 @@ func NewClient(o *common.ClientOptions) *Client {
 	roleManagementPolicyRuleClient := msgraph.NewRoleManagementPolicyRuleClient()
 	o.ConfigureClient(&roleManagementPolicyRuleClient.BaseClient)
 
+	servicePrincipalPolicyClient := NewServicePrincipalPolicyClient()
+	o.ConfigureClient(&servicePrincipalPolicyClient.BaseClient)
+
+	tenantAppManagementPolicyClient := NewTenantAppManagementPolicyClient()
+	o.ConfigureClient(&tenantAppManagementPolicyClient.BaseClient)
+
 	return &Client{
-		AuthenticationStrengthPoliciesClient: authenticationStrengthpoliciesClient,
-		ClaimsMappingPolicyClient:            claimsMappingPolicyClient,
-		RoleManagementPolicyAssignmentClient: roleManagementPolicyAssignmentClient,
-		RoleManagementPolicyClient:           roleManagementPolicyClient,
-		RoleManagementPolicyRuleClient:       roleManagementPolicyRuleClient,
+		ActivityBasedTimeoutPolicyClient:        activityBasedTimeoutPolicyClient,
+		AppManagementPolicyClient:               appManagementPolicyClient,
+		AuthenticationMethodsPolicyClient:       authenticationMethodsPolicyClient,
+		AuthenticationStrengthPoliciesClient:    authenticationStrengthpoliciesClient,
+		AuthorizationPolicyClient:               authorizationPolicyClient,
+		ClaimsMappingPolicyClient:               claimsMappingPolicyClient,
+		DelegatedPermissionClassificationClient: delegatedPermissionClassificationClient,
+		HomeRealmDiscoveryPolicyClient:          homeRealmDiscoveryPolicyClient,
+		PermissionGrantPolicyClient:             permissionGrantPolicyClient,
+		RoleManagementPolicyAssignmentClient:    roleManagementPolicyAssignmentClient,
+		RoleManagementPolicyClient:              roleManagementPolicyClient,
+		RoleManagementPolicyRuleClient:          roleManagementPolicyRuleClient,
+		ServicePrincipalPolicyClient:            servicePrincipalPolicyClient,
+		TenantAppManagementPolicyClient:         tenantAppManagementPolicyClient,
 	}
 }
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+const (
+	DelegatedPermissionClassificationHigh   = "high"
+	DelegatedPermissionClassificationLow    = "low"
+	DelegatedPermissionClassificationMedium = "medium"
+)
+
+// DelegatedPermissionClassification describes the classification of a delegated permission exposed by a resource
+// service principal, which can be referenced by the condition sets of a PermissionGrantPolicy.
+type DelegatedPermissionClassification struct {
+	ID             *string `json:"id,omitempty"`
+	Classification *string `json:"classification,omitempty"`
+	PermissionId   *string `json:"permissionId,omitempty"`
+	PermissionName *string `json:"permissionName,omitempty"`
+}
+
+// DelegatedPermissionClassificationClient performs operations on DelegatedPermissionClassifications.
+type DelegatedPermissionClassificationClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewDelegatedPermissionClassificationClient returns a new DelegatedPermissionClassificationClient
+func NewDelegatedPermissionClassificationClient() *DelegatedPermissionClassificationClient {
+	return &DelegatedPermissionClassificationClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10),
+	}
+}
+
+// List returns the DelegatedPermissionClassifications for a service principal.
+func (c *DelegatedPermissionClassificationClient) List(ctx context.Context, servicePrincipalId string) (*[]DelegatedPermissionClassification, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/servicePrincipals/%s/delegatedPermissionClassifications", servicePrincipalId),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("DelegatedPermissionClassificationClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		Classifications []DelegatedPermissionClassification `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.Classifications, status, nil
+}
+
+// Create classifies a delegated permission exposed by a service principal.
+func (c *DelegatedPermissionClassificationClient) Create(ctx context.Context, servicePrincipalId string, classification DelegatedPermissionClassification) (*DelegatedPermissionClassification, int, error) {
+	var status int
+
+	body, err := json.Marshal(classification)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/servicePrincipals/%s/delegatedPermissionClassifications", servicePrincipalId),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("DelegatedPermissionClassificationClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var newClassification DelegatedPermissionClassification
+	if err := json.Unmarshal(respBody, &newClassification); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &newClassification, status, nil
+}
+
+// Delete removes a DelegatedPermissionClassification from a service principal.
+func (c *DelegatedPermissionClassificationClient) Delete(ctx context.Context, servicePrincipalId, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/servicePrincipals/%s/delegatedPermissionClassifications/%s", servicePrincipalId, id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("DelegatedPermissionClassificationClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+const (
+	PermissionGrantConditionSetTypeExcludes = "excludes"
+	PermissionGrantConditionSetTypeIncludes = "includes"
+)
+
+const (
+	PermissionGrantPermissionClassificationAll    = "all"
+	PermissionGrantPermissionClassificationHigh   = "high"
+	PermissionGrantPermissionClassificationLow    = "low"
+	PermissionGrantPermissionClassificationMedium = "medium"
+)
+
+const (
+	PermissionGrantPermissionTypeApplication              = "application"
+	PermissionGrantPermissionTypeDelegated                = "delegated"
+	PermissionGrantPermissionTypeDelegatedUserConsentable = "delegatedUserConsentable"
+)
+
+// PermissionGrantConditionSetAll is the value used by condition sets to match all permissions, client applications,
+// tenants or publishers.
+const PermissionGrantConditionSetAll = "all"
+
+// PermissionGrantConditionSetAnyResourceApplication is the value used by condition sets to match any resource
+// application.
+const PermissionGrantConditionSetAnyResourceApplication = "any"
+
+// PermissionGrantPolicy describes a policy which specifies the conditions under which consent can be granted.
+type PermissionGrantPolicy struct {
+	ID          *string                        `json:"id,omitempty"`
+	Description *string                        `json:"description,omitempty"`
+	DisplayName *string                        `json:"displayName,omitempty"`
+	Excludes    *[]PermissionGrantConditionSet `json:"excludes,omitempty"`
+	Includes    *[]PermissionGrantConditionSet `json:"includes,omitempty"`
+}
+
+// PermissionGrantConditionSet describes the conditions which a permission grant must match in order to be included
+// in, or excluded from, a PermissionGrantPolicy.
+type PermissionGrantConditionSet struct {
+	ID                                          *string   `json:"id,omitempty"`
+	CertifiedClientApplicationsOnly             *bool     `json:"certifiedClientApplicationsOnly,omitempty"`
+	ClientApplicationIds                        *[]string `json:"clientApplicationIds,omitempty"`
+	ClientApplicationPublisherIds               *[]string `json:"clientApplicationPublisherIds,omitempty"`
+	ClientApplicationTenantIds                  *[]string `json:"clientApplicationTenantIds,omitempty"`
+	ClientApplicationsFromVerifiedPublisherOnly *bool     `json:"clientApplicationsFromVerifiedPublisherOnly,omitempty"`
+	PermissionClassification                    *string   `json:"permissionClassification,omitempty"`
+	PermissionType                              *string   `json:"permissionType,omitempty"`
+	Permissions                                 *[]string `json:"permissions,omitempty"`
+	ResourceApplication                         *string   `json:"resourceApplication,omitempty"`
+}
+
+// PermissionGrantPolicyClient performs operations on PermissionGrantPolicies.
+type PermissionGrantPolicyClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewPermissionGrantPolicyClient returns a new PermissionGrantPolicyClient
+func NewPermissionGrantPolicyClient() *PermissionGrantPolicyClient {
+	return &PermissionGrantPolicyClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10),
+	}
+}
+
+// Create creates a new PermissionGrantPolicy. The ID of the policy must be specified.
+func (c *PermissionGrantPolicyClient) Create(ctx context.Context, policy PermissionGrantPolicy) (*PermissionGrantPolicy, int, error) {
+	var status int
+
+	if policy.ID == nil {
+		return nil, status, errors.New("PermissionGrantPolicyClient.Create(): cannot create policy with nil ID")
+	}
+
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity: "/policies/permissionGrantPolicies",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("PermissionGrantPolicyClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var newPolicy PermissionGrantPolicy
+	if err := json.Unmarshal(respBody, &newPolicy); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &newPolicy, status, nil
+}
+
+// Get retrieves a PermissionGrantPolicy.
+func (c *PermissionGrantPolicyClient) Get(ctx context.Context, id string, query odata.Query) (*PermissionGrantPolicy, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/policies/permissionGrantPolicies/%s", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("PermissionGrantPolicyClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var policy PermissionGrantPolicy
+	if err := json.Unmarshal(respBody, &policy); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &policy, status, nil
+}
+
+// Update amends the display name and description of an existing PermissionGrantPolicy. Condition sets must be
+// managed with AddConditionSet and RemoveConditionSet.
+func (c *PermissionGrantPolicyClient) Update(ctx context.Context, policy PermissionGrantPolicy) (int, error) {
+	var status int
+
+	if policy.ID == nil {
+		return status, errors.New("PermissionGrantPolicyClient.Update(): cannot update policy with nil ID")
+	}
+
+	body, err := json.Marshal(PermissionGrantPolicy{
+		Description: policy.Description,
+		DisplayName: policy.DisplayName,
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/policies/permissionGrantPolicies/%s", *policy.ID),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("PermissionGrantPolicyClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
+
+// Delete removes a PermissionGrantPolicy.
+func (c *PermissionGrantPolicyClient) Delete(ctx context.Context, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/policies/permissionGrantPolicies/%s", id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("PermissionGrantPolicyClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
+
+// ListConditionSets returns the condition sets for a PermissionGrantPolicy, where setType is one of `includes` or
+// `excludes`.
+func (c *PermissionGrantPolicyClient) ListConditionSets(ctx context.Context, policyId, setType string) (*[]PermissionGrantConditionSet, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/policies/permissionGrantPolicies/%s/%s", policyId, setType),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("PermissionGrantPolicyClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		ConditionSets []PermissionGrantConditionSet `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.ConditionSets, status, nil
+}
+
+// AddConditionSet adds a condition set to a PermissionGrantPolicy, where setType is one of `includes` or `excludes`.
+func (c *PermissionGrantPolicyClient) AddConditionSet(ctx context.Context, policyId, setType string, conditionSet PermissionGrantConditionSet) (*PermissionGrantConditionSet, int, error) {
+	var status int
+
+	body, err := json.Marshal(conditionSet)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/policies/permissionGrantPolicies/%s/%s", policyId, setType),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("PermissionGrantPolicyClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var newConditionSet PermissionGrantConditionSet
+	if err := json.Unmarshal(respBody, &newConditionSet); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &newConditionSet, status, nil
+}
+
+// RemoveConditionSet removes a condition set from a PermissionGrantPolicy, where setType is one of `includes` or
+// `excludes`.
+func (c *PermissionGrantPolicyClient) RemoveConditionSet(ctx context.Context, policyId, setType, conditionSetId string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/policies/permissionGrantPolicies/%s/%s/%s", policyId, setType, conditionSetId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("PermissionGrantPolicyClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// Collections of STS policies, which are also the names of the navigation properties used to assign them to service
+// principals
+const (
+	StsPolicyCollectionActivityBasedTimeout = "activityBasedTimeoutPolicies"
+	StsPolicyCollectionClaimsMapping        = "claimsMappingPolicies"
+	StsPolicyCollectionHomeRealmDiscovery   = "homeRealmDiscoveryPolicies"
+	StsPolicyCollectionTokenIssuance        = "tokenIssuancePolicies"
+	StsPolicyCollectionTokenLifetime        = "tokenLifetimePolicies"
+)
+
+// StsPolicy describes a policy which controls the behaviour of the security token service, such as a home realm
+// discovery policy or an activity-based timeout policy.
+type StsPolicy struct {
+	ID                    *string   `json:"id,omitempty"`
+	Definition            *[]string `json:"definition,omitempty"`
+	DisplayName           *string   `json:"displayName,omitempty"`
+	IsOrganizationDefault *bool     `json:"isOrganizationDefault,omitempty"`
+}
+
+// StsPolicyClient performs operations on a collection of StsPolicies.
+type StsPolicyClient struct {
+	BaseClient msgraph.Client
+	collection string
+}
+
+// NewActivityBasedTimeoutPolicyClient returns a new StsPolicyClient for activity-based timeout policies
+func NewActivityBasedTimeoutPolicyClient() *StsPolicyClient {
+	return &StsPolicyClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10),
+		collection: StsPolicyCollectionActivityBasedTimeout,
+	}
+}
+
+// NewHomeRealmDiscoveryPolicyClient returns a new StsPolicyClient for home realm discovery policies
+func NewHomeRealmDiscoveryPolicyClient() *StsPolicyClient {
+	return &StsPolicyClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10),
+		collection: StsPolicyCollectionHomeRealmDiscovery,
+	}
+}
+
+// Create creates a new StsPolicy.
+func (c *StsPolicyClient) Create(ctx context.Context, policy StsPolicy) (*StsPolicy, int, error) {
+	var status int
+
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/policies/%s", c.collection),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("StsPolicyClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var newPolicy StsPolicy
+	if err := json.Unmarshal(respBody, &newPolicy); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &newPolicy, status, nil
+}
+
+// Get retrieves an StsPolicy.
+func (c *StsPolicyClient) Get(ctx context.Context, id string, query odata.Query) (*StsPolicy, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/policies/%s/%s", c.collection, id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("StsPolicyClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var policy StsPolicy
+	if err := json.Unmarshal(respBody, &policy); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &policy, status, nil
+}
+
+// Update amends an existing StsPolicy.
+func (c *StsPolicyClient) Update(ctx context.Context, policy StsPolicy) (int, error) {
+	var status int
+
+	if policy.ID == nil {
+		return status, errors.New("StsPolicyClient.Update(): cannot update policy with nil ID")
+	}
+
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/policies/%s/%s", c.collection, *policy.ID),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("StsPolicyClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
+
+// Delete removes an StsPolicy.
+func (c *StsPolicyClient) Delete(ctx context.Context, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/policies/%s/%s", c.collection, id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("StsPolicyClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
+
+// ServicePrincipalPolicyClient performs operations on the StsPolicies assigned to service principals.
+type ServicePrincipalPolicyClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewServicePrincipalPolicyClient returns a new ServicePrincipalPolicyClient
+func NewServicePrincipalPolicyClient() *ServicePrincipalPolicyClient {
+	return &ServicePrincipalPolicyClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10),
+	}
+}
+
+// ListAssigned returns the StsPolicies in the specified collection which are assigned to a service principal.
+func (c *ServicePrincipalPolicyClient) ListAssigned(ctx context.Context, servicePrincipalId, collection string) (*[]StsPolicy, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/servicePrincipals/%s/%s", servicePrincipalId, collection),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ServicePrincipalPolicyClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		Policies []StsPolicy `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.Policies, status, nil
+}
+
+// Assign assigns an StsPolicy in the specified collection to a service principal.
+func (c *ServicePrincipalPolicyClient) Assign(ctx context.Context, servicePrincipalId, collection, policyId string) (int, error) {
+	var status int
+
+	body, err := json.Marshal(msgraph.DirectoryObject{
+		ODataId: (*odata.Id)(pointer.To(fmt.Sprintf("%s/%s/policies/%s/%s", c.BaseClient.Endpoint, c.BaseClient.ApiVersion, collection, policyId))),
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/servicePrincipals/%s/%s/$ref", servicePrincipalId, collection),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ServicePrincipalPolicyClient.BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
+
+// Unassign removes an StsPolicy in the specified collection from a service principal.
+func (c *ServicePrincipalPolicyClient) Unassign(ctx context.Context, servicePrincipalId, collection, policyId string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/servicePrincipals/%s/%s/%s/$ref", servicePrincipalId, collection, policyId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ServicePrincipalPolicyClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
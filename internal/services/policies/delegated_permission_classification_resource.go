@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	policiesClient "github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+func delegatedPermissionClassificationResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: delegatedPermissionClassificationResourceCreate,
+		ReadContext:   delegatedPermissionClassificationResourceRead,
+		DeleteContext: delegatedPermissionClassificationResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ParseDelegatedPermissionClassificationID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"service_principal_id": {
+				Description:      "The object ID of the service principal exposing the delegated permission",
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.IsUUID),
+			},
+
+			"claim_value": {
+				Description:      "The claim value of the delegated permission to classify, e.g. `openid`",
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotWhiteSpace),
+			},
+
+			"classification": {
+				Description: "The classification of the delegated permission",
+				Type:        pluginsdk.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice([]string{
+					policiesClient.DelegatedPermissionClassificationHigh,
+					policiesClient.DelegatedPermissionClassificationLow,
+					policiesClient.DelegatedPermissionClassificationMedium,
+				}, false)),
+			},
+
+			"permission_id": {
+				Description: "The ID of the delegated permission",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func delegatedPermissionClassificationResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.DelegatedPermissionClassificationClient
+
+	servicePrincipalId := d.Get("service_principal_id").(string)
+	claimValue := d.Get("claim_value").(string)
+
+	existing, status, err := client.List(ctx, servicePrincipalId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(err, "service_principal_id", "Service principal not found (Object ID: %q)", servicePrincipalId)
+		}
+		return tf.ErrorDiagF(err, "Listing delegated permission classifications for service principal %q", servicePrincipalId)
+	}
+	if existing != nil {
+		for _, classification := range *existing {
+			if classification.ID != nil && classification.PermissionName != nil && strings.EqualFold(*classification.PermissionName, claimValue) {
+				return tf.ImportAsExistsDiag("azuread_delegated_permission_classification", parse.NewDelegatedPermissionClassificationID(servicePrincipalId, *classification.ID).ID())
+			}
+		}
+	}
+
+	properties := policiesClient.DelegatedPermissionClassification{
+		Classification: pointer.To(d.Get("classification").(string)),
+		PermissionName: pointer.To(claimValue),
+	}
+
+	classification, _, err := client.Create(ctx, servicePrincipalId, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not classify delegated permission %q for service principal %q", claimValue, servicePrincipalId)
+	}
+
+	if classification == nil || classification.ID == nil || *classification.ID == "" {
+		return tf.ErrorDiagF(errors.New("ID returned for delegated permission classification is nil"), "Bad API response")
+	}
+
+	d.SetId(parse.NewDelegatedPermissionClassificationID(servicePrincipalId, *classification.ID).ID())
+
+	return delegatedPermissionClassificationResourceRead(ctx, d, meta)
+}
+
+func delegatedPermissionClassificationResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.DelegatedPermissionClassificationClient
+
+	id, err := parse.ParseDelegatedPermissionClassificationID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing delegated permission classification ID %q", d.Id())
+	}
+
+	classifications, status, err := client.List(ctx, id.ServicePrincipalId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Service Principal with Object ID %q was not found - removing delegated permission classification from state!", id.ServicePrincipalId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Listing delegated permission classifications for service principal %q", id.ServicePrincipalId)
+	}
+
+	var classification *policiesClient.DelegatedPermissionClassification
+	if classifications != nil {
+		for i, c := range *classifications {
+			if c.ID != nil && *c.ID == id.ClassificationId {
+				classification = &(*classifications)[i]
+				break
+			}
+		}
+	}
+	if classification == nil {
+		log.Printf("[DEBUG] Delegated permission classification %q was not found for service principal %q - removing from state!", id.ClassificationId, id.ServicePrincipalId)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "claim_value", classification.PermissionName)
+	tf.Set(d, "classification", classification.Classification)
+	tf.Set(d, "permission_id", classification.PermissionId)
+	tf.Set(d, "service_principal_id", id.ServicePrincipalId)
+
+	return nil
+}
+
+func delegatedPermissionClassificationResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.DelegatedPermissionClassificationClient
+
+	id, err := parse.ParseDelegatedPermissionClassificationID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing delegated permission classification ID %q", d.Id())
+	}
+
+	if status, err := client.Delete(ctx, id.ServicePrincipalId, id.ClassificationId); err != nil {
+		if status == http.StatusNotFound {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Could not remove delegated permission classification %q from service principal %q", id.ClassificationId, id.ServicePrincipalId)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/parse"
+)
+
+type DelegatedPermissionClassificationResource struct{}
+
+func TestAccDelegatedPermissionClassification_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_delegated_permission_classification", "test")
+	r := DelegatedPermissionClassificationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("permission_id").IsUuid(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDelegatedPermissionClassification_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_delegated_permission_classification", "test")
+	r := DelegatedPermissionClassificationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r DelegatedPermissionClassificationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.DelegatedPermissionClassificationClient
+	client.BaseClient.DisableRetries = true
+	defer func() { client.BaseClient.DisableRetries = false }()
+
+	id, err := parse.ParseDelegatedPermissionClassificationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	classifications, status, err := client.List(ctx, id.ServicePrincipalId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to list delegated permission classifications for service principal %q: %+v", id.ServicePrincipalId, err)
+	}
+
+	if classifications != nil {
+		for _, classification := range *classifications {
+			if classification.ID != nil && *classification.ID == id.ClassificationId {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (DelegatedPermissionClassificationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-DelegatedPermissionClassification-%[1]d"
+
+  api {
+    oauth2_permission_scope {
+      admin_consent_description  = "Read the user profile"
+      admin_consent_display_name = "Read profile"
+      enabled                    = true
+      id                         = "%[2]s"
+      type                       = "User"
+      value                      = "profile.read"
+    }
+  }
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+}
+
+resource "azuread_delegated_permission_classification" "test" {
+  service_principal_id = azuread_service_principal.test.object_id
+  claim_value          = "profile.read"
+  classification       = "low"
+}
+`, data.RandomInteger, data.UUID())
+}
+
+func (r DelegatedPermissionClassificationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_delegated_permission_classification" "import" {
+  service_principal_id = azuread_delegated_permission_classification.test.service_principal_id
+  claim_value          = azuread_delegated_permission_classification.test.claim_value
+  classification       = azuread_delegated_permission_classification.test.classification
+}
+`, r.basic(data))
+}
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	policiesClient "github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+func homeRealmDiscoveryPolicyResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: homeRealmDiscoveryPolicyResourceCreate,
+		ReadContext:   homeRealmDiscoveryPolicyResourceRead,
+		UpdateContext: homeRealmDiscoveryPolicyResourceUpdate,
+		DeleteContext: homeRealmDiscoveryPolicyResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"display_name": {
+				Description:      "The display name for the policy",
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotWhiteSpace),
+			},
+
+			"definition": {
+				Description: "The settings for the policy",
+				Type:        pluginsdk.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"accelerate_to_federated_domain": {
+							Description: "Whether users are sent directly to a federated identity provider, bypassing the username entry page",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+
+						"allow_cloud_password_validation": {
+							Description: "Whether users in a federated domain can sign in with a password synchronized to the directory",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+
+						"alternate_id_login_enabled": {
+							Description: "Whether users can sign in with an alternate login ID, such as their email address",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+
+						"preferred_domain": {
+							Description:      "The federated domain to which users are accelerated, when the tenant has more than one federated domain",
+							Type:             pluginsdk.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotWhiteSpace),
+						},
+					},
+				},
+			},
+
+			"organization_default": {
+				Description: "Whether the policy applies to all service principals in the tenant which do not have a policy assigned",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func homeRealmDiscoveryPolicyResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.HomeRealmDiscoveryPolicyClient
+
+	definition, err := stsPolicyEncodeDefinition(expandHomeRealmDiscoveryPolicyDefinition(d.Get("definition").([]interface{})))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "definition", "Could not encode definition for home realm discovery policy")
+	}
+
+	properties := policiesClient.StsPolicy{
+		Definition:            definition,
+		DisplayName:           pointer.To(d.Get("display_name").(string)),
+		IsOrganizationDefault: pointer.To(d.Get("organization_default").(bool)),
+	}
+
+	policy, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not create home realm discovery policy")
+	}
+
+	if policy == nil || policy.ID == nil || *policy.ID == "" {
+		return tf.ErrorDiagF(errors.New("Object ID returned for home realm discovery policy is nil"), "Bad API response")
+	}
+
+	d.SetId(*policy.ID)
+
+	return homeRealmDiscoveryPolicyResourceRead(ctx, d, meta)
+}
+
+func homeRealmDiscoveryPolicyResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.HomeRealmDiscoveryPolicyClient
+
+	definition, err := stsPolicyEncodeDefinition(expandHomeRealmDiscoveryPolicyDefinition(d.Get("definition").([]interface{})))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "definition", "Could not encode definition for home realm discovery policy")
+	}
+
+	properties := policiesClient.StsPolicy{
+		ID:                    pointer.To(d.Id()),
+		Definition:            definition,
+		DisplayName:           pointer.To(d.Get("display_name").(string)),
+		IsOrganizationDefault: pointer.To(d.Get("organization_default").(bool)),
+	}
+
+	if _, err := client.Update(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Could not update home realm discovery policy with object ID %q", d.Id())
+	}
+
+	return homeRealmDiscoveryPolicyResourceRead(ctx, d, meta)
+}
+
+func homeRealmDiscoveryPolicyResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.HomeRealmDiscoveryPolicyClient
+
+	policy, status, err := client.Get(ctx, d.Id(), odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Home Realm Discovery Policy with Object ID %q was not found - removing from state!", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return tf.ErrorDiagF(err, "Retrieving home realm discovery policy with object ID %q", d.Id())
+	}
+	if policy == nil {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Result is nil")
+	}
+
+	var definition homeRealmDiscoveryPolicyDefinition
+	if err := stsPolicyDecodeDefinition(policy.Definition, &definition); err != nil {
+		return tf.ErrorDiagPathF(err, "definition", "Could not decode definition for home realm discovery policy with object ID %q", d.Id())
+	}
+
+	tf.Set(d, "definition", flattenHomeRealmDiscoveryPolicyDefinition(definition))
+	tf.Set(d, "display_name", policy.DisplayName)
+	tf.Set(d, "organization_default", pointer.From(policy.IsOrganizationDefault))
+
+	return nil
+}
+
+func homeRealmDiscoveryPolicyResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.HomeRealmDiscoveryPolicyClient
+
+	if status, err := client.Delete(ctx, d.Id()); err != nil {
+		if status == http.StatusNotFound {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Deleting home realm discovery policy with object ID %q, received status %d", d.Id(), status)
+	}
+
+	return nil
+}
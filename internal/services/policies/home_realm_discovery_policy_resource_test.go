@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type HomeRealmDiscoveryPolicyResource struct{}
+
+func TestAccHomeRealmDiscoveryPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_home_realm_discovery_policy", "test")
+	r := HomeRealmDiscoveryPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("definition.0.accelerate_to_federated_domain").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccHomeRealmDiscoveryPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_home_realm_discovery_policy", "test")
+	r := HomeRealmDiscoveryPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("definition.0.alternate_id_login_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("definition.0.preferred_domain").HasValue("federated.example.com"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r HomeRealmDiscoveryPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.HomeRealmDiscoveryPolicyClient
+	client.BaseClient.DisableRetries = true
+	defer func() { client.BaseClient.DisableRetries = false }()
+
+	exists := false
+	_, status, err := client.Get(ctx, state.ID, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Home realm discovery policy with object ID %q does not exist", state.ID)
+		}
+		return &exists, fmt.Errorf("failed to retrieve home realm discovery policy with object ID %q: %+v", state.ID, err)
+	}
+
+	exists = true
+	return &exists, nil
+}
+
+func (HomeRealmDiscoveryPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_home_realm_discovery_policy" "test" {
+  display_name = "acctest-HRDPolicy-%[1]d"
+
+  definition {
+    accelerate_to_federated_domain = true
+  }
+}
+`, data.RandomInteger)
+}
+
+func (HomeRealmDiscoveryPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_home_realm_discovery_policy" "test" {
+  display_name = "acctest-HRDPolicy-%[1]d-updated"
+
+  definition {
+    accelerate_to_federated_domain  = true
+    allow_cloud_password_validation = true
+    alternate_id_login_enabled      = true
+    preferred_domain                = "federated.example.com"
+  }
+}
+`, data.RandomInteger)
+}
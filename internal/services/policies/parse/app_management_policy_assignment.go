@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+const (
+	AppManagementPolicyAssignmentTargetApplications      = "applications"
+	AppManagementPolicyAssignmentTargetServicePrincipals = "servicePrincipals"
+)
+
+type AppManagementPolicyAssignmentId struct {
+	Target   string
+	ObjectId string
+	PolicyId string
+}
+
+func NewAppManagementPolicyAssignmentID(target, objectId, policyId string) *AppManagementPolicyAssignmentId {
+	return &AppManagementPolicyAssignmentId{
+		Target:   target,
+		ObjectId: objectId,
+		PolicyId: policyId,
+	}
+}
+
+// ParseAppManagementPolicyAssignmentID parses an ID in the format `/{target}/{objectId}/appManagementPolicies/{policyId}`,
+// where target is either `applications` or `servicePrincipals`
+func ParseAppManagementPolicyAssignmentID(input string) (*AppManagementPolicyAssignmentId, error) {
+	parts := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if len(parts) != 4 || parts[2] != "appManagementPolicies" {
+		return nil, fmt.Errorf("parsing AppManagementPolicyAssignmentId: invalid format, expected `/{applications|servicePrincipals}/{objectId}/appManagementPolicies/{policyId}`")
+	}
+
+	id := AppManagementPolicyAssignmentId{
+		Target:   parts[0],
+		ObjectId: parts[1],
+		PolicyId: parts[3],
+	}
+
+	switch id.Target {
+	case AppManagementPolicyAssignmentTargetApplications, AppManagementPolicyAssignmentTargetServicePrincipals:
+	default:
+		return nil, fmt.Errorf("parsing AppManagementPolicyAssignmentId: invalid target %q", id.Target)
+	}
+
+	if _, err := validation.IsUUID(id.ObjectId, "ObjectId"); len(err) > 0 {
+		return nil, fmt.Errorf("parsing AppManagementPolicyAssignmentId: %+v", err)
+	}
+
+	if _, err := validation.IsUUID(id.PolicyId, "PolicyId"); len(err) > 0 {
+		return nil, fmt.Errorf("parsing AppManagementPolicyAssignmentId: %+v", err)
+	}
+
+	return &id, nil
+}
+
+func (id *AppManagementPolicyAssignmentId) ID() string {
+	return fmt.Sprintf("/%s/%s/appManagementPolicies/%s", id.Target, id.ObjectId, id.PolicyId)
+}
+
+func (id *AppManagementPolicyAssignmentId) String() string {
+	return fmt.Sprintf("App Management Policy Assignment ID: %s", id.ID())
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+type DelegatedPermissionClassificationId struct {
+	ServicePrincipalId string
+	ClassificationId   string
+}
+
+func NewDelegatedPermissionClassificationID(servicePrincipalId, classificationId string) *DelegatedPermissionClassificationId {
+	return &DelegatedPermissionClassificationId{
+		ServicePrincipalId: servicePrincipalId,
+		ClassificationId:   classificationId,
+	}
+}
+
+// ParseDelegatedPermissionClassificationID parses an ID in the format
+// `/servicePrincipals/{servicePrincipalId}/delegatedPermissionClassifications/{classificationId}`
+func ParseDelegatedPermissionClassificationID(input string) (*DelegatedPermissionClassificationId, error) {
+	parts := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if len(parts) != 4 || parts[0] != "servicePrincipals" || parts[2] != "delegatedPermissionClassifications" || parts[3] == "" {
+		return nil, fmt.Errorf("parsing DelegatedPermissionClassificationId: invalid format, expected `/servicePrincipals/{servicePrincipalId}/delegatedPermissionClassifications/{classificationId}`")
+	}
+
+	id := DelegatedPermissionClassificationId{
+		ServicePrincipalId: parts[1],
+		ClassificationId:   parts[3],
+	}
+
+	if _, err := validation.IsUUID(id.ServicePrincipalId, "ServicePrincipalId"); len(err) > 0 {
+		return nil, fmt.Errorf("parsing DelegatedPermissionClassificationId: %+v", err)
+	}
+
+	return &id, nil
+}
+
+func (id *DelegatedPermissionClassificationId) ID() string {
+	return fmt.Sprintf("/servicePrincipals/%s/delegatedPermissionClassifications/%s", id.ServicePrincipalId, id.ClassificationId)
+}
+
+func (id *DelegatedPermissionClassificationId) String() string {
+	return fmt.Sprintf("Delegated Permission Classification ID: %s", id.ID())
+}
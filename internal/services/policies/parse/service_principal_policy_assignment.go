@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+// servicePrincipalPolicyAssignmentCollections are the policy collections which can be assigned to a service principal
+var servicePrincipalPolicyAssignmentCollections = []string{
+	"claimsMappingPolicies",
+	"homeRealmDiscoveryPolicies",
+	"tokenIssuancePolicies",
+	"tokenLifetimePolicies",
+}
+
+type ServicePrincipalPolicyAssignmentId struct {
+	ServicePrincipalId string
+	Collection         string
+	PolicyId           string
+}
+
+func NewServicePrincipalPolicyAssignmentID(servicePrincipalId, collection, policyId string) *ServicePrincipalPolicyAssignmentId {
+	return &ServicePrincipalPolicyAssignmentId{
+		ServicePrincipalId: servicePrincipalId,
+		Collection:         collection,
+		PolicyId:           policyId,
+	}
+}
+
+// ParseServicePrincipalPolicyAssignmentID parses an ID in the format `/servicePrincipals/{servicePrincipalId}/{collection}/{policyId}`,
+// where collection is the navigation property of the assigned policy type, e.g. `homeRealmDiscoveryPolicies`
+func ParseServicePrincipalPolicyAssignmentID(input string) (*ServicePrincipalPolicyAssignmentId, error) {
+	parts := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if len(parts) != 4 || parts[0] != "servicePrincipals" {
+		return nil, fmt.Errorf("parsing ServicePrincipalPolicyAssignmentId: invalid format, expected `/servicePrincipals/{servicePrincipalId}/{collection}/{policyId}`")
+	}
+
+	id := ServicePrincipalPolicyAssignmentId{
+		ServicePrincipalId: parts[1],
+		Collection:         parts[2],
+		PolicyId:           parts[3],
+	}
+
+	valid := false
+	for _, collection := range servicePrincipalPolicyAssignmentCollections {
+		if id.Collection == collection {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return nil, fmt.Errorf("parsing ServicePrincipalPolicyAssignmentId: invalid collection %q, expected one of %s", id.Collection, strings.Join(servicePrincipalPolicyAssignmentCollections, ", "))
+	}
+
+	if _, err := validation.IsUUID(id.ServicePrincipalId, "ServicePrincipalId"); len(err) > 0 {
+		return nil, fmt.Errorf("parsing ServicePrincipalPolicyAssignmentId: %+v", err)
+	}
+
+	if _, err := validation.IsUUID(id.PolicyId, "PolicyId"); len(err) > 0 {
+		return nil, fmt.Errorf("parsing ServicePrincipalPolicyAssignmentId: %+v", err)
+	}
+
+	return &id, nil
+}
+
+func (id *ServicePrincipalPolicyAssignmentId) ID() string {
+	return fmt.Sprintf("/servicePrincipals/%s/%s/%s", id.ServicePrincipalId, id.Collection, id.PolicyId)
+}
+
+func (id *ServicePrincipalPolicyAssignmentId) String() string {
+	return fmt.Sprintf("Service Principal Policy Assignment ID: %s", id.ID())
+}
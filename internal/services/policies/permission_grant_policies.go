@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	policiesClient "github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+// permissionGrantPolicyUserConsentPrefix is prepended to the ID of a permission grant policy when it is assigned in the
+// authorization policy, to allow users to consent to apps on their own behalf subject to the policy
+const permissionGrantPolicyUserConsentPrefix = "ManagePermissionGrantsForSelf."
+
+// permissionGrantPolicyIdRegex matches the IDs which can be specified for a permission grant policy
+var permissionGrantPolicyIdRegex = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+func permissionGrantConditionSetSchema(description string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description: description,
+		Type:        pluginsdk.TypeSet,
+		Optional:    true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"permission_type": {
+					Description: "The type of permission being granted",
+					Type:        pluginsdk.TypeString,
+					Required:    true,
+					ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice([]string{
+						policiesClient.PermissionGrantPermissionTypeApplication,
+						policiesClient.PermissionGrantPermissionTypeDelegated,
+						policiesClient.PermissionGrantPermissionTypeDelegatedUserConsentable,
+					}, false)),
+				},
+
+				"certified_client_applications_only": {
+					Description: "Whether to only match client applications which are Microsoft 365 certified",
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
+
+				"client_application_ids": {
+					Description: "The client IDs of the client applications to match. Defaults to all client applications",
+					Type:        pluginsdk.TypeSet,
+					Optional:    true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.IsUUID,
+					},
+				},
+
+				"client_application_publisher_ids": {
+					Description: "The Microsoft Partner Network (MPN) IDs of the verified publishers of the client applications to match. Defaults to all publishers",
+					Type:        pluginsdk.TypeSet,
+					Optional:    true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+				},
+
+				"client_application_tenant_ids": {
+					Description: "The IDs of the tenants in which the client applications are registered. Defaults to all tenants",
+					Type:        pluginsdk.TypeSet,
+					Optional:    true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.IsUUID,
+					},
+				},
+
+				"client_applications_from_verified_publisher_only": {
+					Description: "Whether to only match client applications with a verified publisher",
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
+
+				"permission_classification": {
+					Description: "The classification of the permissions to match",
+					Type:        pluginsdk.TypeString,
+					Optional:    true,
+					Default:     policiesClient.PermissionGrantPermissionClassificationAll,
+					ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice([]string{
+						policiesClient.PermissionGrantPermissionClassificationAll,
+						policiesClient.PermissionGrantPermissionClassificationHigh,
+						policiesClient.PermissionGrantPermissionClassificationLow,
+						policiesClient.PermissionGrantPermissionClassificationMedium,
+					}, false)),
+				},
+
+				"permissions": {
+					Description: "The IDs of the app roles or delegated permissions to match. Defaults to all permissions",
+					Type:        pluginsdk.TypeSet,
+					Optional:    true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.IsUUID,
+					},
+				},
+
+				"resource_application": {
+					Description:      "The client ID of the resource application whose permissions are being granted, or `any` to match any resource application",
+					Type:             pluginsdk.TypeString,
+					Optional:         true,
+					Default:          policiesClient.PermissionGrantConditionSetAnyResourceApplication,
+					ValidateDiagFunc: validation.ValidateDiag(permissionGrantConditionSetValidateResourceApplication),
+				},
+			},
+		},
+	}
+}
+
+func permissionGrantConditionSetValidateResourceApplication(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == policiesClient.PermissionGrantConditionSetAnyResourceApplication {
+		return
+	}
+	return validation.IsUUID(i, k)
+}
+
+// permissionGrantPolicyReplaceConditionSets removes all existing condition sets of the given type from a permission
+// grant policy and adds the specified condition sets. Condition sets cannot be updated in place.
+func permissionGrantPolicyReplaceConditionSets(ctx context.Context, client *policiesClient.PermissionGrantPolicyClient, policyId, setType string, in []interface{}) error {
+	existing, _, err := client.ListConditionSets(ctx, policyId, setType)
+	if err != nil {
+		return fmt.Errorf("listing %s condition sets: %v", setType, err)
+	}
+
+	existingSets := make([]policiesClient.PermissionGrantConditionSet, 0)
+	if existing != nil {
+		existingSets = *existing
+	}
+
+	// Add any new condition sets before removing those which are no longer required, so that the policy is not left
+	// without its conditions in the meantime, or should a request fail
+	retained := make(map[string]bool)
+	for _, conditionSet := range expandPermissionGrantConditionSets(in) {
+		found := false
+		for _, existingSet := range existingSets {
+			if existingSet.ID != nil && !retained[*existingSet.ID] && permissionGrantConditionSetsMatch(existingSet, conditionSet) {
+				retained[*existingSet.ID] = true
+				found = true
+				break
+			}
+		}
+		if found {
+			continue
+		}
+
+		if _, _, err := client.AddConditionSet(ctx, policyId, setType, conditionSet); err != nil {
+			return fmt.Errorf("adding %s condition set: %v", setType, err)
+		}
+	}
+
+	for _, conditionSet := range existingSets {
+		if conditionSet.ID == nil || retained[*conditionSet.ID] {
+			continue
+		}
+		if _, err := client.RemoveConditionSet(ctx, policyId, setType, *conditionSet.ID); err != nil {
+			return fmt.Errorf("removing %s condition set %q: %v", setType, *conditionSet.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// permissionGrantConditionSetsMatch returns whether two condition sets have the same conditions, disregarding their IDs
+func permissionGrantConditionSetsMatch(a, b policiesClient.PermissionGrantConditionSet) bool {
+	stringsMatch := func(a, b *[]string) bool {
+		x, y := pointer.From(a), pointer.From(b)
+		if len(x) != len(y) {
+			return false
+		}
+		for _, v := range x {
+			found := false
+			for _, w := range y {
+				if strings.EqualFold(v, w) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+
+	return pointer.From(a.CertifiedClientApplicationsOnly) == pointer.From(b.CertifiedClientApplicationsOnly) &&
+		stringsMatch(a.ClientApplicationIds, b.ClientApplicationIds) &&
+		stringsMatch(a.ClientApplicationPublisherIds, b.ClientApplicationPublisherIds) &&
+		stringsMatch(a.ClientApplicationTenantIds, b.ClientApplicationTenantIds) &&
+		pointer.From(a.ClientApplicationsFromVerifiedPublisherOnly) == pointer.From(b.ClientApplicationsFromVerifiedPublisherOnly) &&
+		strings.EqualFold(pointer.From(a.PermissionClassification), pointer.From(b.PermissionClassification)) &&
+		strings.EqualFold(pointer.From(a.PermissionType), pointer.From(b.PermissionType)) &&
+		stringsMatch(a.Permissions, b.Permissions) &&
+		strings.EqualFold(pointer.From(a.ResourceApplication), pointer.From(b.ResourceApplication))
+}
+
+func expandPermissionGrantConditionSets(in []interface{}) []policiesClient.PermissionGrantConditionSet {
+	result := make([]policiesClient.PermissionGrantConditionSet, 0)
+
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+
+		result = append(result, policiesClient.PermissionGrantConditionSet{
+			CertifiedClientApplicationsOnly:             pointer.To(v["certified_client_applications_only"].(bool)),
+			ClientApplicationIds:                        expandPermissionGrantConditionSetValues(v["client_application_ids"].(*pluginsdk.Set).List()),
+			ClientApplicationPublisherIds:               expandPermissionGrantConditionSetValues(v["client_application_publisher_ids"].(*pluginsdk.Set).List()),
+			ClientApplicationTenantIds:                  expandPermissionGrantConditionSetValues(v["client_application_tenant_ids"].(*pluginsdk.Set).List()),
+			ClientApplicationsFromVerifiedPublisherOnly: pointer.To(v["client_applications_from_verified_publisher_only"].(bool)),
+			PermissionClassification:                    pointer.To(v["permission_classification"].(string)),
+			PermissionType:                              pointer.To(v["permission_type"].(string)),
+			Permissions:                                 expandPermissionGrantConditionSetValues(v["permissions"].(*pluginsdk.Set).List()),
+			ResourceApplication:                         pointer.To(v["resource_application"].(string)),
+		})
+	}
+
+	return result
+}
+
+// expandPermissionGrantConditionSetValues returns the special value `all` when no values are specified
+func expandPermissionGrantConditionSetValues(in []interface{}) *[]string {
+	if len(in) == 0 {
+		return &[]string{policiesClient.PermissionGrantConditionSetAll}
+	}
+	return tf.ExpandStringSlicePtr(in)
+}
+
+func flattenPermissionGrantConditionSets(in *[]policiesClient.PermissionGrantConditionSet) []interface{} {
+	result := make([]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	for _, conditionSet := range *in {
+		resourceApplication := pointer.From(conditionSet.ResourceApplication)
+		if resourceApplication == "" {
+			resourceApplication = policiesClient.PermissionGrantConditionSetAnyResourceApplication
+		}
+
+		permissionClassification := pointer.From(conditionSet.PermissionClassification)
+		if permissionClassification == "" {
+			permissionClassification = policiesClient.PermissionGrantPermissionClassificationAll
+		}
+
+		result = append(result, map[string]interface{}{
+			"certified_client_applications_only":               pointer.From(conditionSet.CertifiedClientApplicationsOnly),
+			"client_application_ids":                           flattenPermissionGrantConditionSetValues(conditionSet.ClientApplicationIds),
+			"client_application_publisher_ids":                 flattenPermissionGrantConditionSetValues(conditionSet.ClientApplicationPublisherIds),
+			"client_application_tenant_ids":                    flattenPermissionGrantConditionSetValues(conditionSet.ClientApplicationTenantIds),
+			"client_applications_from_verified_publisher_only": pointer.From(conditionSet.ClientApplicationsFromVerifiedPublisherOnly),
+			"permission_classification":                        permissionClassification,
+			"permission_type":                                  pointer.From(conditionSet.PermissionType),
+			"permissions":                                      flattenPermissionGrantConditionSetValues(conditionSet.Permissions),
+			"resource_application":                             resourceApplication,
+		})
+	}
+
+	return result
+}
+
+// flattenPermissionGrantConditionSetValues omits the special value `all`, which is the default when no values are
+// specified
+func flattenPermissionGrantConditionSetValues(in *[]string) []interface{} {
+	result := make([]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	for _, v := range *in {
+		if v == policiesClient.PermissionGrantConditionSetAll {
+			continue
+		}
+		result = append(result, v)
+	}
+
+	return result
+}
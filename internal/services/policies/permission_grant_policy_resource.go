@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	policiesClient "github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+func permissionGrantPolicyResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: permissionGrantPolicyResourceCreate,
+		ReadContext:   permissionGrantPolicyResourceRead,
+		UpdateContext: permissionGrantPolicyResourceUpdate,
+		DeleteContext: permissionGrantPolicyResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if !permissionGrantPolicyIdRegex.MatchString(id) {
+				return fmt.Errorf("specified ID (%q) is not valid", id)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"policy_id": {
+				Description:      "The unique identifier for the policy",
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringMatch(permissionGrantPolicyIdRegex, "may only contain letters, numbers, hyphens, underscores and periods")),
+			},
+
+			"display_name": {
+				Description:      "The display name for the policy",
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotWhiteSpace),
+			},
+
+			"description": {
+				Description:      "The description for the policy",
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotWhiteSpace),
+			},
+
+			"include": permissionGrantConditionSetSchema("Condition sets describing the permission grants which are included by this policy"),
+
+			"exclude": permissionGrantConditionSetSchema("Condition sets describing the permission grants which are excluded by this policy, taking precedence over included condition sets"),
+
+			"user_consent_policy_id": {
+				Description: "The value to specify in `permission_grant_policies_assigned` for the authorization policy, to allow users to consent to apps on their own behalf subject to this policy",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func permissionGrantPolicyResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.PermissionGrantPolicyClient
+
+	properties := policiesClient.PermissionGrantPolicy{
+		ID:          pointer.To(d.Get("policy_id").(string)),
+		Description: pointer.To(d.Get("description").(string)),
+		DisplayName: pointer.To(d.Get("display_name").(string)),
+	}
+
+	policy, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not create permission grant policy")
+	}
+
+	if policy == nil || policy.ID == nil || *policy.ID == "" {
+		return tf.ErrorDiagF(errors.New("ID returned for permission grant policy is nil"), "Bad API response")
+	}
+
+	d.SetId(*policy.ID)
+
+	if err := permissionGrantPolicyReplaceConditionSets(ctx, client, d.Id(), policiesClient.PermissionGrantConditionSetTypeIncludes, d.Get("include").(*pluginsdk.Set).List()); err != nil {
+		return tf.ErrorDiagPathF(err, "include", "Could not set included condition sets for permission grant policy with ID %q", d.Id())
+	}
+	if err := permissionGrantPolicyReplaceConditionSets(ctx, client, d.Id(), policiesClient.PermissionGrantConditionSetTypeExcludes, d.Get("exclude").(*pluginsdk.Set).List()); err != nil {
+		return tf.ErrorDiagPathF(err, "exclude", "Could not set excluded condition sets for permission grant policy with ID %q", d.Id())
+	}
+
+	return permissionGrantPolicyResourceRead(ctx, d, meta)
+}
+
+func permissionGrantPolicyResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.PermissionGrantPolicyClient
+
+	if d.HasChanges("description", "display_name") {
+		properties := policiesClient.PermissionGrantPolicy{
+			ID:          pointer.To(d.Id()),
+			Description: pointer.To(d.Get("description").(string)),
+			DisplayName: pointer.To(d.Get("display_name").(string)),
+		}
+
+		if _, err := client.Update(ctx, properties); err != nil {
+			return tf.ErrorDiagF(err, "Could not update permission grant policy with ID %q", d.Id())
+		}
+	}
+
+	if d.HasChange("include") {
+		if err := permissionGrantPolicyReplaceConditionSets(ctx, client, d.Id(), policiesClient.PermissionGrantConditionSetTypeIncludes, d.Get("include").(*pluginsdk.Set).List()); err != nil {
+			return tf.ErrorDiagPathF(err, "include", "Could not update included condition sets for permission grant policy with ID %q", d.Id())
+		}
+	}
+
+	if d.HasChange("exclude") {
+		if err := permissionGrantPolicyReplaceConditionSets(ctx, client, d.Id(), policiesClient.PermissionGrantConditionSetTypeExcludes, d.Get("exclude").(*pluginsdk.Set).List()); err != nil {
+			return tf.ErrorDiagPathF(err, "exclude", "Could not update excluded condition sets for permission grant policy with ID %q", d.Id())
+		}
+	}
+
+	return permissionGrantPolicyResourceRead(ctx, d, meta)
+}
+
+func permissionGrantPolicyResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.PermissionGrantPolicyClient
+
+	policy, status, err := client.Get(ctx, d.Id(), odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Permission Grant Policy with ID %q was not found - removing from state!", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return tf.ErrorDiagF(err, "Retrieving permission grant policy with ID %q", d.Id())
+	}
+	if policy == nil {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Result is nil")
+	}
+
+	includes, _, err := client.ListConditionSets(ctx, d.Id(), policiesClient.PermissionGrantConditionSetTypeIncludes)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving included condition sets for permission grant policy with ID %q", d.Id())
+	}
+
+	excludes, _, err := client.ListConditionSets(ctx, d.Id(), policiesClient.PermissionGrantConditionSetTypeExcludes)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving excluded condition sets for permission grant policy with ID %q", d.Id())
+	}
+
+	tf.Set(d, "description", policy.Description)
+	tf.Set(d, "display_name", policy.DisplayName)
+	tf.Set(d, "exclude", flattenPermissionGrantConditionSets(excludes))
+	tf.Set(d, "include", flattenPermissionGrantConditionSets(includes))
+	tf.Set(d, "policy_id", d.Id())
+	tf.Set(d, "user_consent_policy_id", permissionGrantPolicyUserConsentPrefix+d.Id())
+
+	return nil
+}
+
+func permissionGrantPolicyResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.PermissionGrantPolicyClient
+
+	if status, err := client.Delete(ctx, d.Id()); err != nil {
+		if status == http.StatusNotFound {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Deleting permission grant policy with ID %q, received status %d", d.Id(), status)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type PermissionGrantPolicyResource struct{}
+
+func TestAccPermissionGrantPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_permission_grant_policy", "test")
+	r := PermissionGrantPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("include.#").HasValue("1"),
+				check.That(data.ResourceName).Key("user_consent_policy_id").HasValue(fmt.Sprintf("ManagePermissionGrantsForSelf.acctest-permission-grant-policy-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPermissionGrantPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_permission_grant_policy", "test")
+	r := PermissionGrantPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("exclude.#").HasValue("1"),
+				check.That(data.ResourceName).Key("include.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("exclude.#").HasValue("0"),
+				check.That(data.ResourceName).Key("include.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r PermissionGrantPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.PermissionGrantPolicyClient
+	client.BaseClient.DisableRetries = true
+	defer func() { client.BaseClient.DisableRetries = false }()
+
+	exists := false
+	_, status, err := client.Get(ctx, state.ID, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Permission grant policy with ID %q does not exist", state.ID)
+		}
+		return &exists, fmt.Errorf("failed to retrieve permission grant policy with ID %q: %+v", state.ID, err)
+	}
+
+	exists = true
+	return &exists, nil
+}
+
+func (PermissionGrantPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_permission_grant_policy" "test" {
+  policy_id    = "acctest-permission-grant-policy-%[1]d"
+  display_name = "acctest-PermissionGrantPolicy-%[1]d"
+  description  = "Acceptance test policy"
+
+  include {
+    permission_type                                  = "delegated"
+    permission_classification                        = "low"
+    client_applications_from_verified_publisher_only = true
+  }
+}
+`, data.RandomInteger)
+}
+
+func (PermissionGrantPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_application_published_app_ids" "well_known" {}
+
+data "azuread_client_config" "current" {}
+
+resource "azuread_permission_grant_policy" "test" {
+  policy_id    = "acctest-permission-grant-policy-%[1]d"
+  display_name = "acctest-PermissionGrantPolicy-updated-%[1]d"
+  description  = "Acceptance test policy with multiple condition sets"
+
+  include {
+    permission_type                                  = "delegated"
+    permission_classification                        = "low"
+    client_applications_from_verified_publisher_only = true
+  }
+
+  include {
+    permission_type               = "delegated"
+    resource_application          = data.azuread_application_published_app_ids.well_known.result.MicrosoftGraph
+    client_application_tenant_ids = [data.azuread_client_config.current.tenant_id]
+  }
+
+  exclude {
+    permission_type      = "delegated"
+    resource_application = data.azuread_application_published_app_ids.well_known.result.MicrosoftGraph
+    permissions          = ["e1fe6dd8-ba31-4d61-89e7-88639da4683d"]
+  }
+}
+`, data.RandomInteger)
+}
@@ -29,14 +29,27 @@ func (r Registration) WebsiteCategories() []string {
 
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
-	return map[string]*pluginsdk.Resource{}
+	return map[string]*pluginsdk.Resource{
+		"azuread_authentication_strength_policy": authenticationStrengthPolicyDataSource(),
+	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_authentication_strength_policy": authenticationStrengthPolicyResource(),
-		"azuread_claims_mapping_policy":          claimsMappingPolicyResource(),
+		"azuread_activity_based_timeout_policy":            activityBasedTimeoutPolicyResource(),
+		"azuread_application_management_policy":            applicationManagementPolicyResource(),
+		"azuread_application_management_policy_assignment": applicationManagementPolicyAssignmentResource(),
+		"azuread_authentication_method_configuration":      authenticationMethodConfigurationResource(),
+		"azuread_authentication_methods_policy":            authenticationMethodsPolicyResource(),
+		"azuread_authentication_strength_policy":           authenticationStrengthPolicyResource(),
+		"azuread_authorization_policy":                     authorizationPolicyResource(),
+		"azuread_claims_mapping_policy":                    claimsMappingPolicyResource(),
+		"azuread_delegated_permission_classification":      delegatedPermissionClassificationResource(),
+		"azuread_home_realm_discovery_policy":              homeRealmDiscoveryPolicyResource(),
+		"azuread_permission_grant_policy":                  permissionGrantPolicyResource(),
+		"azuread_service_principal_policy_assignment":      servicePrincipalPolicyAssignmentResource(),
+		"azuread_tenant_app_management_policy":             tenantAppManagementPolicyResource(),
 	}
 }
 
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	policiesClient "github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+// servicePrincipalPolicyAssignmentCollections maps the supported values of `policy_type` to the navigation property
+// used to assign policies of that type
+var servicePrincipalPolicyAssignmentCollections = map[string]string{
+	"claimsMapping":      policiesClient.StsPolicyCollectionClaimsMapping,
+	"homeRealmDiscovery": policiesClient.StsPolicyCollectionHomeRealmDiscovery,
+	"tokenIssuance":      policiesClient.StsPolicyCollectionTokenIssuance,
+	"tokenLifetime":      policiesClient.StsPolicyCollectionTokenLifetime,
+}
+
+func servicePrincipalPolicyAssignmentResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: servicePrincipalPolicyAssignmentResourceCreate,
+		ReadContext:   servicePrincipalPolicyAssignmentResourceRead,
+		DeleteContext: servicePrincipalPolicyAssignmentResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ParseServicePrincipalPolicyAssignmentID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"service_principal_id": {
+				Description:      "The object ID of the service principal to which the policy should be assigned",
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.IsUUID),
+			},
+
+			"policy_type": {
+				Description:      "The type of the policy to assign",
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringInSlice([]string{"claimsMapping", "homeRealmDiscovery", "tokenIssuance", "tokenLifetime"}, false)),
+			},
+
+			"policy_id": {
+				Description:      "The object ID of the policy to assign",
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.IsUUID),
+			},
+		},
+	}
+}
+
+func servicePrincipalPolicyAssignmentResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.ServicePrincipalPolicyClient
+
+	policyType := d.Get("policy_type").(string)
+	id := parse.NewServicePrincipalPolicyAssignmentID(d.Get("service_principal_id").(string), servicePrincipalPolicyAssignmentCollections[policyType], d.Get("policy_id").(string))
+
+	existing, _, err := client.ListAssigned(ctx, id.ServicePrincipalId, id.Collection)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing %s policies assigned to service principal %q", policyType, id.ServicePrincipalId)
+	}
+	if existing != nil {
+		for _, policy := range *existing {
+			if policy.ID != nil && strings.EqualFold(*policy.ID, id.PolicyId) {
+				return tf.ImportAsExistsDiag("azuread_service_principal_policy_assignment", id.ID())
+			}
+		}
+	}
+
+	if _, err := client.Assign(ctx, id.ServicePrincipalId, id.Collection, id.PolicyId); err != nil {
+		return tf.ErrorDiagF(err, "Could not assign %s policy %q to service principal %q", policyType, id.PolicyId, id.ServicePrincipalId)
+	}
+
+	d.SetId(id.ID())
+
+	return servicePrincipalPolicyAssignmentResourceRead(ctx, d, meta)
+}
+
+func servicePrincipalPolicyAssignmentResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.ServicePrincipalPolicyClient
+
+	id, err := parse.ParseServicePrincipalPolicyAssignmentID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing service principal policy assignment ID %q", d.Id())
+	}
+
+	policies, status, err := client.ListAssigned(ctx, id.ServicePrincipalId, id.Collection)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Service Principal with Object ID %q was not found - removing policy assignment from state!", id.ServicePrincipalId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Listing %s assigned to service principal %q", id.Collection, id.ServicePrincipalId)
+	}
+
+	found := false
+	if policies != nil {
+		for _, policy := range *policies {
+			if policy.ID != nil && strings.EqualFold(*policy.ID, id.PolicyId) {
+				found = true
+				break
+			}
+		}
+	}
+	if !found {
+		log.Printf("[DEBUG] Policy %q is not assigned to service principal %q - removing from state!", id.PolicyId, id.ServicePrincipalId)
+		d.SetId("")
+		return nil
+	}
+
+	for policyType, collection := range servicePrincipalPolicyAssignmentCollections {
+		if collection == id.Collection {
+			tf.Set(d, "policy_type", policyType)
+			break
+		}
+	}
+	tf.Set(d, "policy_id", id.PolicyId)
+	tf.Set(d, "service_principal_id", id.ServicePrincipalId)
+
+	return nil
+}
+
+func servicePrincipalPolicyAssignmentResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.ServicePrincipalPolicyClient
+
+	id, err := parse.ParseServicePrincipalPolicyAssignmentID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing service principal policy assignment ID %q", d.Id())
+	}
+
+	if status, err := client.Unassign(ctx, id.ServicePrincipalId, id.Collection, id.PolicyId); err != nil {
+		if status == http.StatusNotFound {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Could not remove policy %q from service principal %q", id.PolicyId, id.ServicePrincipalId)
+	}
+
+	return nil
+}
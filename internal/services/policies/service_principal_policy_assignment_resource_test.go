@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/parse"
+)
+
+type ServicePrincipalPolicyAssignmentResource struct{}
+
+func TestAccServicePrincipalPolicyAssignment_homeRealmDiscovery(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_policy_assignment", "test")
+	r := ServicePrincipalPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.homeRealmDiscovery(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("policy_type").HasValue("homeRealmDiscovery"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServicePrincipalPolicyAssignment_claimsMapping(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_policy_assignment", "test")
+	r := ServicePrincipalPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.claimsMapping(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("policy_type").HasValue("claimsMapping"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ServicePrincipalPolicyAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.ServicePrincipalPolicyClient
+	client.BaseClient.DisableRetries = true
+	defer func() { client.BaseClient.DisableRetries = false }()
+
+	id, err := parse.ParseServicePrincipalPolicyAssignmentID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing service principal policy assignment ID: %v", err)
+	}
+
+	exists := false
+	policies, status, err := client.ListAssigned(ctx, id.ServicePrincipalId, id.Collection)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Service principal with object ID %q does not exist", id.ServicePrincipalId)
+		}
+		return &exists, fmt.Errorf("failed to list %s assigned to service principal %q: %+v", id.Collection, id.ServicePrincipalId, err)
+	}
+
+	if policies != nil {
+		for _, policy := range *policies {
+			if policy.ID != nil && strings.EqualFold(*policy.ID, id.PolicyId) {
+				exists = true
+				break
+			}
+		}
+	}
+
+	return &exists, nil
+}
+
+func (ServicePrincipalPolicyAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-SPPolicyAssignment-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+}
+`, data.RandomInteger)
+}
+
+func (r ServicePrincipalPolicyAssignmentResource) homeRealmDiscovery(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_home_realm_discovery_policy" "test" {
+  display_name = "acctest-SPPolicyAssignment-%[2]d"
+
+  definition {
+    accelerate_to_federated_domain = true
+  }
+}
+
+resource "azuread_service_principal_policy_assignment" "test" {
+  service_principal_id = azuread_service_principal.test.object_id
+  policy_type          = "homeRealmDiscovery"
+  policy_id            = azuread_home_realm_discovery_policy.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ServicePrincipalPolicyAssignmentResource) claimsMapping(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_claims_mapping_policy" "test" {
+  definition = [
+    jsonencode({
+      ClaimsMappingPolicy = {
+        Version              = 1
+        IncludeBasicClaimSet = "true"
+        ClaimsSchema         = []
+      }
+    })
+  ]
+  display_name = "acctest-SPPolicyAssignment-%[2]d"
+}
+
+resource "azuread_service_principal_policy_assignment" "test" {
+  service_principal_id = azuread_service_principal.test.object_id
+  policy_type          = "claimsMapping"
+  policy_id            = azuread_claims_mapping_policy.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
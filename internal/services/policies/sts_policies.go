@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+// activityBasedTimeoutPolicyDefaultApplicationId is the application ID used in an activity-based timeout policy
+// definition for the timeout which applies to all applications without a specific timeout
+const activityBasedTimeoutPolicyDefaultApplicationId = "default"
+
+// activityBasedTimeoutPolicyTimeoutRegex matches a timespan in the format `[d.]hh:mm:ss`
+var activityBasedTimeoutPolicyTimeoutRegex = regexp.MustCompile(`^(?:[0-9]+\.)?[0-9]{2}:[0-5][0-9]:[0-5][0-9]$`)
+
+// stsPolicyBool is a boolean in a policy definition. Definitions created outside of Terraform sometimes encode
+// booleans as strings, so both forms are accepted when decoding.
+type stsPolicyBool bool
+
+func (b *stsPolicyBool) UnmarshalJSON(data []byte) error {
+	value, err := strconv.ParseBool(strings.Trim(string(data), `"`))
+	if err != nil {
+		return fmt.Errorf("parsing boolean %s: %v", data, err)
+	}
+	*b = stsPolicyBool(value)
+	return nil
+}
+
+type homeRealmDiscoveryPolicyDefinition struct {
+	HomeRealmDiscoveryPolicy homeRealmDiscoveryPolicyProperties `json:"HomeRealmDiscoveryPolicy"`
+}
+
+type homeRealmDiscoveryPolicyProperties struct {
+	AccelerateToFederatedDomain  stsPolicyBool                    `json:"AccelerateToFederatedDomain"`
+	AllowCloudPasswordValidation stsPolicyBool                    `json:"AllowCloudPasswordValidation"`
+	AlternateIdLogin             *homeRealmDiscoveryPolicyFeature `json:"AlternateIdLogin,omitempty"`
+	PreferredDomain              string                           `json:"PreferredDomain,omitempty"`
+}
+
+type homeRealmDiscoveryPolicyFeature struct {
+	Enabled stsPolicyBool `json:"Enabled"`
+}
+
+type activityBasedTimeoutPolicyDefinition struct {
+	ActivityBasedTimeoutPolicy activityBasedTimeoutPolicyProperties `json:"ActivityBasedTimeoutPolicy"`
+}
+
+type activityBasedTimeoutPolicyProperties struct {
+	Version             int                                     `json:"Version"`
+	ApplicationPolicies []activityBasedTimeoutApplicationPolicy `json:"ApplicationPolicies"`
+}
+
+type activityBasedTimeoutApplicationPolicy struct {
+	ApplicationId         string `json:"ApplicationId"`
+	WebSessionIdleTimeout string `json:"WebSessionIdleTimeout"`
+}
+
+// stsPolicyDecodeDefinition decodes the JSON definition of an STS policy, which the API returns as the only item in
+// a list of strings
+func stsPolicyDecodeDefinition(in *[]string, out interface{}) error {
+	if in == nil || len(*in) == 0 {
+		return errors.New("policy has no definition")
+	}
+	if len(*in) > 1 {
+		return fmt.Errorf("policy has %d definitions, expected exactly one", len(*in))
+	}
+	return json.Unmarshal([]byte((*in)[0]), out)
+}
+
+// stsPolicyEncodeDefinition encodes the JSON definition of an STS policy
+func stsPolicyEncodeDefinition(in interface{}) (*[]string, error) {
+	definition, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+	return &[]string{string(definition)}, nil
+}
+
+func expandHomeRealmDiscoveryPolicyDefinition(in []interface{}) homeRealmDiscoveryPolicyDefinition {
+	result := homeRealmDiscoveryPolicyDefinition{}
+
+	if len(in) == 0 || in[0] == nil {
+		return result
+	}
+	v := in[0].(map[string]interface{})
+
+	result.HomeRealmDiscoveryPolicy = homeRealmDiscoveryPolicyProperties{
+		AccelerateToFederatedDomain:  stsPolicyBool(v["accelerate_to_federated_domain"].(bool)),
+		AllowCloudPasswordValidation: stsPolicyBool(v["allow_cloud_password_validation"].(bool)),
+		PreferredDomain:              v["preferred_domain"].(string),
+	}
+	if v["alternate_id_login_enabled"].(bool) {
+		result.HomeRealmDiscoveryPolicy.AlternateIdLogin = &homeRealmDiscoveryPolicyFeature{
+			Enabled: true,
+		}
+	}
+
+	return result
+}
+
+func flattenHomeRealmDiscoveryPolicyDefinition(in homeRealmDiscoveryPolicyDefinition) []interface{} {
+	properties := in.HomeRealmDiscoveryPolicy
+
+	alternateIdLoginEnabled := false
+	if properties.AlternateIdLogin != nil {
+		alternateIdLoginEnabled = bool(properties.AlternateIdLogin.Enabled)
+	}
+
+	return []interface{}{map[string]interface{}{
+		"accelerate_to_federated_domain":  bool(properties.AccelerateToFederatedDomain),
+		"allow_cloud_password_validation": bool(properties.AllowCloudPasswordValidation),
+		"alternate_id_login_enabled":      alternateIdLoginEnabled,
+		"preferred_domain":                properties.PreferredDomain,
+	}}
+}
+
+func expandActivityBasedTimeoutPolicyDefinition(in []interface{}) activityBasedTimeoutPolicyDefinition {
+	result := activityBasedTimeoutPolicyDefinition{
+		ActivityBasedTimeoutPolicy: activityBasedTimeoutPolicyProperties{
+			Version:             1,
+			ApplicationPolicies: make([]activityBasedTimeoutApplicationPolicy, 0),
+		},
+	}
+
+	if len(in) == 0 || in[0] == nil {
+		return result
+	}
+	v := in[0].(map[string]interface{})
+
+	for _, raw := range v["application_policy"].([]interface{}) {
+		if raw == nil {
+			continue
+		}
+		policy := raw.(map[string]interface{})
+
+		result.ActivityBasedTimeoutPolicy.ApplicationPolicies = append(result.ActivityBasedTimeoutPolicy.ApplicationPolicies, activityBasedTimeoutApplicationPolicy{
+			ApplicationId:         policy["application_id"].(string),
+			WebSessionIdleTimeout: policy["web_session_idle_timeout"].(string),
+		})
+	}
+
+	return result
+}
+
+func flattenActivityBasedTimeoutPolicyDefinition(in activityBasedTimeoutPolicyDefinition) []interface{} {
+	applicationPolicies := make([]interface{}, 0)
+
+	for _, policy := range in.ActivityBasedTimeoutPolicy.ApplicationPolicies {
+		applicationId := policy.ApplicationId
+		if strings.EqualFold(applicationId, activityBasedTimeoutPolicyDefaultApplicationId) {
+			applicationId = activityBasedTimeoutPolicyDefaultApplicationId
+		}
+
+		applicationPolicies = append(applicationPolicies, map[string]interface{}{
+			"application_id":           applicationId,
+			"web_session_idle_timeout": policy.WebSessionIdleTimeout,
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"application_policy": applicationPolicies,
+	}}
+}
+
+// activityBasedTimeoutPolicyValidateApplicationId validates that an application ID is either `default` or a UUID
+func activityBasedTimeoutPolicyValidateApplicationId(i interface{}, k string) (warnings []string, errs []error) {
+	v, ok := i.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if v == activityBasedTimeoutPolicyDefaultApplicationId {
+		return
+	}
+
+	if _, err := uuid.ParseUUID(v); err != nil {
+		errs = append(errs, fmt.Errorf("expected %q to be %q or a valid UUID, got %q", k, activityBasedTimeoutPolicyDefaultApplicationId, v))
+	}
+
+	return
+}
+
+// activityBasedTimeoutPolicyValidateApplicationPolicies checks that each application is specified at most once.
+// Application policies with unknown application IDs are skipped.
+func activityBasedTimeoutPolicyValidateApplicationPolicies(in []interface{}) error {
+	seen := make(map[string]bool)
+
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		applicationId := strings.ToLower(raw.(map[string]interface{})["application_id"].(string))
+		if applicationId == "" {
+			continue
+		}
+
+		if seen[applicationId] {
+			return fmt.Errorf("the application ID %q is specified in more than one `application_policy` block", applicationId)
+		}
+		seen[applicationId] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	policiesClient "github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+)
+
+const tenantAppManagementPolicyId = "defaultAppManagementPolicy"
+
+func tenantAppManagementPolicyResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: tenantAppManagementPolicyResourceCreate,
+		ReadContext:   tenantAppManagementPolicyResourceRead,
+		UpdateContext: tenantAppManagementPolicyResourceUpdate,
+		DeleteContext: tenantAppManagementPolicyResourceDelete,
+
+		CustomizeDiff: tenantAppManagementPolicyResourceCustomizeDiff,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if id != tenantAppManagementPolicyId {
+				return fmt.Errorf("specified ID (%q) is not valid, expected %q", id, tenantAppManagementPolicyId)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"enabled": {
+				Description: "Whether the default restrictions are enforced",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+
+			"application_restrictions": appManagementPolicyRestrictionsSchema("The default credential restrictions for applications"),
+
+			"service_principal_restrictions": appManagementPolicyRestrictionsSchema("The default credential restrictions for service principals"),
+
+			"baseline": {
+				Description: "The original settings of the policy, encoded as JSON, which are restored when this resource is destroyed",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"description": {
+				Description: "The description of the tenant app management policy",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"display_name": {
+				Description: "The display name of the tenant app management policy",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func tenantAppManagementPolicyResourceCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	for _, block := range []string{"application_restrictions", "service_principal_restrictions"} {
+		for _, credentialType := range []string{"key_credential", "password_credential"} {
+			attr := fmt.Sprintf("%s.0.%s", block, credentialType)
+			if err := appManagementPolicyValidateCredentials(attr, diff.Get(attr).([]interface{})); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func tenantAppManagementPolicyResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.TenantAppManagementPolicyClient
+
+	// Record the existing settings so they can be restored when this resource is destroyed
+	existing, _, err := client.Get(ctx, odata.Query{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not retrieve tenant app management policy")
+	}
+	if existing == nil {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Result is nil")
+	}
+
+	baseline, err := json.Marshal(policiesClient.TenantAppManagementPolicy{
+		ApplicationRestrictions:      existing.ApplicationRestrictions,
+		IsEnabled:                    existing.IsEnabled,
+		ServicePrincipalRestrictions: existing.ServicePrincipalRestrictions,
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not encode baseline for tenant app management policy")
+	}
+
+	if diags := tenantAppManagementPolicyResourceApply(ctx, d, meta); diags != nil {
+		return diags
+	}
+
+	d.SetId(tenantAppManagementPolicyId)
+	tf.Set(d, "baseline", string(baseline))
+
+	return tenantAppManagementPolicyResourceRead(ctx, d, meta)
+}
+
+func tenantAppManagementPolicyResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	if diags := tenantAppManagementPolicyResourceApply(ctx, d, meta); diags != nil {
+		return diags
+	}
+
+	return tenantAppManagementPolicyResourceRead(ctx, d, meta)
+}
+
+func tenantAppManagementPolicyResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.TenantAppManagementPolicyClient
+
+	policy, _, err := client.Get(ctx, odata.Query{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not retrieve tenant app management policy")
+	}
+	if policy == nil {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Result is nil")
+	}
+
+	tf.Set(d, "application_restrictions", flattenAppManagementConfiguration(policy.ApplicationRestrictions))
+	tf.Set(d, "description", policy.Description)
+	tf.Set(d, "display_name", policy.DisplayName)
+	tf.Set(d, "enabled", pointer.From(policy.IsEnabled))
+	tf.Set(d, "service_principal_restrictions", flattenAppManagementConfiguration(policy.ServicePrincipalRestrictions))
+
+	return nil
+}
+
+func tenantAppManagementPolicyResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.TenantAppManagementPolicyClient
+
+	// The tenant app management policy cannot be deleted, so we restore the recorded baseline. When no baseline was
+	// recorded, for example after importing, we disable the policy and remove all restrictions instead.
+	properties := policiesClient.TenantAppManagementPolicy{
+		ApplicationRestrictions:      expandAppManagementConfiguration(nil),
+		IsEnabled:                    pointer.To(false),
+		ServicePrincipalRestrictions: expandAppManagementConfiguration(nil),
+	}
+
+	if v := d.Get("baseline").(string); v != "" {
+		var baseline policiesClient.TenantAppManagementPolicy
+		if err := json.Unmarshal([]byte(v), &baseline); err != nil {
+			return tf.ErrorDiagPathF(err, "baseline", "Could not decode recorded baseline for tenant app management policy")
+		}
+
+		if baseline.ApplicationRestrictions != nil {
+			properties.ApplicationRestrictions = tenantAppManagementPolicyCompleteConfiguration(baseline.ApplicationRestrictions)
+		}
+		if baseline.IsEnabled != nil {
+			properties.IsEnabled = baseline.IsEnabled
+		}
+		if baseline.ServicePrincipalRestrictions != nil {
+			properties.ServicePrincipalRestrictions = tenantAppManagementPolicyCompleteConfiguration(baseline.ServicePrincipalRestrictions)
+		}
+	}
+
+	if _, err := client.Update(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Could not restore tenant app management policy")
+	}
+
+	return nil
+}
+
+func tenantAppManagementPolicyResourceApply(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.TenantAppManagementPolicyClient
+
+	// Restrictions are managed authoritatively, so omitted blocks remove any existing restrictions
+	properties := policiesClient.TenantAppManagementPolicy{
+		ApplicationRestrictions:      expandAppManagementConfiguration(d.Get("application_restrictions").([]interface{})),
+		IsEnabled:                    pointer.To(d.Get("enabled").(bool)),
+		ServicePrincipalRestrictions: expandAppManagementConfiguration(d.Get("service_principal_restrictions").([]interface{})),
+	}
+
+	if _, err := client.Update(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Could not update tenant app management policy")
+	}
+
+	return nil
+}
+
+// tenantAppManagementPolicyCompleteConfiguration ensures that both credential lists are sent when restoring the
+// baseline, so that restrictions added by this resource are removed
+func tenantAppManagementPolicyCompleteConfiguration(in *policiesClient.AppManagementConfiguration) *policiesClient.AppManagementConfiguration {
+	result := *in
+	if result.KeyCredentials == nil {
+		result.KeyCredentials = &[]policiesClient.KeyCredentialConfiguration{}
+	}
+	if result.PasswordCredentials == nil {
+		result.PasswordCredentials = &[]policiesClient.PasswordCredentialConfiguration{}
+	}
+	return &result
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type TenantAppManagementPolicyResource struct{}
+
+func TestAccTenantAppManagementPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_tenant_app_management_policy", "test")
+	r := TenantAppManagementPolicyResource{}
+
+	// The tenant app management policy always exists, it is restored to its baseline on destroy
+	data.ResourceTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("baseline").Exists(),
+			),
+		},
+		data.ImportStep("baseline"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("application_restrictions.0.password_credential.#").HasValue("1"),
+				check.That(data.ResourceName).Key("service_principal_restrictions.0.key_credential.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("baseline"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("baseline"),
+	})
+}
+
+func (r TenantAppManagementPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.TenantAppManagementPolicyClient
+	client.BaseClient.DisableRetries = true
+	defer func() { client.BaseClient.DisableRetries = false }()
+
+	if _, _, err := client.Get(ctx, odata.Query{}); err != nil {
+		return nil, fmt.Errorf("failed to retrieve tenant app management policy: %+v", err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (TenantAppManagementPolicyResource) basic(data acceptance.TestData) string {
+	return `
+provider "azuread" {}
+
+resource "azuread_tenant_app_management_policy" "test" {
+  enabled = false
+}
+`
+}
+
+func (TenantAppManagementPolicyResource) complete(data acceptance.TestData) string {
+	return `
+provider "azuread" {}
+
+resource "azuread_tenant_app_management_policy" "test" {
+  enabled = true
+
+  application_restrictions {
+    password_credential {
+      restriction_type                = "passwordLifetime"
+      max_lifetime                    = "P180D"
+      restrict_for_apps_created_after = "2030-01-01T00:00:00Z"
+    }
+  }
+
+  service_principal_restrictions {
+    key_credential {
+      restriction_type                = "asymmetricKeyLifetime"
+      max_lifetime                    = "P365D"
+      restrict_for_apps_created_after = "2030-01-01T00:00:00Z"
+    }
+  }
+}
+`
+}
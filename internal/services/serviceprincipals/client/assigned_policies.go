@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// Navigation properties used to list the policies of each type which are assigned to a service principal
+const (
+	AssignedPolicyCollectionClaimsMapping      = "claimsMappingPolicies"
+	AssignedPolicyCollectionHomeRealmDiscovery = "homeRealmDiscoveryPolicies"
+	AssignedPolicyCollectionTokenIssuance      = "tokenIssuancePolicies"
+	AssignedPolicyCollectionTokenLifetime      = "tokenLifetimePolicies"
+)
+
+// AssignedPolicy describes a policy assigned to a service principal.
+type AssignedPolicy struct {
+	ID          *string `json:"id,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+}
+
+// AssignedPoliciesClient lists the policies assigned to service principals, which is not supported by the SDK.
+type AssignedPoliciesClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewAssignedPoliciesClient returns a new AssignedPoliciesClient
+func NewAssignedPoliciesClient() *AssignedPoliciesClient {
+	return &AssignedPoliciesClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10),
+	}
+}
+
+// List returns the policies in the specified collection which are assigned to a service principal.
+func (c *AssignedPoliciesClient) List(ctx context.Context, servicePrincipalId, collection string) (*[]AssignedPolicy, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ConsistencyFailureFunc: msgraph.RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/servicePrincipals/%s/%s", servicePrincipalId, collection),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AssignedPoliciesClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		Policies []AssignedPolicy `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.Policies, status, nil
+}
@@ -9,6 +9,7 @@ import (
 )
 
 type Client struct {
+	AssignedPoliciesClient          *AssignedPoliciesClient
 	DelegatedPermissionGrantsClient *msgraph.DelegatedPermissionGrantsClient
 	DirectoryObjectsClient          *msgraph.DirectoryObjectsClient
 	ServicePrincipalsClient         *msgraph.ServicePrincipalsClient
@@ -16,6 +17,9 @@ type Client struct {
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	assignedPoliciesClient := NewAssignedPoliciesClient()
+	o.ConfigureClient(&assignedPoliciesClient.BaseClient)
+
 	delegatedPermissionGrantsClient := msgraph.NewDelegatedPermissionGrantsClient()
 	o.ConfigureClient(&delegatedPermissionGrantsClient.BaseClient)
 
@@ -32,6 +36,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	synchronizationJobClient.BaseClient.ApiVersion = msgraph.VersionBeta
 
 	return &Client{
+		AssignedPoliciesClient:          assignedPoliciesClient,
 		DelegatedPermissionGrantsClient: delegatedPermissionGrantsClient,
 		DirectoryObjectsClient:          directoryObjectsClient,
 		ServicePrincipalsClient:         servicePrincipalsClient,
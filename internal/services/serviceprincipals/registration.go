@@ -30,20 +30,23 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_service_principal":  servicePrincipalData(),
-		"azuread_service_principals": servicePrincipalsDataSource(),
+		"azuread_saml_federation_metadata":                      samlFederationMetadataDataSource(),
+		"azuread_service_principal":                             servicePrincipalData(),
+		"azuread_service_principal_delegated_permission_grants": servicePrincipalDelegatedPermissionGrantsDataSource(),
+		"azuread_service_principals":                            servicePrincipalsDataSource(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_service_principal":                                  servicePrincipalResource(),
-		"azuread_service_principal_certificate":                      servicePrincipalCertificateResource(),
-		"azuread_service_principal_claims_mapping_policy_assignment": servicePrincipalClaimsMappingPolicyAssignmentResource(),
-		"azuread_service_principal_delegated_permission_grant":       servicePrincipalDelegatedPermissionGrantResource(),
-		"azuread_service_principal_password":                         servicePrincipalPasswordResource(),
-		"azuread_service_principal_token_signing_certificate":        servicePrincipalTokenSigningCertificateResource(),
+		"azuread_service_principal":                                    servicePrincipalResource(),
+		"azuread_service_principal_certificate":                        servicePrincipalCertificateResource(),
+		"azuread_service_principal_claims_mapping_policy_assignment":   servicePrincipalClaimsMappingPolicyAssignmentResource(),
+		"azuread_service_principal_delegated_permission_grant":         servicePrincipalDelegatedPermissionGrantResource(),
+		"azuread_service_principal_password":                           servicePrincipalPasswordResource(),
+		"azuread_service_principal_token_signing_certificate":          servicePrincipalTokenSigningCertificateResource(),
+		"azuread_service_principal_token_signing_certificate_rotation": servicePrincipalTokenSigningCertificateRotationResource(),
 	}
 }
 
@@ -0,0 +1,322 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+// samlMetadataMaxSize is the maximum size of a metadata document which will be read, in bytes
+const samlMetadataMaxSize = 10 * 1024 * 1024
+
+// samlMetadataEntityDescriptor is the root element of a SAML 2.0 metadata document. Elements are matched by local
+// name so that documents using different namespace prefixes are parsed in the same way.
+type samlMetadataEntityDescriptor struct {
+	XMLName              xml.Name                    `xml:"EntityDescriptor"`
+	EntityId             string                      `xml:"entityID,attr"`
+	IdentityProviderSsos []samlMetadataSsoDescriptor `xml:"IDPSSODescriptor"`
+	ServiceProviderSsos  []samlMetadataSsoDescriptor `xml:"SPSSODescriptor"`
+}
+
+type samlMetadataSsoDescriptor struct {
+	KeyDescriptors            []samlMetadataKeyDescriptor `xml:"KeyDescriptor"`
+	NameIdFormats             []string                    `xml:"NameIDFormat"`
+	SingleSignOnServices      []samlMetadataEndpoint      `xml:"SingleSignOnService"`
+	SingleLogoutServices      []samlMetadataEndpoint      `xml:"SingleLogoutService"`
+	AssertionConsumerServices []samlMetadataEndpoint      `xml:"AssertionConsumerService"`
+}
+
+type samlMetadataKeyDescriptor struct {
+	Use              string   `xml:"use,attr"`
+	X509Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+}
+
+type samlMetadataEndpoint struct {
+	Binding   string `xml:"Binding,attr"`
+	Location  string `xml:"Location,attr"`
+	Index     int    `xml:"index,attr"`
+	IsDefault bool   `xml:"isDefault,attr"`
+}
+
+func samlFederationMetadataDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: samlFederationMetadataDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"client_id": {
+				Description:  "The client ID of an application for which to retrieve the SAML federation metadata published by Azure Active Directory",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"client_id", "metadata_url"},
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"metadata_url": {
+				Description:  "The URL of a SAML federation metadata document, such as the `saml_metadata_url` of a service principal",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"client_id", "metadata_url"},
+				ValidateFunc: validation.IsHttpOrHttpsUrl,
+			},
+
+			"entity_id": {
+				Description: "The entity ID (issuer) declared in the metadata document",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"identity_provider": {
+				Description: "The identity provider role declared in the metadata document",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: samlFederationMetadataRoleSchema(),
+				},
+			},
+
+			"metadata_xml": {
+				Description: "The raw XML metadata document",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"service_provider": {
+				Description: "The service provider role declared in the metadata document",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: samlFederationMetadataRoleSchema(),
+				},
+			},
+		},
+	}
+}
+
+func samlFederationMetadataRoleSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"assertion_consumer_service": samlFederationMetadataEndpointSchema("The assertion consumer service endpoints"),
+
+		"encryption_certificates": {
+			Description: "The PEM encoded certificates used for encryption",
+			Type:        pluginsdk.TypeList,
+			Computed:    true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"name_id_formats": {
+			Description: "The supported NameID formats",
+			Type:        pluginsdk.TypeList,
+			Computed:    true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"signing_certificates": {
+			Description: "The PEM encoded certificates used for signing",
+			Type:        pluginsdk.TypeList,
+			Computed:    true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"single_logout_service": samlFederationMetadataEndpointSchema("The single logout endpoints"),
+
+		"single_sign_on_service": samlFederationMetadataEndpointSchema("The single sign-on endpoints"),
+	}
+}
+
+func samlFederationMetadataEndpointSchema(description string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description: description,
+		Type:        pluginsdk.TypeList,
+		Computed:    true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"binding": {
+					Description: "The SAML protocol binding supported by the endpoint",
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+				},
+
+				"default": {
+					Description: "Whether this is the default endpoint",
+					Type:        pluginsdk.TypeBool,
+					Computed:    true,
+				},
+
+				"index": {
+					Description: "The index of the endpoint",
+					Type:        pluginsdk.TypeInt,
+					Computed:    true,
+				},
+
+				"location": {
+					Description: "The URL of the endpoint",
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func samlFederationMetadataDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client)
+
+	metadataUrl := d.Get("metadata_url").(string)
+	if clientId := d.Get("client_id").(string); clientId != "" {
+		if client.Environment.Authorization == nil {
+			return tf.ErrorDiagF(errors.New("login endpoint not known for the configured environment"), "Building federation metadata URL")
+		}
+		metadataUrl = fmt.Sprintf("%s/%s/federationmetadata/2007-06/federationmetadata.xml?appid=%s", strings.TrimSuffix(client.Environment.Authorization.LoginEndpoint, "/"), client.TenantID, clientId)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metadataUrl, nil)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Building request for federation metadata from %q", metadataUrl)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving federation metadata from %q", metadataUrl)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return tf.ErrorDiagF(fmt.Errorf("unexpected status %d", resp.StatusCode), "Retrieving federation metadata from %q", metadataUrl)
+	}
+
+	// Read one byte more than the maximum size, in order to detect documents which exceed it
+	metadataXml, err := io.ReadAll(io.LimitReader(resp.Body, samlMetadataMaxSize+1))
+	if err != nil {
+		return tf.ErrorDiagF(err, "Reading federation metadata from %q", metadataUrl)
+	}
+	if len(metadataXml) > samlMetadataMaxSize {
+		return tf.ErrorDiagF(fmt.Errorf("document exceeds the maximum size of %d bytes", samlMetadataMaxSize), "Reading federation metadata from %q", metadataUrl)
+	}
+
+	var metadata samlMetadataEntityDescriptor
+	if err = xml.Unmarshal(metadataXml, &metadata); err != nil {
+		return tf.ErrorDiagF(err, "Parsing federation metadata from %q", metadataUrl)
+	}
+
+	identityProvider, err := flattenSamlMetadataSsoDescriptors(metadata.IdentityProviderSsos)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Parsing identity provider from federation metadata")
+	}
+	serviceProvider, err := flattenSamlMetadataSsoDescriptors(metadata.ServiceProviderSsos)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Parsing service provider from federation metadata")
+	}
+
+	h := sha1.New()
+	if _, err := h.Write([]byte(metadataUrl)); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for metadata URL")
+	}
+	d.SetId("samlfederationmetadata#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+
+	tf.Set(d, "entity_id", metadata.EntityId)
+	tf.Set(d, "identity_provider", identityProvider)
+	tf.Set(d, "metadata_xml", string(metadataXml))
+	tf.Set(d, "service_provider", serviceProvider)
+
+	return nil
+}
+
+func flattenSamlMetadataSsoDescriptors(in []samlMetadataSsoDescriptor) ([]interface{}, error) {
+	result := make([]interface{}, 0)
+
+	for _, descriptor := range in {
+		signingCertificates := make([]string, 0)
+		encryptionCertificates := make([]string, 0)
+
+		for _, key := range descriptor.KeyDescriptors {
+			for _, certificate := range key.X509Certificates {
+				pemCertificate, err := samlMetadataCertificateToPem(certificate)
+				if err != nil {
+					return nil, err
+				}
+
+				// A key descriptor without a `use` attribute applies to both signing and encryption
+				if key.Use == "" || strings.EqualFold(key.Use, "signing") {
+					signingCertificates = append(signingCertificates, pemCertificate)
+				}
+				if key.Use == "" || strings.EqualFold(key.Use, "encryption") {
+					encryptionCertificates = append(encryptionCertificates, pemCertificate)
+				}
+			}
+		}
+
+		nameIdFormats := make([]string, 0)
+		for _, format := range descriptor.NameIdFormats {
+			nameIdFormats = append(nameIdFormats, strings.TrimSpace(format))
+		}
+
+		result = append(result, map[string]interface{}{
+			"assertion_consumer_service": flattenSamlMetadataEndpoints(descriptor.AssertionConsumerServices),
+			"encryption_certificates":    encryptionCertificates,
+			"name_id_formats":            nameIdFormats,
+			"signing_certificates":       signingCertificates,
+			"single_logout_service":      flattenSamlMetadataEndpoints(descriptor.SingleLogoutServices),
+			"single_sign_on_service":     flattenSamlMetadataEndpoints(descriptor.SingleSignOnServices),
+		})
+	}
+
+	return result, nil
+}
+
+func flattenSamlMetadataEndpoints(in []samlMetadataEndpoint) []interface{} {
+	result := make([]interface{}, 0)
+
+	for _, endpoint := range in {
+		result = append(result, map[string]interface{}{
+			"binding":  endpoint.Binding,
+			"default":  endpoint.IsDefault,
+			"index":    endpoint.Index,
+			"location": endpoint.Location,
+		})
+	}
+
+	return result
+}
+
+// samlMetadataCertificateToPem converts a base64 encoded DER certificate from a metadata document to PEM format
+func samlMetadataCertificateToPem(in string) (string, error) {
+	der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(in), ""))
+	if err != nil {
+		return "", fmt.Errorf("decoding certificate: %v", err)
+	}
+
+	if _, err = x509.ParseCertificate(der); err != nil {
+		return "", fmt.Errorf("parsing certificate: %v", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: der,
+	})), nil
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type SamlFederationMetadataDataSource struct{}
+
+func TestAccSamlFederationMetadataDataSource_byClientId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_saml_federation_metadata", "test")
+	r := SamlFederationMetadataDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.byClientId(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("entity_id").HasValue(fmt.Sprintf("https://sts.windows.net/%s/", os.Getenv("ARM_TENANT_ID"))),
+				check.That(data.ResourceName).Key("identity_provider.#").HasValue("1"),
+				check.That(data.ResourceName).Key("identity_provider.0.signing_certificates.#").Exists(),
+				check.That(data.ResourceName).Key("identity_provider.0.single_sign_on_service.#").Exists(),
+				check.That(data.ResourceName).Key("metadata_xml").Exists(),
+			),
+		},
+	})
+}
+
+func (SamlFederationMetadataDataSource) byClientId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_saml_federation_metadata" "test" {
+  client_id = azuread_service_principal.test.client_id
+}
+`, ServicePrincipalResource{}.samlSingleSignOn(data))
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const samlMetadataTestCertificate = "MIIBjTCCATOgAwIBAgIUMY3uwaOlbF0vbatYJVuvSJAYGDQwCgYIKoZIzj0EAwIwGzEZMBcGA1UEAwwQc2FtbC5leGFtcGxlLmNvbTAgFw0yNjEwMTgxNzE5MTFaGA8yMTI2MDkyNDE3MTkxMVowGzEZMBcGA1UEAwwQc2FtbC5leGFtcGxlLmNvbTBZMBMGByqGSM49AgEGCCqGSM49AwEHA0IABAapoSfLAy+716gKq7lqXAYuS30QbYs8zjk/Qa+8I1RrxIqg/O2W62/7DWcPAH12WR9n37lEPH3p68GifA6LNrCjUzBRMB0GA1UdDgQWBBR7As9G9lJe0kgv5dupCFyM9UNCWDAfBgNVHSMEGDAWgBR7As9G9lJe0kgv5dupCFyM9UNCWDAPBgNVHRMBAf8EBTADAQH/MAoGCCqGSM49BAMCA0gAMEUCIDXbi8bJhGbIPPawjyp/SfbY0GE+VZ8eMWao+f0Ji8xDAiEAmGgbuU6YOi36IqHUrX5/4DaUyfTfyDJBhPKHdkVlcNc="
+
+const samlMetadataTestCertificatePem = "" +
+	"-----BEGIN CERTIFICATE-----\n" +
+	"MIIBjTCCATOgAwIBAgIUMY3uwaOlbF0vbatYJVuvSJAYGDQwCgYIKoZIzj0EAwIw\n" +
+	"GzEZMBcGA1UEAwwQc2FtbC5leGFtcGxlLmNvbTAgFw0yNjEwMTgxNzE5MTFaGA8y\n" +
+	"MTI2MDkyNDE3MTkxMVowGzEZMBcGA1UEAwwQc2FtbC5leGFtcGxlLmNvbTBZMBMG\n" +
+	"ByqGSM49AgEGCCqGSM49AwEHA0IABAapoSfLAy+716gKq7lqXAYuS30QbYs8zjk/\n" +
+	"Qa+8I1RrxIqg/O2W62/7DWcPAH12WR9n37lEPH3p68GifA6LNrCjUzBRMB0GA1Ud\n" +
+	"DgQWBBR7As9G9lJe0kgv5dupCFyM9UNCWDAfBgNVHSMEGDAWgBR7As9G9lJe0kgv\n" +
+	"5dupCFyM9UNCWDAPBgNVHRMBAf8EBTADAQH/MAoGCCqGSM49BAMCA0gAMEUCIDXb\n" +
+	"i8bJhGbIPPawjyp/SfbY0GE+VZ8eMWao+f0Ji8xDAiEAmGgbuU6YOi36IqHUrX5/\n" +
+	"4DaUyfTfyDJBhPKHdkVlcNc=\n" +
+	"-----END CERTIFICATE-----\n"
+
+const samlMetadataTestDocument = `<?xml version="1.0" encoding="utf-8"?>
+<EntityDescriptor ID="_00000000-0000-0000-0000-000000000000" entityID="https://sts.example.com/00000000-0000-0000-0000-000000000000/" xmlns="urn:oasis:names:tc:SAML:2.0:metadata">
+	<IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+		<KeyDescriptor use="signing">
+			<KeyInfo xmlns="http://www.w3.org/2000/09/xmldsig#">
+				<X509Data>
+					<X509Certificate>
+						MIIBjTCCATOgAwIBAgIUMY3uwaOlbF0vbatYJVuvSJAYGDQwCgYIKoZIzj0EAwIw
+						GzEZMBcGA1UEAwwQc2FtbC5leGFtcGxlLmNvbTAgFw0yNjEwMTgxNzE5MTFaGA8y
+						MTI2MDkyNDE3MTkxMVowGzEZMBcGA1UEAwwQc2FtbC5leGFtcGxlLmNvbTBZMBMG
+						ByqGSM49AgEGCCqGSM49AwEHA0IABAapoSfLAy+716gKq7lqXAYuS30QbYs8zjk/
+						Qa+8I1RrxIqg/O2W62/7DWcPAH12WR9n37lEPH3p68GifA6LNrCjUzBRMB0GA1Ud
+						DgQWBBR7As9G9lJe0kgv5dupCFyM9UNCWDAfBgNVHSMEGDAWgBR7As9G9lJe0kgv
+						5dupCFyM9UNCWDAPBgNVHRMBAf8EBTADAQH/MAoGCCqGSM49BAMCA0gAMEUCIDXb
+						i8bJhGbIPPawjyp/SfbY0GE+VZ8eMWao+f0Ji8xDAiEAmGgbuU6YOi36IqHUrX5/
+						4DaUyfTfyDJBhPKHdkVlcNc=
+					</X509Certificate>
+				</X509Data>
+			</KeyInfo>
+		</KeyDescriptor>
+		<NameIDFormat>
+			urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress
+		</NameIDFormat>
+		<SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://login.example.com/saml2/logout"/>
+		<SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://login.example.com/saml2"/>
+		<SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://login.example.com/saml2"/>
+	</IDPSSODescriptor>
+	<md:SPSSODescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+		<md:KeyDescriptor>
+			<ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#">
+				<ds:X509Data>
+					<ds:X509Certificate>MIIBjTCCATOgAwIBAgIUMY3uwaOlbF0vbatYJVuvSJAYGDQwCgYIKoZIzj0EAwIwGzEZMBcGA1UEAwwQc2FtbC5leGFtcGxlLmNvbTAgFw0yNjEwMTgxNzE5MTFaGA8yMTI2MDkyNDE3MTkxMVowGzEZMBcGA1UEAwwQc2FtbC5leGFtcGxlLmNvbTBZMBMGByqGSM49AgEGCCqGSM49AwEHA0IABAapoSfLAy+716gKq7lqXAYuS30QbYs8zjk/Qa+8I1RrxIqg/O2W62/7DWcPAH12WR9n37lEPH3p68GifA6LNrCjUzBRMB0GA1UdDgQWBBR7As9G9lJe0kgv5dupCFyM9UNCWDAfBgNVHSMEGDAWgBR7As9G9lJe0kgv5dupCFyM9UNCWDAPBgNVHRMBAf8EBTADAQH/MAoGCCqGSM49BAMCA0gAMEUCIDXbi8bJhGbIPPawjyp/SfbY0GE+VZ8eMWao+f0Ji8xDAiEAmGgbuU6YOi36IqHUrX5/4DaUyfTfyDJBhPKHdkVlcNc=</ds:X509Certificate>
+				</ds:X509Data>
+			</ds:KeyInfo>
+		</md:KeyDescriptor>
+		<md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://app.example.com/acs" index="1" isDefault="true"/>
+	</md:SPSSODescriptor>
+</EntityDescriptor>`
+
+func TestFlattenSamlMetadataSsoDescriptors(t *testing.T) {
+	var metadata samlMetadataEntityDescriptor
+	if err := xml.Unmarshal([]byte(samlMetadataTestDocument), &metadata); err != nil {
+		t.Fatalf("parsing metadata document: %+v", err)
+	}
+
+	if expected := "https://sts.example.com/00000000-0000-0000-0000-000000000000/"; metadata.EntityId != expected {
+		t.Errorf("expected entity ID %q, got %q", expected, metadata.EntityId)
+	}
+
+	cases := []struct {
+		TestName    string
+		Descriptors []samlMetadataSsoDescriptor
+		Expected    []interface{}
+	}{
+		{
+			TestName:    "IdentityProvider",
+			Descriptors: metadata.IdentityProviderSsos,
+			Expected: []interface{}{
+				map[string]interface{}{
+					"assertion_consumer_service": []interface{}{},
+					"encryption_certificates":    []string{},
+					"name_id_formats":            []string{"urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"},
+					"signing_certificates":       []string{samlMetadataTestCertificatePem},
+					"single_logout_service": []interface{}{
+						map[string]interface{}{
+							"binding":  "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect",
+							"default":  false,
+							"index":    0,
+							"location": "https://login.example.com/saml2/logout",
+						},
+					},
+					"single_sign_on_service": []interface{}{
+						map[string]interface{}{
+							"binding":  "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect",
+							"default":  false,
+							"index":    0,
+							"location": "https://login.example.com/saml2",
+						},
+						map[string]interface{}{
+							"binding":  "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST",
+							"default":  false,
+							"index":    0,
+							"location": "https://login.example.com/saml2",
+						},
+					},
+				},
+			},
+		},
+		{
+			TestName:    "ServiceProviderWithPrefixedNamespace",
+			Descriptors: metadata.ServiceProviderSsos,
+			Expected: []interface{}{
+				map[string]interface{}{
+					"assertion_consumer_service": []interface{}{
+						map[string]interface{}{
+							"binding":  "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST",
+							"default":  true,
+							"index":    1,
+							"location": "https://app.example.com/acs",
+						},
+					},
+					"encryption_certificates": []string{samlMetadataTestCertificatePem},
+					"name_id_formats":         []string{},
+					"signing_certificates":    []string{samlMetadataTestCertificatePem},
+					"single_logout_service":   []interface{}{},
+					"single_sign_on_service":  []interface{}{},
+				},
+			},
+		},
+		{
+			TestName:    "None",
+			Descriptors: nil,
+			Expected:    []interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			result, err := flattenSamlMetadataSsoDescriptors(tc.Descriptors)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if !reflect.DeepEqual(result, tc.Expected) {
+				t.Fatalf("expected %#v, got %#v", tc.Expected, result)
+			}
+		})
+	}
+}
+
+func TestSamlMetadataCertificateToPem(t *testing.T) {
+	cases := []struct {
+		TestName string
+		Input    string
+		Expected string
+		Error    string
+	}{
+		{
+			TestName: "Valid",
+			Input:    samlMetadataTestCertificate,
+			Expected: samlMetadataTestCertificatePem,
+		},
+		{
+			TestName: "ValidWithWhitespace",
+			Input:    "\n\t" + samlMetadataTestCertificate[:64] + "\n\t" + samlMetadataTestCertificate[64:] + "\n",
+			Expected: samlMetadataTestCertificatePem,
+		},
+		{
+			TestName: "InvalidBase64",
+			Input:    "not base64!",
+			Error:    "decoding certificate",
+		},
+		{
+			TestName: "NotACertificate",
+			Input:    "aGVsbG8gd29ybGQ=",
+			Error:    "parsing certificate",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			result, err := samlMetadataCertificateToPem(tc.Input)
+			if tc.Error != "" {
+				if err == nil {
+					t.Fatalf("expected an error containing %q, got nil", tc.Error)
+				}
+				if !strings.Contains(err.Error(), tc.Error) {
+					t.Fatalf("expected an error containing %q, got %q", tc.Error, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if result != tc.Expected {
+				t.Fatalf("expected %q, got %q", tc.Expected, result)
+			}
+		})
+	}
+}
@@ -169,7 +169,7 @@ func servicePrincipalCertificateResourceCreate(ctx context.Context, d *pluginsdk
 		KeyCredentials: &newCredentials,
 	}
 	if _, err := client.Update(ctx, properties); err != nil {
-		return tf.ErrorDiagF(err, "Adding certificate for service principal with object ID %q", id.ObjectId)
+		return helpers.CredentialErrorDiag(err, helpers.CredentialTypeCertificate, "Adding certificate for service principal with object ID %q", id.ObjectId)
 	}
 
 	// Wait for the credential to appear in the service principal manifest, this can take several minutes
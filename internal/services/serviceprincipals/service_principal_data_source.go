@@ -67,6 +67,34 @@ func servicePrincipalData() *pluginsdk.Resource {
 				Deprecated:   "The `application_id` property has been replaced with the `client_id` property and will be removed in version 3.0 of the AzureAD provider",
 			},
 
+			"include_credentials": {
+				Description: "Whether to retrieve metadata for the password and key credentials of the service principal",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"include_memberships": {
+				Description: "Whether to retrieve the groups of which the service principal is a member",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"include_owners": {
+				Description: "Whether to retrieve the owners of the service principal",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"include_policies": {
+				Description: "Whether to retrieve the claims mapping, home realm discovery, token issuance and token lifetime policies assigned to the service principal",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
 			"account_enabled": {
 				Description: "Whether or not the service principal account is enabled",
 				Type:        pluginsdk.TypeBool,
@@ -105,6 +133,33 @@ func servicePrincipalData() *pluginsdk.Resource {
 				},
 			},
 
+			"assigned_policies": {
+				Description: "A list of policies assigned to the service principal. Only populated when `include_policies` is true",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"display_name": {
+							Description: "The display name of the policy",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"policy_id": {
+							Description: "The object ID of the policy",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"policy_type": {
+							Description: "The type of the policy",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+
 			"description": {
 				Description: "Description of the service principal provided for internal end-users",
 				Type:        pluginsdk.TypeString,
@@ -178,12 +233,78 @@ func servicePrincipalData() *pluginsdk.Resource {
 				},
 			},
 
+			"group_memberships": {
+				Description: "A list of groups of which the service principal is a direct member. Only populated when `include_memberships` is true",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"display_name": {
+							Description: "The display name of the group",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"object_id": {
+							Description: "The object ID of the group",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+
 			"homepage_url": {
 				Description: "Home page or landing page of the application",
 				Type:        pluginsdk.TypeString,
 				Computed:    true,
 			},
 
+			"key_credentials": {
+				Description: "A list of key credentials (certificates) for the service principal. Only populated when `include_credentials` is true",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"display_name": {
+							Description: "The display name of the credential",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"end_date": {
+							Description: "The end date until which the credential is valid, formatted as an RFC3339 date string",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"key_id": {
+							Description: "The unique key ID of the credential",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"start_date": {
+							Description: "The start date from which the credential is valid, formatted as an RFC3339 date string",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"type": {
+							Description: "The type of the credential",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"usage": {
+							Description: "The purpose of the credential, either `Sign` or `Verify`",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+
 			"login_url": {
 				Description: "The URL where the service provider redirects the user to Azure AD to authenticate. Azure AD uses the URL to launch the application from Microsoft 365 or the Azure AD My Apps",
 				Type:        pluginsdk.TypeString,
@@ -222,6 +343,54 @@ func servicePrincipalData() *pluginsdk.Resource {
 				},
 			},
 
+			"owner_object_ids": {
+				Description: "A list of object IDs of the owners of the service principal. Only populated when `include_owners` is true",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"password_credentials": {
+				Description: "A list of password credentials for the service principal. Only populated when `include_credentials` is true",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"display_name": {
+							Description: "The display name of the credential",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"end_date": {
+							Description: "The end date until which the credential is valid, formatted as an RFC3339 date string",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"hint": {
+							Description: "The first few characters of the password",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"key_id": {
+							Description: "The unique key ID of the credential",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"start_date": {
+							Description: "The start date from which the credential is valid, formatted as an RFC3339 date string",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+
 			"preferred_single_sign_on_mode": {
 				Description: "The single sign-on mode configured for this application. Azure AD uses the preferred single sign-on mode to launch the application from Microsoft 365 or the Azure AD My Apps",
 				Type:        pluginsdk.TypeString,
@@ -254,6 +423,21 @@ func servicePrincipalData() *pluginsdk.Resource {
 							Type:        pluginsdk.TypeString,
 							Computed:    true,
 						},
+
+						"logout_url": {
+							Description: "The URL that will be used by Microsoft's authorization service to sign out a user using SAML logout",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"reply_urls": {
+							Description: "The reply URLs (assertion consumer service URLs) where SAML tokens are sent for the associated application",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
 					},
 				},
 			},
@@ -385,11 +569,73 @@ func servicePrincipalDataSourceRead(ctx context.Context, d *pluginsdk.ResourceDa
 		}
 	}
 
+	keyCredentials := make([]map[string]interface{}, 0)
+	passwordCredentials := make([]map[string]interface{}, 0)
+	if d.Get("include_credentials").(bool) {
+		keyCredentials = flattenServicePrincipalKeyCredentials(servicePrincipal.KeyCredentials)
+		passwordCredentials = flattenServicePrincipalPasswordCredentials(servicePrincipal.PasswordCredentials)
+	}
+
+	ownerIds := make([]string, 0)
+	if d.Get("include_owners").(bool) {
+		owners, _, err := client.ListOwners(ctx, d.Id())
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not retrieve owners for service principal with object ID %q", d.Id())
+		}
+		if owners != nil {
+			ownerIds = *owners
+		}
+	}
+
+	groupMemberships := make([]map[string]interface{}, 0)
+	if d.Get("include_memberships").(bool) {
+		groups, _, err := client.ListGroupMemberships(ctx, d.Id(), odata.Query{})
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not retrieve group memberships for service principal with object ID %q", d.Id())
+		}
+		if groups != nil {
+			for _, group := range *groups {
+				if group.ID() == nil {
+					continue
+				}
+				groupMemberships = append(groupMemberships, map[string]interface{}{
+					"display_name": pointer.From(group.DisplayName),
+					"object_id":    *group.ID(),
+				})
+			}
+		}
+	}
+
+	assignedPolicies := make([]map[string]interface{}, 0)
+	if d.Get("include_policies").(bool) {
+		assignedPoliciesClient := meta.(*clients.Client).ServicePrincipals.AssignedPoliciesClient
+		for _, policyType := range servicePrincipalAssignablePolicyTypes {
+			policies, _, err := assignedPoliciesClient.List(ctx, d.Id(), policyType.collection)
+			if err != nil {
+				return tf.ErrorDiagF(err, "Could not retrieve %s policies assigned to service principal with object ID %q", policyType.name, d.Id())
+			}
+			if policies == nil {
+				continue
+			}
+			for _, policy := range *policies {
+				if policy.ID == nil {
+					continue
+				}
+				assignedPolicies = append(assignedPolicies, map[string]interface{}{
+					"display_name": pointer.From(policy.DisplayName),
+					"policy_id":    *policy.ID,
+					"policy_type":  policyType.name,
+				})
+			}
+		}
+	}
+
 	tf.Set(d, "account_enabled", servicePrincipal.AccountEnabled)
 	tf.Set(d, "alternative_names", tf.FlattenStringSlicePtr(servicePrincipal.AlternativeNames))
 	tf.Set(d, "app_role_assignment_required", servicePrincipal.AppRoleAssignmentRequired)
 	tf.Set(d, "app_role_ids", helpers.ApplicationFlattenAppRoleIDs(servicePrincipal.AppRoles))
 	tf.Set(d, "app_roles", helpers.ApplicationFlattenAppRoles(servicePrincipal.AppRoles))
+	tf.Set(d, "assigned_policies", assignedPolicies)
 	tf.Set(d, "application_id", servicePrincipal.AppId)
 	tf.Set(d, "application_tenant_id", servicePrincipal.AppOwnerOrganizationId)
 	tf.Set(d, "client_id", servicePrincipal.AppId)
@@ -397,7 +643,9 @@ func servicePrincipalDataSourceRead(ctx context.Context, d *pluginsdk.ResourceDa
 	tf.Set(d, "display_name", servicePrincipal.DisplayName)
 	tf.Set(d, "feature_tags", helpers.ApplicationFlattenFeatures(servicePrincipal.Tags, false))
 	tf.Set(d, "features", helpers.ApplicationFlattenFeatures(servicePrincipal.Tags, true))
+	tf.Set(d, "group_memberships", groupMemberships)
 	tf.Set(d, "homepage_url", servicePrincipal.Homepage)
+	tf.Set(d, "key_credentials", keyCredentials)
 	tf.Set(d, "logout_url", servicePrincipal.LogoutUrl)
 	tf.Set(d, "login_url", servicePrincipal.LoginUrl)
 	tf.Set(d, "notes", servicePrincipal.Notes)
@@ -405,10 +653,12 @@ func servicePrincipalDataSourceRead(ctx context.Context, d *pluginsdk.ResourceDa
 	tf.Set(d, "oauth2_permission_scope_ids", helpers.ApplicationFlattenOAuth2PermissionScopeIDs(servicePrincipal.OAuth2PermissionScopes))
 	tf.Set(d, "oauth2_permission_scopes", helpers.ApplicationFlattenOAuth2PermissionScopes(servicePrincipal.OAuth2PermissionScopes))
 	tf.Set(d, "object_id", servicePrincipal.ID())
+	tf.Set(d, "owner_object_ids", ownerIds)
+	tf.Set(d, "password_credentials", passwordCredentials)
 	tf.Set(d, "preferred_single_sign_on_mode", servicePrincipal.PreferredSingleSignOnMode)
 	tf.Set(d, "redirect_uris", tf.FlattenStringSlicePtr(servicePrincipal.ReplyUrls))
 	tf.Set(d, "saml_metadata_url", servicePrincipal.SamlMetadataUrl)
-	tf.Set(d, "saml_single_sign_on", flattenSamlSingleSignOn(servicePrincipal.SamlSingleSignOnSettings))
+	tf.Set(d, "saml_single_sign_on", flattenSamlSingleSignOn(servicePrincipal))
 	tf.Set(d, "service_principal_names", servicePrincipalNames)
 	tf.Set(d, "sign_in_audience", servicePrincipal.SignInAudience)
 	tf.Set(d, "tags", servicePrincipal.Tags)
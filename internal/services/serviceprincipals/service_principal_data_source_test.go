@@ -94,6 +94,27 @@ func TestAccServicePrincipalDataSource_builtInByDisplayName(t *testing.T) {
 	})
 }
 
+func TestAccServicePrincipalDataSource_includeDetails(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_service_principal", "test")
+	r := ServicePrincipalDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.includeDetails(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("assigned_policies.#").HasValue("1"),
+				check.That(data.ResourceName).Key("assigned_policies.0.policy_type").HasValue("claimsMapping"),
+				check.That(data.ResourceName).Key("group_memberships.#").HasValue("1"),
+				check.That(data.ResourceName).Key("group_memberships.0.display_name").HasValue(fmt.Sprintf("acctestServicePrincipal-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("key_credentials.#").HasValue("0"),
+				check.That(data.ResourceName).Key("owner_object_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("password_credentials.#").HasValue("1"),
+				check.That(data.ResourceName).Key("password_credentials.0.display_name").HasValue("acctest-password"),
+			),
+		},
+	})
+}
+
 func (ServicePrincipalDataSource) testCheckFunc(data acceptance.TestData) acceptance.TestCheckFunc {
 	tenantId := os.Getenv("ARM_TENANT_ID")
 	return acceptance.ComposeTestCheckFunc(
@@ -194,6 +215,65 @@ data "azuread_service_principal" "test" {
 `, ServicePrincipalResource{}.complete(data))
 }
 
+func (ServicePrincipalDataSource) includeDetails(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_client_config" "current" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+  owners    = [data.azuread_client_config.current.object_id]
+}
+
+resource "azuread_service_principal_password" "test" {
+  service_principal_id = azuread_service_principal.test.object_id
+  display_name         = "acctest-password"
+}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestServicePrincipal-%[1]d"
+  security_enabled = true
+  members          = [azuread_service_principal.test.object_id]
+}
+
+resource "azuread_claims_mapping_policy" "test" {
+  display_name = "acctestServicePrincipal-%[1]d"
+  definition = [
+    jsonencode({
+      ClaimsMappingPolicy = {
+        Version              = 1
+        IncludeBasicClaimSet = "true"
+      }
+    }),
+  ]
+}
+
+resource "azuread_service_principal_claims_mapping_policy_assignment" "test" {
+  service_principal_id     = azuread_service_principal.test.id
+  claims_mapping_policy_id = azuread_claims_mapping_policy.test.id
+}
+
+data "azuread_service_principal" "test" {
+  object_id           = azuread_service_principal.test.object_id
+  include_credentials = true
+  include_memberships = true
+  include_owners      = true
+  include_policies    = true
+
+  depends_on = [
+    azuread_group.test,
+    azuread_service_principal_claims_mapping_policy_assignment.test,
+    azuread_service_principal_password.test,
+  ]
+}
+`, data.RandomInteger)
+}
+
 func (ServicePrincipalDataSource) builtInByDisplayName(data acceptance.TestData) string {
 	return `
 provider "azuread" {}
@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+
+// defaultRiskyClaimValues are the delegated permissions considered risky when `risky_claim_values` is not specified.
+// Like those specified in configuration, they are matched by name for grants to any API.
+// These permit a client to read or modify mailboxes, files or directory objects, or to escalate its own privileges.
+var defaultRiskyClaimValues = []string{
+	"AppRoleAssignment.ReadWrite.All",
+	"Application.ReadWrite.All",
+	"Directory.AccessAsUser.All",
+	"Directory.ReadWrite.All",
+	"Files.ReadWrite.All",
+	"Group.ReadWrite.All",
+	"Mail.ReadWrite",
+	"Mail.Send",
+	"MailboxSettings.ReadWrite",
+	"RoleManagement.ReadWrite.Directory",
+	"Sites.ReadWrite.All",
+	"User.ReadWrite.All",
+	"full_access_as_user",
+}
+
+func servicePrincipalDelegatedPermissionGrantsDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: servicePrincipalDelegatedPermissionGrantsDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"service_principal_object_id": {
+				Description:  "The object ID of the client service principal for which to list delegated permission grants",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"resource_service_principal_object_id": {
+				Description:  "The object ID of the resource service principal for which to list delegated permission grants",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"user_object_id": {
+				Description:  "The object ID of a user for which to list delegated permission grants",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"risky_claim_values": {
+				Description: "A list of delegated permissions to be considered risky, matched by name regardless of the API which publishes them. Defaults to a list of highly privileged Microsoft Graph and Exchange permissions",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"delegated_permission_grants": {
+				Description: "A list of delegated permission grants",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Description: "The ID of the delegated permission grant",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"claim_values": {
+							Description: "The delegated permissions granted",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"consent_type": {
+							Description: "Whether the grant applies to all users (`AllPrincipals`) or to a single user (`Principal`)",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"resource_service_principal_object_id": {
+							Description: "The object ID of the resource service principal",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"risky": {
+							Description: "Whether any of the granted delegated permissions are considered risky",
+							Type:        pluginsdk.TypeBool,
+							Computed:    true,
+						},
+
+						"risky_claim_values": {
+							Description: "The granted delegated permissions which are considered risky",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"service_principal_object_id": {
+							Description: "The object ID of the client service principal",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"user_object_id": {
+							Description: "The object ID of the user on behalf of whom the permissions are granted, when the consent type is `Principal`",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+
+			"risky_grant_ids": {
+				Description: "The IDs of delegated permission grants which include risky delegated permissions",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"risky_grants_found": {
+				Description: "Whether any delegated permission grants include risky delegated permissions",
+				Type:        pluginsdk.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func servicePrincipalDelegatedPermissionGrantsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.DelegatedPermissionGrantsClient
+
+	servicePrincipalId := d.Get("service_principal_object_id").(string)
+	resourceId := d.Get("resource_service_principal_object_id").(string)
+	userId := d.Get("user_object_id").(string)
+
+	filters := make([]string, 0)
+	if servicePrincipalId != "" {
+		filters = append(filters, fmt.Sprintf("clientId eq '%s'", servicePrincipalId))
+	}
+	if resourceId != "" {
+		filters = append(filters, fmt.Sprintf("resourceId eq '%s'", resourceId))
+	}
+	if userId != "" {
+		filters = append(filters, fmt.Sprintf("principalId eq '%s'", userId))
+	}
+
+	result, _, err := client.List(ctx, odata.Query{Filter: strings.Join(filters, " and ")})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing delegated permission grants")
+	}
+	if result == nil {
+		return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+	}
+
+	// An empty list is distinct from an unspecified one, and disables risk detection
+	riskyClaimValues := defaultRiskyClaimValues
+	if !d.GetRawConfig().GetAttr("risky_claim_values").IsNull() {
+		riskyClaimValues = tf.ExpandStringSlice(d.Get("risky_claim_values").([]interface{}))
+	}
+
+	grants := make([]interface{}, 0)
+	grantIds := make([]string, 0)
+	riskyGrantIds := make([]string, 0)
+
+	for _, grant := range *result {
+		if grant.Id == nil {
+			continue
+		}
+
+		claimValues := make([]string, 0)
+		riskyGrantClaimValues := make([]string, 0)
+		if grant.Scopes != nil {
+			for _, claimValue := range *grant.Scopes {
+				claimValues = append(claimValues, claimValue)
+				for _, riskyClaimValue := range riskyClaimValues {
+					if strings.EqualFold(claimValue, riskyClaimValue) {
+						riskyGrantClaimValues = append(riskyGrantClaimValues, claimValue)
+						break
+					}
+				}
+			}
+		}
+
+		if len(riskyGrantClaimValues) > 0 {
+			riskyGrantIds = append(riskyGrantIds, *grant.Id)
+		}
+		grantIds = append(grantIds, *grant.Id)
+
+		grants = append(grants, map[string]interface{}{
+			"id":                                   *grant.Id,
+			"claim_values":                         claimValues,
+			"consent_type":                         pointer.From(grant.ConsentType),
+			"resource_service_principal_object_id": pointer.From(grant.ResourceId),
+			"risky":                                len(riskyGrantClaimValues) > 0,
+			"risky_claim_values":                   riskyGrantClaimValues,
+			"service_principal_object_id":          pointer.From(grant.ClientId),
+			"user_object_id":                       pointer.From(grant.PrincipalId),
+		})
+	}
+
+	sort.Strings(grantIds)
+	h := sha1.New()
+	if _, err := h.Write([]byte(strings.Join(append([]string{servicePrincipalId, resourceId, userId}, grantIds...), "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for delegated permission grant IDs")
+	}
+
+	d.SetId("delegatedpermissiongrants#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	tf.Set(d, "delegated_permission_grants", grants)
+	tf.Set(d, "risky_grant_ids", riskyGrantIds)
+	tf.Set(d, "risky_grants_found", len(riskyGrantIds) > 0)
+
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ServicePrincipalDelegatedPermissionGrantsDataSource struct{}
+
+func TestAccServicePrincipalDelegatedPermissionGrantsDataSource_byServicePrincipal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_service_principal_delegated_permission_grants", "test")
+	r := ServicePrincipalDelegatedPermissionGrantsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.byServicePrincipal(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("delegated_permission_grants.#").HasValue("1"),
+				check.That(data.ResourceName).Key("delegated_permission_grants.0.claim_values.#").HasValue("2"),
+				check.That(data.ResourceName).Key("delegated_permission_grants.0.consent_type").HasValue("AllPrincipals"),
+				check.That(data.ResourceName).Key("delegated_permission_grants.0.risky").HasValue("true"),
+				check.That(data.ResourceName).Key("delegated_permission_grants.0.risky_claim_values.#").HasValue("1"),
+				check.That(data.ResourceName).Key("delegated_permission_grants.0.risky_claim_values.0").HasValue("Mail.ReadWrite"),
+				check.That(data.ResourceName).Key("risky_grant_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("risky_grants_found").HasValue("true"),
+			),
+		},
+	})
+}
+
+func TestAccServicePrincipalDelegatedPermissionGrantsDataSource_customRiskyClaimValues(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_service_principal_delegated_permission_grants", "test")
+	r := ServicePrincipalDelegatedPermissionGrantsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.customRiskyClaimValues(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("delegated_permission_grants.#").HasValue("1"),
+				check.That(data.ResourceName).Key("delegated_permission_grants.0.risky").HasValue("false"),
+				check.That(data.ResourceName).Key("risky_grant_ids.#").HasValue("0"),
+				check.That(data.ResourceName).Key("risky_grants_found").HasValue("false"),
+			),
+		},
+	})
+}
+
+func (ServicePrincipalDelegatedPermissionGrantsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_application_published_app_ids" "well_known" {}
+
+resource "azuread_service_principal" "msgraph" {
+  client_id    = data.azuread_application_published_app_ids.well_known.result.MicrosoftGraph
+  use_existing = true
+}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-DelegatedPermissionGrants-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+}
+
+resource "azuread_service_principal_delegated_permission_grant" "test" {
+  service_principal_object_id          = azuread_service_principal.test.object_id
+  resource_service_principal_object_id = azuread_service_principal.msgraph.object_id
+  claim_values                         = ["openid", "Mail.ReadWrite"]
+}
+`, data.RandomInteger)
+}
+
+func (r ServicePrincipalDelegatedPermissionGrantsDataSource) byServicePrincipal(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_service_principal_delegated_permission_grants" "test" {
+  service_principal_object_id = azuread_service_principal.test.object_id
+
+  depends_on = [azuread_service_principal_delegated_permission_grant.test]
+}
+`, r.template(data))
+}
+
+func (r ServicePrincipalDelegatedPermissionGrantsDataSource) customRiskyClaimValues(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_service_principal_delegated_permission_grants" "test" {
+  service_principal_object_id          = azuread_service_principal.test.object_id
+  resource_service_principal_object_id = azuread_service_principal.msgraph.object_id
+  risky_claim_values                   = ["Directory.ReadWrite.All"]
+
+  depends_on = [azuread_service_principal_delegated_permission_grant.test]
+}
+`, r.template(data))
+}
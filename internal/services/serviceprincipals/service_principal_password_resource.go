@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/base64"
 	"errors"
+	"fmt"
 	"log"
 	"net/http"
 	"strings"
@@ -21,17 +22,22 @@ import (
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+	"github.com/manicminer/hamilton/msgraph"
 )
 
 func servicePrincipalPasswordResource() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		CreateContext: servicePrincipalPasswordResourceCreate,
 		ReadContext:   servicePrincipalPasswordResourceRead,
+		UpdateContext: servicePrincipalPasswordResourceUpdate,
 		DeleteContext: servicePrincipalPasswordResourceDelete,
 
+		CustomizeDiff: helpers.PasswordRotationCustomizeDiff,
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(15 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
 		},
 
@@ -99,6 +105,29 @@ func servicePrincipalPasswordResource() *pluginsdk.Resource {
 				},
 			},
 
+			"rotation_days": {
+				Description:      "The number of days after which the password is rotated. A new password is created when a plan is run after this period, and the previous password is retained for the duration of `rotation_overlap`",
+				Type:             pluginsdk.TypeInt,
+				Optional:         true,
+				ConflictsWith:    []string{"end_date", "start_date"},
+				ValidateDiagFunc: validation.ValidateDiag(validation.IntAtLeast(1)),
+			},
+
+			"rotate_before_expiry": {
+				Description:      "A duration before the expiry of the password at which it is rotated, for example `720h` (30 days). A new password is created when a plan is run within this period, and the previous password is retained for the duration of `rotation_overlap`",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"end_date", "start_date"},
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotEmpty),
+			},
+
+			"rotation_overlap": {
+				Description:      "The duration for which the previous password remains valid after rotation, for example `48h`. Defaults to `24h`",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringIsNotEmpty),
+			},
+
 			"key_id": {
 				Description: "A UUID used to uniquely identify this password credential",
 				Type:        pluginsdk.TypeString,
@@ -111,6 +140,19 @@ func servicePrincipalPasswordResource() *pluginsdk.Resource {
 				Computed:    true,
 				Sensitive:   true,
 			},
+
+			"previous_key_id": {
+				Description: "The key ID of the previous password credential, which is retained for the duration of `rotation_overlap` following rotation",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"previous_value": {
+				Description: "The previous password for this service principal, which is retained for the duration of `rotation_overlap` following rotation",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
 		},
 	}
 }
@@ -145,56 +187,71 @@ func servicePrincipalPasswordResourceCreate(ctx context.Context, d *pluginsdk.Re
 		return tf.ErrorDiagF(errors.New("nil service principal or service principal with nil ID was returned"), "API error retrieving service principal with object ID %q", objectId)
 	}
 
-	newCredential, _, err := client.AddPassword(ctx, *sp.ID(), *credential)
+	newCredential, err := servicePrincipalPasswordResourceAddPassword(ctx, client, *sp.ID(), *credential)
 	if err != nil {
-		return tf.ErrorDiagF(err, "Adding password for service principal with object ID %q", *sp.ID())
-	}
-	if newCredential == nil {
-		return tf.ErrorDiagF(errors.New("nil credential received when adding password"), "API error adding password for service principal with object ID %q", *sp.ID())
-	}
-	if newCredential.KeyId == nil {
-		return tf.ErrorDiagF(errors.New("nil or empty keyId received"), "API error adding password for service principal with object ID %q", *sp.ID())
-	}
-	if newCredential.SecretText == nil || len(*newCredential.SecretText) == 0 {
-		return tf.ErrorDiagF(errors.New("nil or empty password received"), "API error adding password for service principal with object ID %q", *sp.ID())
+		return helpers.CredentialErrorDiag(err, helpers.CredentialTypePassword, "Adding password for service principal with object ID %q", *sp.ID())
 	}
 
 	id := parse.NewCredentialID(*sp.ID(), "password", *newCredential.KeyId)
 
-	// Wait for the credential to appear in the service principal manifest, this can take several minutes
-	timeout, _ := ctx.Deadline()
-	polledForCredential, err := (&pluginsdk.StateChangeConf{ //nolint:staticcheck
-		Pending:                   []string{"Waiting"},
-		Target:                    []string{"Done"},
-		Timeout:                   time.Until(timeout),
-		MinTimeout:                1 * time.Second,
-		ContinuousTargetOccurence: 5,
-		Refresh: func() (interface{}, string, error) {
-			servicePrincipal, _, err := client.Get(ctx, id.ObjectId, odata.Query{})
-			if err != nil {
-				return nil, "Error", err
-			}
+	d.SetId(id.String())
+	d.Set("value", newCredential.SecretText)
 
-			if servicePrincipal.PasswordCredentials != nil {
-				for _, cred := range *servicePrincipal.PasswordCredentials {
-					if cred.KeyId != nil && strings.EqualFold(*cred.KeyId, id.KeyId) {
-						return &cred, "Done", nil
-					}
-				}
-			}
+	return servicePrincipalPasswordResourceRead(ctx, d, meta)
+}
 
-			return nil, "Waiting", nil
-		},
-	}).WaitForStateContext(ctx)
+func servicePrincipalPasswordResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.ServicePrincipalsClient
 
+	id, err := parse.PasswordID(d.Id())
 	if err != nil {
-		return tf.ErrorDiagF(err, "Waiting for password credential for service principal with object ID %q", id.ObjectId)
-	} else if polledForCredential == nil {
-		return tf.ErrorDiagF(errors.New("password credential not found in service principal manifest"), "Waiting for password credential for service principal with object ID %q", id.ObjectId)
+		return tf.ErrorDiagPathF(err, "id", "Parsing password credential with ID %q", d.Id())
 	}
 
-	d.SetId(id.String())
-	d.Set("value", newCredential.SecretText)
+	tf.LockByName(servicePrincipalResourceName, id.ObjectId)
+	defer tf.UnlockByName(servicePrincipalResourceName, id.ObjectId)
+
+	oldPreviousKeyId, newPreviousKeyId := d.GetChange("previous_key_id")
+
+	if d.HasChange("key_id") {
+		// The password is due for rotation, so add a new password and retain the current one as the previous password
+		credential, err := helpers.PasswordCredentialForRotation(d)
+		if err != nil {
+			attr := ""
+			if kerr, ok := err.(helpers.CredentialError); ok {
+				attr = kerr.Attr()
+			}
+			return tf.ErrorDiagPathF(err, attr, "Generating password credentials for service principal with object ID %q", id.ObjectId)
+		}
+
+		newCredential, err := servicePrincipalPasswordResourceAddPassword(ctx, client, id.ObjectId, *credential)
+		if err != nil {
+			return helpers.CredentialErrorDiag(err, helpers.CredentialTypePassword, "Rotating password for service principal with object ID %q", id.ObjectId)
+		}
+
+		// Any password retained from an earlier rotation is superseded
+		if v := oldPreviousKeyId.(string); v != "" {
+			if err = servicePrincipalPasswordResourceRemovePassword(ctx, client, id.ObjectId, v); err != nil {
+				return tf.ErrorDiagF(err, "Removing previous password credential %q from service principal with object ID %q", v, id.ObjectId)
+			}
+		}
+
+		oldValue, _ := d.GetChange("value")
+		newId := parse.NewCredentialID(id.ObjectId, "password", *newCredential.KeyId)
+
+		d.SetId(newId.String())
+		tf.Set(d, "previous_key_id", id.KeyId)
+		tf.Set(d, "previous_value", oldValue)
+		tf.Set(d, "value", newCredential.SecretText)
+	} else if v := oldPreviousKeyId.(string); v != "" && newPreviousKeyId.(string) == "" {
+		// The rotation overlap period has passed, so remove the previous password
+		if err = servicePrincipalPasswordResourceRemovePassword(ctx, client, id.ObjectId, v); err != nil {
+			return tf.ErrorDiagF(err, "Removing previous password credential %q from service principal with object ID %q", v, id.ObjectId)
+		}
+
+		tf.Set(d, "previous_key_id", "")
+		tf.Set(d, "previous_value", "")
+	}
 
 	return servicePrincipalPasswordResourceRead(ctx, d, meta)
 }
@@ -235,6 +292,12 @@ func servicePrincipalPasswordResourceRead(ctx context.Context, d *pluginsdk.Reso
 	}
 
 	tf.Set(d, "key_id", id.KeyId)
+
+	if v := d.Get("previous_key_id").(string); v != "" && helpers.GetPasswordCredential(servicePrincipal.PasswordCredentials, v) == nil {
+		log.Printf("[DEBUG] Previous password credential %q (ID %q) was not found - removing from state!", v, id.ObjectId)
+		tf.Set(d, "previous_key_id", "")
+		tf.Set(d, "previous_value", "")
+	}
 	tf.Set(d, "service_principal_id", id.ObjectId)
 
 	startDate := ""
@@ -263,29 +326,90 @@ func servicePrincipalPasswordResourceDelete(ctx context.Context, d *pluginsdk.Re
 	tf.LockByName(servicePrincipalResourceName, id.ObjectId)
 	defer tf.UnlockByName(servicePrincipalResourceName, id.ObjectId)
 
-	if _, err := client.RemovePassword(ctx, id.ObjectId, id.KeyId); err != nil {
+	if err = servicePrincipalPasswordResourceRemovePassword(ctx, client, id.ObjectId, id.KeyId); err != nil {
 		return tf.ErrorDiagF(err, "Removing password credential %q from service principal with object ID %q", id.KeyId, id.ObjectId)
 	}
 
-	// Wait for service principal password to be deleted
-	if err := helpers.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+	if v := d.Get("previous_key_id").(string); v != "" {
+		if err = servicePrincipalPasswordResourceRemovePassword(ctx, client, id.ObjectId, v); err != nil {
+			return tf.ErrorDiagF(err, "Removing previous password credential %q from service principal with object ID %q", v, id.ObjectId)
+		}
+	}
+
+	return nil
+}
+
+// servicePrincipalPasswordResourceAddPassword adds a password to a service principal and waits for it to appear in the
+// service principal manifest, which can take several minutes
+func servicePrincipalPasswordResourceAddPassword(ctx context.Context, client *msgraph.ServicePrincipalsClient, objectId string, credential msgraph.PasswordCredential) (*msgraph.PasswordCredential, error) {
+	newCredential, _, err := client.AddPassword(ctx, objectId, credential)
+	if err != nil {
+		return nil, err
+	}
+	if newCredential == nil {
+		return nil, errors.New("nil credential received when adding password")
+	}
+	if newCredential.KeyId == nil {
+		return nil, errors.New("nil or empty keyId received")
+	}
+	if newCredential.SecretText == nil || len(*newCredential.SecretText) == 0 {
+		return nil, errors.New("nil or empty password received")
+	}
+
+	timeout, _ := ctx.Deadline()
+	polledForCredential, err := (&pluginsdk.StateChangeConf{ //nolint:staticcheck
+		Pending:                   []string{"Waiting"},
+		Target:                    []string{"Done"},
+		Timeout:                   time.Until(timeout),
+		MinTimeout:                1 * time.Second,
+		ContinuousTargetOccurence: 5,
+		Refresh: func() (interface{}, string, error) {
+			servicePrincipal, _, err := client.Get(ctx, objectId, odata.Query{})
+			if err != nil {
+				return nil, "Error", err
+			}
+
+			if servicePrincipal.PasswordCredentials != nil {
+				for _, cred := range *servicePrincipal.PasswordCredentials {
+					if cred.KeyId != nil && strings.EqualFold(*cred.KeyId, *newCredential.KeyId) {
+						return &cred, "Done", nil
+					}
+				}
+			}
+
+			return nil, "Waiting", nil
+		},
+	}).WaitForStateContext(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for password credential: %+v", err)
+	} else if polledForCredential == nil {
+		return nil, errors.New("password credential not found in service principal manifest")
+	}
+
+	return newCredential, nil
+}
+
+// servicePrincipalPasswordResourceRemovePassword removes a password from a service principal and waits for it to be deleted
+func servicePrincipalPasswordResourceRemovePassword(ctx context.Context, client *msgraph.ServicePrincipalsClient, objectId, keyId string) error {
+	if _, err := client.RemovePassword(ctx, objectId, keyId); err != nil {
+		return err
+	}
+
+	return helpers.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
 		defer func() { client.BaseClient.DisableRetries = false }()
 		client.BaseClient.DisableRetries = true
 
-		servicePrincipal, _, err := client.Get(ctx, id.ObjectId, odata.Query{})
+		servicePrincipal, _, err := client.Get(ctx, objectId, odata.Query{})
 		if err != nil {
 			return nil, err
 		}
 
-		credential := helpers.GetPasswordCredential(servicePrincipal.PasswordCredentials, id.KeyId)
+		credential := helpers.GetPasswordCredential(servicePrincipal.PasswordCredentials, keyId)
 		if credential == nil {
 			return pointer.To(false), nil
 		}
 
 		return pointer.To(true), nil
-	}); err != nil {
-		return tf.ErrorDiagF(err, "Waiting for deletion of password credential %q from service principal with object ID %q", id.KeyId, id.ObjectId)
-	}
-
-	return nil
+	})
 }
@@ -78,6 +78,36 @@ func TestAccServicePrincipalPassword_relativeEndDate(t *testing.T) {
 	})
 }
 
+func TestAccServicePrincipalPassword_rotation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_password", "test")
+	r := ServicePrincipalPasswordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rotation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_id").Exists(),
+				check.That(data.ResourceName).Key("previous_key_id").HasValue(""),
+				check.That(data.ResourceName).Key("value").Exists(),
+			),
+			// The password is always within the rotation window, so it is rotated on every plan
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			Config: r.rotation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_id").Exists(),
+				check.That(data.ResourceName).Key("previous_key_id").IsUuid(),
+				check.That(data.ResourceName).Key("previous_value").Exists(),
+				check.That(data.ResourceName).Key("value").Exists(),
+			),
+			ExpectNonEmptyPlan: true,
+		},
+	})
+}
+
 func (r ServicePrincipalPasswordResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.ServicePrincipals.ServicePrincipalsClient
 	client.BaseClient.DisableRetries = true
@@ -153,3 +183,17 @@ resource "azuread_service_principal_password" "test" {
 }
 `, r.template(data), data.RandomString)
 }
+
+func (r ServicePrincipalPasswordResource) rotation(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_password" "test" {
+  service_principal_id = azuread_service_principal.test.object_id
+  display_name         = "terraform-%[2]s"
+  end_date_relative    = "240h"
+  rotate_before_expiry = "240h"
+  rotation_overlap     = "1h"
+}
+`, r.template(data), data.RandomString)
+}
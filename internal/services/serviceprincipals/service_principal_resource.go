@@ -317,6 +317,24 @@ func servicePrincipalResource() *pluginsdk.Resource {
 							Optional:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
+
+						"logout_url": {
+							Description:  "The URL that will be used by Microsoft's authorization service to sign out a user using SAML logout",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsLogoutUrl,
+						},
+
+						"reply_urls": {
+							Description: "The reply URLs (assertion consumer service URLs) where SAML tokens are sent for the associated application. The first URL is the default",
+							Type:        pluginsdk.TypeList,
+							Optional:    true,
+							MaxItems:    256,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.IsRedirectUriFunc(true, false),
+							},
+						},
 					},
 				},
 			},
@@ -353,7 +371,12 @@ func servicePrincipalDiffSuppress(k, old, new string, d *pluginsdk.ResourceData)
 		if len(samlSingleSignOnRaw) == 1 {
 			suppress = true
 			samlSingleSignOn := samlSingleSignOnRaw[0].(map[string]interface{})
-			if v, ok := samlSingleSignOn["relay_state"]; ok && v.(string) != "" {
+			for _, key := range []string{"logout_url", "relay_state"} {
+				if v, ok := samlSingleSignOn[key]; ok && v.(string) != "" {
+					suppress = false
+				}
+			}
+			if v, ok := samlSingleSignOn["reply_urls"]; ok && len(v.([]interface{})) > 0 {
 				suppress = false
 			}
 		}
@@ -431,6 +454,14 @@ func servicePrincipalResourceCreate(ctx context.Context, d *pluginsdk.ResourceDa
 		Tags:                       &tags,
 	}
 
+	logoutUrl, replyUrls := expandSamlSingleSignOnUrls(d.Get("saml_single_sign_on").([]interface{}))
+	if logoutUrl != "" {
+		properties.LogoutUrl = pointer.To(logoutUrl)
+	}
+	if len(replyUrls) > 0 {
+		properties.ReplyUrls = &replyUrls
+	}
+
 	// Sort the owners into two slices, the first containing up to 20 and the rest overflowing to the second slice
 	// The calling principal should always be in the first slice of owners
 	callerObject, _, err := directoryObjectsClient.Get(ctx, callerId, odata.Query{})
@@ -553,6 +584,15 @@ func servicePrincipalResourceUpdate(ctx context.Context, d *pluginsdk.ResourceDa
 		Tags:                       &tags,
 	}
 
+	// Send the logout URL and reply URLs whenever they change, so that they are cleared when removed from configuration
+	logoutUrl, replyUrls := expandSamlSingleSignOnUrls(d.Get("saml_single_sign_on").([]interface{}))
+	if d.HasChange("saml_single_sign_on.0.logout_url") {
+		properties.LogoutUrl = pointer.To(logoutUrl)
+	}
+	if d.HasChange("saml_single_sign_on.0.reply_urls") {
+		properties.ReplyUrls = &replyUrls
+	}
+
 	if _, err := client.Update(ctx, properties); err != nil {
 		return tf.ErrorDiagF(err, "Updating service principal with object ID: %q", d.Id())
 	}
@@ -642,7 +682,7 @@ func servicePrincipalResourceRead(ctx context.Context, d *pluginsdk.ResourceData
 	tf.Set(d, "preferred_single_sign_on_mode", servicePrincipal.PreferredSingleSignOnMode)
 	tf.Set(d, "redirect_uris", tf.FlattenStringSlicePtr(servicePrincipal.ReplyUrls))
 	tf.Set(d, "saml_metadata_url", servicePrincipal.SamlMetadataUrl)
-	tf.Set(d, "saml_single_sign_on", flattenSamlSingleSignOn(servicePrincipal.SamlSingleSignOnSettings))
+	tf.Set(d, "saml_single_sign_on", filterUnmanagedSamlSingleSignOnUrls(flattenSamlSingleSignOn(servicePrincipal), d.Get("saml_single_sign_on").([]interface{})))
 	tf.Set(d, "service_principal_names", servicePrincipalNames)
 	tf.Set(d, "sign_in_audience", servicePrincipal.SignInAudience)
 	tf.Set(d, "tags", servicePrincipal.Tags)
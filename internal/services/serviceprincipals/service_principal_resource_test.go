@@ -313,6 +313,37 @@ func TestAccServicePrincipal_fromApplicationTemplate(t *testing.T) {
 	})
 }
 
+func TestAccServicePrincipal_samlSingleSignOn(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
+	r := ServicePrincipalResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.samlSingleSignOn(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("saml_single_sign_on.0.logout_url").HasValue(fmt.Sprintf("https://test-%d.internal/saml/logout", data.RandomInteger)),
+				check.That(data.ResourceName).Key("saml_single_sign_on.0.relay_state").HasValue("/samlHome"),
+				check.That(data.ResourceName).Key("saml_single_sign_on.0.reply_urls.#").HasValue("2"),
+				check.That(data.ResourceName).Key("saml_single_sign_on.0.reply_urls.0").HasValue(fmt.Sprintf("https://test-%d.internal/saml/acs", data.RandomInteger)),
+			),
+		},
+		data.ImportStep("use_existing", "saml_single_sign_on.0.logout_url", "saml_single_sign_on.0.reply_urls"),
+		{
+			Config: r.samlSingleSignOnRelayStateOnly(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("logout_url").IsEmpty(),
+				check.That(data.ResourceName).Key("redirect_uris.#").HasValue("0"),
+				check.That(data.ResourceName).Key("saml_single_sign_on.0.logout_url").IsEmpty(),
+				check.That(data.ResourceName).Key("saml_single_sign_on.0.relay_state").HasValue("/samlHome"),
+				check.That(data.ResourceName).Key("saml_single_sign_on.0.reply_urls.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("use_existing"),
+	})
+}
+
 func TestAccServicePrincipal_deprecatedId(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
 	r := ServicePrincipalResource{}
@@ -677,6 +708,74 @@ resource "azuread_service_principal" "test" {
 `, data.RandomInteger, testApplicationTemplateId)
 }
 
+func (ServicePrincipalResource) samlSingleSignOn(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_client_config" "test" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+  template_id  = "%[2]s"
+  owners       = [data.azuread_client_config.test.object_id]
+}
+
+resource "azuread_service_principal" "test" {
+  client_id    = azuread_application.test.client_id
+  owners       = [data.azuread_client_config.test.object_id]
+  use_existing = true
+
+  login_url                     = "https://test-%[1]d.internal/login"
+  preferred_single_sign_on_mode = "saml"
+
+  notification_email_addresses = [
+    "alerts.internal@hashitown.net",
+  ]
+
+  saml_single_sign_on {
+    logout_url  = "https://test-%[1]d.internal/saml/logout"
+    relay_state = "/samlHome"
+
+    reply_urls = [
+      "https://test-%[1]d.internal/saml/acs",
+      "https://test-%[1]d.internal/saml/acs2",
+    ]
+  }
+}
+`, data.RandomInteger, testApplicationTemplateId)
+}
+
+func (ServicePrincipalResource) samlSingleSignOnRelayStateOnly(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_client_config" "test" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+  template_id  = "%[2]s"
+  owners       = [data.azuread_client_config.test.object_id]
+}
+
+resource "azuread_service_principal" "test" {
+  client_id    = azuread_application.test.client_id
+  owners       = [data.azuread_client_config.test.object_id]
+  use_existing = true
+
+  login_url                     = "https://test-%[1]d.internal/login"
+  preferred_single_sign_on_mode = "saml"
+
+  notification_email_addresses = [
+    "alerts.internal@hashitown.net",
+  ]
+
+  saml_single_sign_on {
+    relay_state = "/samlHome"
+  }
+}
+`, data.RandomInteger, testApplicationTemplateId)
+}
+
 func (ServicePrincipalResource) threeServicePrincipalsABC(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
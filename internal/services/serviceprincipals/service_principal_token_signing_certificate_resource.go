@@ -5,12 +5,9 @@ package serviceprincipals
 
 import (
 	"context"
-	"errors"
-	"fmt"
 	"log"
 	"net/http"
 	"regexp"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -116,54 +113,10 @@ func servicePrincipalTokenSigningCertificateResourceCreate(ctx context.Context,
 	tf.LockByName(servicePrincipalResourceName, objectId)
 	defer tf.UnlockByName(servicePrincipalResourceName, objectId)
 
-	key, _, err := client.AddTokenSigningCertificate(ctx, objectId, keyCreds)
+	credential, err := addTokenSigningCertificate(ctx, client, objectId, keyCreds)
 	if err != nil {
 		return tf.ErrorDiagF(err, "Could not add token signing certificate to service principal with object ID: %q", objectId)
 	}
-
-	// Wait for the credential to appear in the service principal manifest, this can take several minutes
-	timeout, _ := ctx.Deadline()
-	polledForCredential, err := (&pluginsdk.StateChangeConf{ //nolint:staticcheck
-		Pending:                   []string{"Waiting"},
-		Target:                    []string{"Done"},
-		Timeout:                   time.Until(timeout),
-		MinTimeout:                1 * time.Second,
-		ContinuousTargetOccurence: 5,
-		Refresh: func() (interface{}, string, error) {
-			servicePrincipal, _, err := client.Get(ctx, objectId, odata.Query{})
-			if err != nil {
-				return nil, "Error", err
-			}
-
-			if servicePrincipal.KeyCredentials != nil {
-				for _, cred := range *servicePrincipal.KeyCredentials {
-					if cred.KeyId != nil && strings.EqualFold(*cred.KeyId, *key.KeyId) {
-						return &cred, "Done", nil
-					}
-				}
-			}
-
-			return nil, "Waiting", nil
-		},
-	}).WaitForStateContext(ctx)
-
-	if err != nil {
-		return tf.ErrorDiagF(err, "Waiting for token_signing_certificate credential for service principal with object ID %q", objectId)
-	} else if polledForCredential == nil {
-		return tf.ErrorDiagF(errors.New("certificate credential not found in service principal manifest"), "Waiting for certificate credential for service principal with object ID %q", objectId)
-	}
-
-	// Workaround b/c the returned keyId is for the Sign key, rather than Verify key,
-	// so we need to get the Verify keyId based on the customKeyIdentifier
-	servicePrincipal, _, err := client.Get(ctx, objectId, odata.Query{})
-	if err != nil {
-		return tf.ErrorDiagF(err, "Could not get service principal with object ID: %q", objectId)
-	}
-	credential := helpers.GetVerifyKeyCredentialFromCustomKeyId(servicePrincipal.KeyCredentials, *key.CustomKeyIdentifier)
-
-	if credential == nil {
-		return tf.ErrorDiagF(errors.New("returned credential was nil"), "Could not determine key ID for newly added token signing certificate on service principal %q", objectId)
-	}
 	id := parse.NewCredentialID(objectId, "tokenSigningCertificate", *credential.KeyId)
 
 	d.SetId(id.String())
@@ -241,70 +194,9 @@ func servicePrincipalTokenSigningCertificateResourceDelete(ctx context.Context,
 	tf.LockByName(servicePrincipalResourceName, id.ObjectId)
 	defer tf.UnlockByName(servicePrincipalResourceName, id.ObjectId)
 
-	app, status, err := client.Get(ctx, id.ObjectId, odata.Query{})
-	if err != nil {
-		if status == http.StatusNotFound {
-			return tf.ErrorDiagPathF(fmt.Errorf("Service Principal was not found"), "service_principal_id", "Retrieving service principal with object ID %q", id.ObjectId)
-		}
-		return tf.ErrorDiagPathF(err, "service_principal_id", "Retrieving service principal with object ID %q", id.ObjectId)
-	}
-
-	// use CustomKeyIdentifier to determine which certs and passwords
-	// are associated together.
-	customKeyId := ""
-	newKeyCredentials := make([]msgraph.KeyCredential, 0)
-	if app.KeyCredentials != nil {
-		for _, cred := range *app.KeyCredentials {
-			if cred.KeyId != nil && !strings.EqualFold(*cred.KeyId, id.KeyId) {
-				customKeyId = *cred.CustomKeyIdentifier
-			}
-		}
-		for _, cred := range *app.KeyCredentials {
-			if cred.KeyId != nil && !strings.EqualFold(*cred.CustomKeyIdentifier, customKeyId) {
-				newKeyCredentials = append(newKeyCredentials, cred)
-			}
-		}
-	}
-
-	newPasswordCredentials := make([]msgraph.PasswordCredential, 0)
-	if app.PasswordCredentials != nil {
-		for _, cred := range *app.PasswordCredentials {
-			if cred.KeyId != nil && !strings.EqualFold(*cred.CustomKeyIdentifier, customKeyId) {
-				newPasswordCredentials = append(newPasswordCredentials, cred)
-			}
-		}
-	}
-
-	properties := msgraph.ServicePrincipal{
-		DirectoryObject: msgraph.DirectoryObject{
-			Id: &id.ObjectId,
-		},
-		KeyCredentials:      &newKeyCredentials,
-		PasswordCredentials: &newPasswordCredentials,
-	}
-	if _, err := client.Update(ctx, properties); err != nil {
+	if err := removeTokenSigningCertificates(ctx, client, id.ObjectId, []string{id.KeyId}); err != nil {
 		return tf.ErrorDiagF(err, "Removing token signing certificate credentials %q from service principal with object ID %q", id.KeyId, id.ObjectId)
 	}
 
-	// Wait for service principal token signing certificate to be deleted
-	if err := helpers.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
-		defer func() { client.BaseClient.DisableRetries = false }()
-		client.BaseClient.DisableRetries = true
-
-		servicePrincipal, _, err := client.Get(ctx, id.ObjectId, odata.Query{})
-		if err != nil {
-			return nil, err
-		}
-
-		credential := helpers.GetKeyCredential(servicePrincipal.KeyCredentials, id.KeyId)
-		if credential == nil {
-			return pointer.To(false), nil
-		}
-
-		return pointer.To(true), nil
-	}); err != nil {
-		return tf.ErrorDiagF(err, "Waiting for deletion of token signing certificate credential %q from service principal with object ID %q", id.KeyId, id.ObjectId)
-	}
-
 	return nil
 }
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals
+
+import (
+	"testing"
+	"time"
+)
+
+type tokenSigningCertificateRotationArguments map[string]interface{}
+
+func (a tokenSigningCertificateRotationArguments) Get(key string) interface{} {
+	return a[key]
+}
+
+func TestTokenSigningCertificateRotationScheduleDue(t *testing.T) {
+	date := func(value string) time.Time {
+		result, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", value, err)
+		}
+		return result
+	}
+
+	cases := []struct {
+		TestName               string
+		RotationDays           int
+		RotateBeforeExpiryDays int
+		StagingDays            int
+		GracePeriodDays        int
+		Now                    string
+		HasNext                bool
+		HasPrevious            bool
+		Stage                  bool
+		Rotate                 bool
+		Cleanup                bool
+	}{
+		{
+			TestName:               "NothingDue",
+			RotateBeforeExpiryDays: 30,
+			StagingDays:            7,
+			GracePeriodDays:        7,
+			Now:                    "2024-06-01T00:00:00Z",
+		},
+		{
+			TestName:               "StagingDue",
+			RotateBeforeExpiryDays: 30,
+			StagingDays:            7,
+			GracePeriodDays:        7,
+			Now:                    "2024-11-25T00:00:00Z",
+			Stage:                  true,
+		},
+		{
+			TestName:               "StagingDueWithExistingNextCertificate",
+			RotateBeforeExpiryDays: 30,
+			StagingDays:            7,
+			GracePeriodDays:        7,
+			Now:                    "2024-11-25T00:00:00Z",
+			HasNext:                true,
+		},
+		{
+			TestName:               "RotationDueWithExistingNextCertificate",
+			RotateBeforeExpiryDays: 30,
+			StagingDays:            7,
+			GracePeriodDays:        7,
+			Now:                    "2024-12-02T00:00:00Z",
+			HasNext:                true,
+			Rotate:                 true,
+		},
+		{
+			TestName:               "RotationDueWithoutNextCertificate",
+			RotateBeforeExpiryDays: 30,
+			StagingDays:            7,
+			GracePeriodDays:        7,
+			Now:                    "2024-12-02T00:00:00Z",
+			Stage:                  true,
+			Rotate:                 true,
+		},
+		{
+			TestName:               "ZeroStagingDaysBeforeRotation",
+			RotateBeforeExpiryDays: 30,
+			GracePeriodDays:        7,
+			Now:                    "2024-12-01T23:59:59Z",
+		},
+		{
+			TestName:               "ZeroStagingDaysAtRotation",
+			RotateBeforeExpiryDays: 30,
+			GracePeriodDays:        7,
+			Now:                    "2024-12-02T00:00:00Z",
+			Stage:                  true,
+			Rotate:                 true,
+		},
+		{
+			TestName:               "ZeroGracePeriodDays",
+			RotateBeforeExpiryDays: 30,
+			StagingDays:            7,
+			Now:                    "2024-01-01T00:00:00Z",
+			HasPrevious:            true,
+			Cleanup:                true,
+		},
+		{
+			TestName:               "ZeroGracePeriodDaysWithoutPreviousCertificate",
+			RotateBeforeExpiryDays: 30,
+			StagingDays:            7,
+			Now:                    "2024-01-01T00:00:00Z",
+		},
+		{
+			TestName:               "GracePeriodNotElapsed",
+			RotateBeforeExpiryDays: 30,
+			StagingDays:            7,
+			GracePeriodDays:        7,
+			Now:                    "2024-01-07T23:59:59Z",
+			HasPrevious:            true,
+		},
+		{
+			TestName:               "RotationDaysBeforeExpiryStaging",
+			RotationDays:           90,
+			RotateBeforeExpiryDays: 30,
+			StagingDays:            7,
+			GracePeriodDays:        7,
+			Now:                    "2024-03-24T00:00:00Z",
+			HasPrevious:            true,
+			Stage:                  true,
+			Cleanup:                true,
+		},
+		{
+			TestName:               "RotationDaysBeforeExpiryRotation",
+			RotationDays:           90,
+			RotateBeforeExpiryDays: 30,
+			StagingDays:            7,
+			GracePeriodDays:        7,
+			Now:                    "2024-03-31T00:00:00Z",
+			HasNext:                true,
+			Rotate:                 true,
+		},
+		{
+			TestName:               "RotationDaysAfterExpiry",
+			RotationDays:           400,
+			RotateBeforeExpiryDays: 30,
+			StagingDays:            7,
+			GracePeriodDays:        7,
+			Now:                    "2024-12-01T23:59:59Z",
+			HasNext:                true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			schedule := expandTokenSigningCertificateRotationSchedule(tokenSigningCertificateRotationArguments{
+				"current_end_date":          "2025-01-01T00:00:00Z",
+				"grace_period_days":         tc.GracePeriodDays,
+				"last_rotation_date":        "2024-01-01T00:00:00Z",
+				"rotate_before_expiry_days": tc.RotateBeforeExpiryDays,
+				"rotation_days":             tc.RotationDays,
+				"staging_days":              tc.StagingDays,
+			})
+			if schedule == nil {
+				t.Fatalf("expected a schedule, got nil")
+			}
+
+			stage, rotate, cleanup := schedule.due(date(tc.Now), tc.HasNext, tc.HasPrevious)
+			if stage != tc.Stage {
+				t.Errorf("expected stage to be %t, got %t", tc.Stage, stage)
+			}
+			if rotate != tc.Rotate {
+				t.Errorf("expected rotate to be %t, got %t", tc.Rotate, rotate)
+			}
+			if cleanup != tc.Cleanup {
+				t.Errorf("expected cleanup to be %t, got %t", tc.Cleanup, cleanup)
+			}
+		})
+	}
+}
+
+func TestExpandTokenSigningCertificateRotationScheduleUnknown(t *testing.T) {
+	schedule := expandTokenSigningCertificateRotationSchedule(tokenSigningCertificateRotationArguments{
+		"current_end_date":          "",
+		"grace_period_days":         7,
+		"last_rotation_date":        "",
+		"rotate_before_expiry_days": 30,
+		"rotation_days":             0,
+		"staging_days":              7,
+	})
+	if schedule != nil {
+		t.Fatalf("expected nil schedule when the active certificate is not known, got %+v", schedule)
+	}
+}
@@ -0,0 +1,517 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+func servicePrincipalTokenSigningCertificateRotationResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: servicePrincipalTokenSigningCertificateRotationResourceCreate,
+		ReadContext:   servicePrincipalTokenSigningCertificateRotationResourceRead,
+		UpdateContext: servicePrincipalTokenSigningCertificateRotationResourceUpdate,
+		DeleteContext: servicePrincipalTokenSigningCertificateRotationResourceDelete,
+
+		CustomizeDiff: servicePrincipalTokenSigningCertificateRotationResourceCustomizeDiff,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"service_principal_id": {
+				Description:      "The object ID of the service principal for which token signing certificates should be rotated",
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.IsUUID),
+			},
+
+			"display_name": {
+				Description:      "A friendly name for new certificates, which must start with `CN=`",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.StringMatch(regexp.MustCompile("^CN=.+$"), "display_name must start with `CN=`")),
+			},
+
+			"certificate_validity_days": {
+				Description:  "The number of days for which new certificates are valid. Defaults to 3 years",
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 1095),
+			},
+
+			"rotation_days": {
+				Description:  "The number of days after which the active certificate is rotated",
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"rotate_before_expiry_days": {
+				Description:  "The number of days before the active certificate expires, at which it is rotated",
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"staging_days": {
+				Description:  "The number of days before a rotation, at which the next certificate is added to the service principal",
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      14,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"grace_period_days": {
+				Description:  "The number of days after a rotation, at which the previous certificate is removed from the service principal",
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      7,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"current_certificate": {
+				Description: "The PEM encoded active certificate",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"current_end_date": {
+				Description: "The end date until which the active certificate is valid, formatted as an RFC3339 date string",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"current_key_id": {
+				Description: "The key ID of the active certificate",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"current_thumbprint": {
+				Description: "The thumbprint of the active certificate",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"last_rotation_date": {
+				Description: "The date at which the active certificate became active, formatted as an RFC3339 date string",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"next_certificate": {
+				Description: "The PEM encoded certificate which will become active at the next rotation, when staged",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"next_end_date": {
+				Description: "The end date until which the staged certificate is valid, formatted as an RFC3339 date string",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"next_key_id": {
+				Description: "The key ID of the staged certificate",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"next_rotation_date": {
+				Description: "The date at which the active certificate is due to be rotated, formatted as an RFC3339 date string",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"next_thumbprint": {
+				Description: "The thumbprint of the staged certificate",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"previous_key_id": {
+				Description: "The key ID of the previously active certificate, during the grace period after a rotation",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"previous_thumbprint": {
+				Description: "The thumbprint of the previously active certificate, during the grace period after a rotation",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// tokenSigningCertificateRotationGetter is satisfied by both *pluginsdk.ResourceData and *pluginsdk.ResourceDiff
+type tokenSigningCertificateRotationGetter interface {
+	Get(string) interface{}
+}
+
+// tokenSigningCertificateRotationSchedule describes when certificates are staged, rotated and removed
+type tokenSigningCertificateRotationSchedule struct {
+	rotation time.Time
+	staging  time.Time
+	cleanup  time.Time
+}
+
+// expandTokenSigningCertificateRotationSchedule calculates the rotation schedule from the arguments and the state of the
+// active certificate. It returns nil when the active certificate is not yet known.
+func expandTokenSigningCertificateRotationSchedule(d tokenSigningCertificateRotationGetter) *tokenSigningCertificateRotationSchedule {
+	lastRotation, err := time.Parse(time.RFC3339, d.Get("last_rotation_date").(string))
+	if err != nil {
+		return nil
+	}
+	currentEndDate, err := time.Parse(time.RFC3339, d.Get("current_end_date").(string))
+	if err != nil {
+		return nil
+	}
+
+	rotation := currentEndDate.AddDate(0, 0, -d.Get("rotate_before_expiry_days").(int))
+	if v := d.Get("rotation_days").(int); v > 0 {
+		if scheduled := lastRotation.AddDate(0, 0, v); scheduled.Before(rotation) {
+			rotation = scheduled
+		}
+	}
+
+	return &tokenSigningCertificateRotationSchedule{
+		rotation: rotation,
+		staging:  rotation.AddDate(0, 0, -d.Get("staging_days").(int)),
+		cleanup:  lastRotation.AddDate(0, 0, d.Get("grace_period_days").(int)),
+	}
+}
+
+// due returns whether the next certificate should be staged, whether the active certificate should be rotated, and
+// whether the previous certificate should be removed
+func (s tokenSigningCertificateRotationSchedule) due(now time.Time, hasNext, hasPrevious bool) (stage, rotate, cleanup bool) {
+	rotate = !now.Before(s.rotation)
+	stage = !hasNext && (rotate || !now.Before(s.staging))
+	cleanup = hasPrevious && !now.Before(s.cleanup)
+	return
+}
+
+func servicePrincipalTokenSigningCertificateRotationResourceCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	if validityDays := diff.Get("certificate_validity_days").(int); validityDays > 0 {
+		if rotateBeforeExpiryDays := diff.Get("rotate_before_expiry_days").(int); rotateBeforeExpiryDays >= validityDays {
+			return fmt.Errorf("`rotate_before_expiry_days` (%d) must be less than `certificate_validity_days` (%d)", rotateBeforeExpiryDays, validityDays)
+		}
+	}
+
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if diff.HasChanges("rotation_days", "rotate_before_expiry_days") {
+		if err := diff.SetNewComputed("next_rotation_date"); err != nil {
+			return err
+		}
+	}
+
+	schedule := expandTokenSigningCertificateRotationSchedule(diff)
+	if schedule == nil {
+		return nil
+	}
+
+	stage, rotate, cleanup := schedule.due(time.Now(), diff.Get("next_key_id").(string) != "", diff.Get("previous_key_id").(string) != "")
+
+	var computed []string
+	if stage || rotate {
+		computed = append(computed, "next_certificate", "next_end_date", "next_key_id", "next_thumbprint")
+	}
+	if rotate {
+		computed = append(computed, "current_certificate", "current_end_date", "current_key_id", "current_thumbprint", "last_rotation_date", "next_rotation_date")
+	}
+	if rotate || cleanup {
+		computed = append(computed, "previous_key_id", "previous_thumbprint")
+	}
+
+	for _, key := range computed {
+		if err := diff.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func servicePrincipalTokenSigningCertificateRotationResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.ServicePrincipalsClient
+	objectId := d.Get("service_principal_id").(string)
+
+	tf.LockByName(servicePrincipalResourceName, objectId)
+	defer tf.UnlockByName(servicePrincipalResourceName, objectId)
+
+	credential, err := addTokenSigningCertificate(ctx, client, objectId, expandTokenSigningCertificateRotationKeyCredential(d))
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not add token signing certificate to service principal with object ID: %q", objectId)
+	}
+
+	d.SetId(objectId)
+
+	tf.Set(d, "current_key_id", *credential.KeyId)
+	tf.Set(d, "last_rotation_date", time.Now().UTC().Format(time.RFC3339))
+
+	// Populate the active certificate, then make it the preferred certificate and stage the next certificate if it is
+	// already due
+	if diags := servicePrincipalTokenSigningCertificateRotationResourceRead(ctx, d, meta); diags.HasError() {
+		return diags
+	}
+	if err = servicePrincipalTokenSigningCertificateRotate(ctx, client, d); err != nil {
+		return tf.ErrorDiagF(err, "Rotating token signing certificates for service principal with object ID: %q", objectId)
+	}
+
+	return servicePrincipalTokenSigningCertificateRotationResourceRead(ctx, d, meta)
+}
+
+func servicePrincipalTokenSigningCertificateRotationResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.ServicePrincipalsClient
+	objectId := d.Id()
+
+	tf.LockByName(servicePrincipalResourceName, objectId)
+	defer tf.UnlockByName(servicePrincipalResourceName, objectId)
+
+	if err := servicePrincipalTokenSigningCertificateRotate(ctx, client, d); err != nil {
+		return tf.ErrorDiagF(err, "Rotating token signing certificates for service principal with object ID: %q", objectId)
+	}
+
+	return servicePrincipalTokenSigningCertificateRotationResourceRead(ctx, d, meta)
+}
+
+// servicePrincipalTokenSigningCertificateRotate stages, rotates and removes certificates according to the rotation
+// schedule, and updates the key IDs in the resource data. The caller should hold the lock for the service principal.
+func servicePrincipalTokenSigningCertificateRotate(ctx context.Context, client *msgraph.ServicePrincipalsClient, d *pluginsdk.ResourceData) error {
+	objectId := d.Id()
+
+	currentKeyId := d.Get("current_key_id").(string)
+	nextKeyId := d.Get("next_key_id").(string)
+	previousKeyId := d.Get("previous_key_id").(string)
+
+	schedule := expandTokenSigningCertificateRotationSchedule(d)
+	if schedule == nil {
+		return errors.New("the active certificate or the date it became active is not known")
+	}
+
+	now := time.Now().UTC()
+	stage, rotate, cleanup := schedule.due(now, nextKeyId != "", previousKeyId != "")
+
+	// A previous certificate still in its grace period is also removed when rotating again, since it is replaced
+	if previousKeyId != "" && (cleanup || rotate) {
+		if err := removeTokenSigningCertificates(ctx, client, objectId, []string{previousKeyId}); err != nil {
+			return fmt.Errorf("removing previous token signing certificate %q: %v", previousKeyId, err)
+		}
+		previousKeyId = ""
+	}
+
+	if stage {
+		credential, err := addTokenSigningCertificate(ctx, client, objectId, expandTokenSigningCertificateRotationKeyCredential(d))
+		if err != nil {
+			return fmt.Errorf("adding next token signing certificate: %v", err)
+		}
+		nextKeyId = *credential.KeyId
+	}
+
+	if rotate {
+		previousKeyId, currentKeyId, nextKeyId = currentKeyId, nextKeyId, ""
+		tf.Set(d, "last_rotation_date", now.Format(time.RFC3339))
+	}
+
+	// The preferred certificate is always set, which also restores it if it was changed outside of Terraform
+	if err := setPreferredTokenSigningCertificate(ctx, client, objectId, currentKeyId); err != nil {
+		return fmt.Errorf("setting preferred token signing certificate: %v", err)
+	}
+
+	if rotate && d.Get("grace_period_days").(int) == 0 {
+		if err := removeTokenSigningCertificates(ctx, client, objectId, []string{previousKeyId}); err != nil {
+			return fmt.Errorf("removing previous token signing certificate %q: %v", previousKeyId, err)
+		}
+		previousKeyId = ""
+	}
+
+	tf.Set(d, "current_key_id", currentKeyId)
+	tf.Set(d, "next_key_id", nextKeyId)
+	tf.Set(d, "previous_key_id", previousKeyId)
+
+	return nil
+}
+
+func servicePrincipalTokenSigningCertificateRotationResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.ServicePrincipalsClient
+	objectId := d.Id()
+
+	servicePrincipal, status, err := client.Get(ctx, objectId, odata.Query{
+		Select: []string{"keyCredentials", "preferredTokenSigningKeyThumbprint"},
+	})
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Service Principal with Object ID %q was not found - removing from state!", objectId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Retrieving service principal with object ID %q", objectId)
+	}
+
+	// When importing, the active certificate is the one with the preferred thumbprint
+	currentKeyId := d.Get("current_key_id").(string)
+	if currentKeyId == "" && servicePrincipal.PreferredTokenSigningKeyThumbprint != nil && servicePrincipal.KeyCredentials != nil {
+		for _, cred := range *servicePrincipal.KeyCredentials {
+			if cred.KeyId == nil || !strings.EqualFold(cred.Usage, msgraph.KeyCredentialUsageVerify) {
+				continue
+			}
+			if _, thumbprint, err := tokenSigningCertificatePem(&cred); err == nil && strings.EqualFold(thumbprint, string(*servicePrincipal.PreferredTokenSigningKeyThumbprint)) {
+				currentKeyId = *cred.KeyId
+				break
+			}
+		}
+	}
+
+	current := helpers.GetKeyCredential(servicePrincipal.KeyCredentials, currentKeyId)
+	if current == nil {
+		log.Printf("[DEBUG] Active token signing certificate %q for Service Principal with Object ID %q was not found - removing from state!", currentKeyId, objectId)
+		d.SetId("")
+		return nil
+	}
+
+	currentCertificate, currentThumbprint, err := tokenSigningCertificatePem(current)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "current_certificate", "Parsing active token signing certificate %q", currentKeyId)
+	}
+
+	currentEndDate := ""
+	if current.EndDateTime != nil {
+		currentEndDate = current.EndDateTime.Format(time.RFC3339)
+	}
+
+	lastRotationDate := d.Get("last_rotation_date").(string)
+	if lastRotationDate == "" && current.StartDateTime != nil {
+		lastRotationDate = current.StartDateTime.Format(time.RFC3339)
+	}
+
+	nextKeyId, nextCertificate, nextThumbprint, nextEndDate := "", "", "", ""
+	if next := helpers.GetKeyCredential(servicePrincipal.KeyCredentials, d.Get("next_key_id").(string)); next != nil {
+		nextKeyId = *next.KeyId
+		if nextCertificate, nextThumbprint, err = tokenSigningCertificatePem(next); err != nil {
+			return tf.ErrorDiagPathF(err, "next_certificate", "Parsing staged token signing certificate %q", nextKeyId)
+		}
+		if next.EndDateTime != nil {
+			nextEndDate = next.EndDateTime.Format(time.RFC3339)
+		}
+	}
+
+	previousKeyId, previousThumbprint := "", ""
+	if previous := helpers.GetKeyCredential(servicePrincipal.KeyCredentials, d.Get("previous_key_id").(string)); previous != nil {
+		previousKeyId = *previous.KeyId
+		if _, previousThumbprint, err = tokenSigningCertificatePem(previous); err != nil {
+			return tf.ErrorDiagPathF(err, "previous_thumbprint", "Parsing previous token signing certificate %q", previousKeyId)
+		}
+	}
+
+	tf.Set(d, "service_principal_id", objectId)
+	tf.Set(d, "current_certificate", currentCertificate)
+	tf.Set(d, "current_end_date", currentEndDate)
+	tf.Set(d, "current_key_id", *current.KeyId)
+	tf.Set(d, "current_thumbprint", currentThumbprint)
+	tf.Set(d, "last_rotation_date", lastRotationDate)
+	tf.Set(d, "next_certificate", nextCertificate)
+	tf.Set(d, "next_end_date", nextEndDate)
+	tf.Set(d, "next_key_id", nextKeyId)
+	tf.Set(d, "next_thumbprint", nextThumbprint)
+	tf.Set(d, "previous_key_id", previousKeyId)
+	tf.Set(d, "previous_thumbprint", previousThumbprint)
+
+	nextRotationDate := ""
+	if schedule := expandTokenSigningCertificateRotationSchedule(d); schedule != nil {
+		nextRotationDate = schedule.rotation.Format(time.RFC3339)
+	}
+	tf.Set(d, "next_rotation_date", nextRotationDate)
+
+	return nil
+}
+
+func servicePrincipalTokenSigningCertificateRotationResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.ServicePrincipalsClient
+	objectId := d.Id()
+
+	tf.LockByName(servicePrincipalResourceName, objectId)
+	defer tf.UnlockByName(servicePrincipalResourceName, objectId)
+
+	keyIds := make([]string, 0)
+	for _, key := range []string{"current_key_id", "next_key_id", "previous_key_id"} {
+		if v := d.Get(key).(string); v != "" {
+			keyIds = append(keyIds, v)
+		}
+	}
+
+	if err := removeTokenSigningCertificates(ctx, client, objectId, keyIds); err != nil {
+		return tf.ErrorDiagF(err, "Removing token signing certificates from service principal with object ID %q", objectId)
+	}
+
+	return nil
+}
+
+func expandTokenSigningCertificateRotationKeyCredential(d *pluginsdk.ResourceData) msgraph.KeyCredential {
+	keyCreds := msgraph.KeyCredential{}
+
+	if v := d.Get("display_name").(string); v != "" {
+		keyCreds.DisplayName = pointer.To(v)
+	}
+	if v := d.Get("certificate_validity_days").(int); v > 0 {
+		keyCreds.EndDateTime = pointer.To(time.Now().UTC().AddDate(0, 0, v))
+	}
+
+	return keyCreds
+}
+
+// setPreferredTokenSigningCertificate sets the preferred token signing certificate for a service principal, given the
+// key ID of its Verify key credential
+func setPreferredTokenSigningCertificate(ctx context.Context, client *msgraph.ServicePrincipalsClient, objectId, keyId string) error {
+	servicePrincipal, _, err := client.Get(ctx, objectId, odata.Query{
+		Select: []string{"keyCredentials"},
+	})
+	if err != nil {
+		return fmt.Errorf("retrieving service principal: %v", err)
+	}
+
+	_, thumbprint, err := tokenSigningCertificatePem(helpers.GetKeyCredential(servicePrincipal.KeyCredentials, keyId))
+	if err != nil {
+		return fmt.Errorf("parsing token signing certificate %q: %v", keyId, err)
+	}
+
+	if _, err = client.SetPreferredTokenSigningKeyThumbprint(ctx, objectId, thumbprint); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type servicePrincipalTokenSigningCertificateRotationResource struct{}
+
+func TestAccServicePrincipalTokenSigningCertificateRotation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_token_signing_certificate_rotation", "test")
+	r := servicePrincipalTokenSigningCertificateRotationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("current_certificate").Exists(),
+				check.That(data.ResourceName).Key("current_key_id").IsUuid(),
+				check.That(data.ResourceName).Key("current_thumbprint").Exists(),
+				check.That(data.ResourceName).Key("next_key_id").IsEmpty(),
+				check.That(data.ResourceName).Key("next_rotation_date").Exists(),
+			),
+		},
+		data.ImportStep("grace_period_days", "last_rotation_date", "next_rotation_date", "rotate_before_expiry_days", "staging_days"),
+	})
+}
+
+func TestAccServicePrincipalTokenSigningCertificateRotation_staged(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_token_signing_certificate_rotation", "test")
+	r := servicePrincipalTokenSigningCertificateRotationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("next_key_id").IsEmpty(),
+			),
+		},
+		{
+			Config: r.staged(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("current_certificate").Exists(),
+				check.That(data.ResourceName).Key("next_certificate").Exists(),
+				check.That(data.ResourceName).Key("next_key_id").IsUuid(),
+				check.That(data.ResourceName).Key("next_thumbprint").Exists(),
+			),
+		},
+	})
+}
+
+func (r servicePrincipalTokenSigningCertificateRotationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.ServicePrincipals.ServicePrincipalsClient
+	client.BaseClient.DisableRetries = true
+	defer func() { client.BaseClient.DisableRetries = false }()
+
+	servicePrincipal, status, err := client.Get(ctx, state.ID, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Service Principal with object ID %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve Service Principal with object ID %q: %+v", state.ID, err)
+	}
+
+	keyId := state.Attributes["current_key_id"]
+	if servicePrincipal.KeyCredentials != nil {
+		for _, cred := range *servicePrincipal.KeyCredentials {
+			if cred.KeyId != nil && *cred.KeyId == keyId {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("Token Signing Key Credential %q was not found for Service Principal %q", keyId, state.ID)
+}
+
+func (servicePrincipalTokenSigningCertificateRotationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+}
+`, data.RandomInteger)
+}
+
+func (r servicePrincipalTokenSigningCertificateRotationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_token_signing_certificate_rotation" "test" {
+  service_principal_id = azuread_service_principal.test.id
+}
+`, r.template(data))
+}
+
+func (r servicePrincipalTokenSigningCertificateRotationResource) staged(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_token_signing_certificate_rotation" "test" {
+  service_principal_id      = azuread_service_principal.test.id
+  display_name              = "CN=acctestTokenSigningCert-%[2]s"
+  certificate_validity_days = 180
+  rotation_days             = 90
+  staging_days              = 90
+}
+`, r.template(data), data.RandomID)
+}
@@ -6,7 +6,10 @@ package serviceprincipals
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -15,6 +18,10 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
+	serviceprincipalsClient "github.com/hashicorp/terraform-provider-azuread/internal/services/serviceprincipals/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
 	"github.com/manicminer/hamilton/msgraph"
 )
 
@@ -31,21 +38,69 @@ func expandSamlSingleSignOn(in []interface{}) *msgraph.SamlSingleSignOnSettings
 	return &result
 }
 
-func flattenSamlSingleSignOn(in *msgraph.SamlSingleSignOnSettings) []map[string]interface{} {
+// expandSamlSingleSignOnUrls returns the logout URL and reply URLs from the `saml_single_sign_on` block. These are
+// properties of the service principal rather than of its SAML settings, and empty values are returned when omitted.
+func expandSamlSingleSignOnUrls(in []interface{}) (logoutUrl string, replyUrls []string) {
+	replyUrls = make([]string, 0)
+	if len(in) == 0 || in[0] == nil {
+		return
+	}
+
+	samlSingleSignOnSettings := in[0].(map[string]interface{})
+
+	logoutUrl = samlSingleSignOnSettings["logout_url"].(string)
+	replyUrls = tf.ExpandStringSlice(samlSingleSignOnSettings["reply_urls"].([]interface{}))
+
+	return
+}
+
+func flattenSamlSingleSignOn(in *msgraph.ServicePrincipal) []map[string]interface{} {
 	if in == nil {
 		return []map[string]interface{}{}
 	}
 
+	hasLogoutUrl := in.LogoutUrl != nil && *in.LogoutUrl != ""
+	hasReplyUrls := in.ReplyUrls != nil && len(*in.ReplyUrls) > 0
+	if in.SamlSingleSignOnSettings == nil && !hasLogoutUrl && !hasReplyUrls {
+		return []map[string]interface{}{}
+	}
+
 	relayState := ""
-	if in.RelayState != nil {
-		relayState = *in.RelayState
+	if in.SamlSingleSignOnSettings != nil && in.SamlSingleSignOnSettings.RelayState != nil {
+		relayState = *in.SamlSingleSignOnSettings.RelayState
+	}
+
+	logoutUrl := ""
+	if in.LogoutUrl != nil {
+		logoutUrl = *in.LogoutUrl
 	}
 
 	return []map[string]interface{}{{
+		"logout_url":  logoutUrl,
 		"relay_state": relayState,
+		"reply_urls":  tf.FlattenStringSlicePtr(in.ReplyUrls),
 	}}
 }
 
+// filterUnmanagedSamlSingleSignOnUrls removes the logout URL and reply URLs from a flattened `saml_single_sign_on`
+// block, unless they were present in the prior value. These are otherwise populated from the associated application,
+// and are only tracked once they are being managed with the `saml_single_sign_on` block.
+func filterUnmanagedSamlSingleSignOnUrls(in []map[string]interface{}, prior []interface{}) []map[string]interface{} {
+	if len(in) == 0 {
+		return in
+	}
+
+	priorLogoutUrl, priorReplyUrls := expandSamlSingleSignOnUrls(prior)
+	if priorLogoutUrl == "" {
+		in[0]["logout_url"] = ""
+	}
+	if len(priorReplyUrls) == 0 {
+		in[0]["reply_urls"] = []string{}
+	}
+
+	return in
+}
+
 func findByClientId(ctx context.Context, client *msgraph.ServicePrincipalsClient, appId string) (*msgraph.ServicePrincipal, error) {
 	var servicePrincipal *msgraph.ServicePrincipal
 
@@ -123,3 +178,224 @@ func findByClientIdWithTimeout(ctx context.Context, timeout time.Duration, clien
 
 	return unmarshal(resp)
 }
+
+// addTokenSigningCertificate adds a self-signed token signing certificate to a service principal and waits for it to
+// appear, returning the resulting Verify key credential. The caller should hold the lock for the service principal.
+func addTokenSigningCertificate(ctx context.Context, client *msgraph.ServicePrincipalsClient, objectId string, keyCreds msgraph.KeyCredential) (*msgraph.KeyCredential, error) {
+	key, _, err := client.AddTokenSigningCertificate(ctx, objectId, keyCreds)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil || key.KeyId == nil || key.CustomKeyIdentifier == nil {
+		return nil, errors.New("returned token signing certificate was nil or had no key ID")
+	}
+
+	// Wait for the credential to appear in the service principal manifest, this can take several minutes
+	timeout, _ := ctx.Deadline()
+	polledForCredential, err := (&pluginsdk.StateChangeConf{ //nolint:staticcheck
+		Pending:                   []string{"Waiting"},
+		Target:                    []string{"Done"},
+		Timeout:                   time.Until(timeout),
+		MinTimeout:                1 * time.Second,
+		ContinuousTargetOccurence: 5,
+		Refresh: func() (interface{}, string, error) {
+			servicePrincipal, _, err := client.Get(ctx, objectId, odata.Query{})
+			if err != nil {
+				return nil, "Error", err
+			}
+
+			if servicePrincipal.KeyCredentials != nil {
+				for _, cred := range *servicePrincipal.KeyCredentials {
+					if cred.KeyId != nil && strings.EqualFold(*cred.KeyId, *key.KeyId) {
+						return &cred, "Done", nil
+					}
+				}
+			}
+
+			return nil, "Waiting", nil
+		},
+	}).WaitForStateContext(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for token signing certificate: %v", err)
+	} else if polledForCredential == nil {
+		return nil, errors.New("certificate credential not found in service principal manifest")
+	}
+
+	// Workaround b/c the returned keyId is for the Sign key, rather than Verify key,
+	// so we need to get the Verify keyId based on the customKeyIdentifier
+	servicePrincipal, _, err := client.Get(ctx, objectId, odata.Query{
+		Select: []string{"keyCredentials"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving service principal: %v", err)
+	}
+
+	credential := helpers.GetVerifyKeyCredentialFromCustomKeyId(servicePrincipal.KeyCredentials, *key.CustomKeyIdentifier)
+	if credential == nil {
+		return nil, errors.New("could not determine key ID for newly added token signing certificate")
+	}
+
+	return credential, nil
+}
+
+// removeTokenSigningCertificates removes token signing certificates from a service principal, along with the Sign key
+// and password credentials which were created with them, and waits for the removal to complete. Certificates are
+// identified by the key IDs of their Verify key credentials. The caller should hold the lock for the service principal.
+func removeTokenSigningCertificates(ctx context.Context, client *msgraph.ServicePrincipalsClient, objectId string, keyIds []string) error {
+	servicePrincipal, _, err := client.Get(ctx, objectId, odata.Query{})
+	if err != nil {
+		return fmt.Errorf("retrieving service principal: %v", err)
+	}
+
+	// use CustomKeyIdentifier to determine which certs and passwords are associated together
+	customKeyIds := make(map[string]bool)
+	for _, keyId := range keyIds {
+		if cred := helpers.GetKeyCredential(servicePrincipal.KeyCredentials, keyId); cred != nil && cred.CustomKeyIdentifier != nil {
+			customKeyIds[strings.ToLower(*cred.CustomKeyIdentifier)] = true
+		}
+	}
+	if len(customKeyIds) == 0 {
+		return nil
+	}
+
+	newKeyCredentials := make([]msgraph.KeyCredential, 0)
+	if servicePrincipal.KeyCredentials != nil {
+		for _, cred := range *servicePrincipal.KeyCredentials {
+			if cred.CustomKeyIdentifier == nil || !customKeyIds[strings.ToLower(*cred.CustomKeyIdentifier)] {
+				newKeyCredentials = append(newKeyCredentials, cred)
+			}
+		}
+	}
+
+	newPasswordCredentials := make([]msgraph.PasswordCredential, 0)
+	if servicePrincipal.PasswordCredentials != nil {
+		for _, cred := range *servicePrincipal.PasswordCredentials {
+			if cred.CustomKeyIdentifier == nil || !customKeyIds[strings.ToLower(*cred.CustomKeyIdentifier)] {
+				newPasswordCredentials = append(newPasswordCredentials, cred)
+			}
+		}
+	}
+
+	properties := msgraph.ServicePrincipal{
+		DirectoryObject: msgraph.DirectoryObject{
+			Id: &objectId,
+		},
+		KeyCredentials:      &newKeyCredentials,
+		PasswordCredentials: &newPasswordCredentials,
+	}
+	if _, err := client.Update(ctx, properties); err != nil {
+		return fmt.Errorf("updating service principal: %v", err)
+	}
+
+	// Wait for the token signing certificates to be deleted
+	return helpers.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		defer func() { client.BaseClient.DisableRetries = false }()
+		client.BaseClient.DisableRetries = true
+
+		servicePrincipal, _, err := client.Get(ctx, objectId, odata.Query{})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, keyId := range keyIds {
+			if credential := helpers.GetKeyCredential(servicePrincipal.KeyCredentials, keyId); credential != nil {
+				return pointer.To(true), nil
+			}
+		}
+
+		return pointer.To(false), nil
+	})
+}
+
+// tokenSigningCertificatePem returns the certificate for a token signing key credential in PEM format, along with its
+// thumbprint. The thumbprint is not available when querying the service principal, so it is calculated.
+func tokenSigningCertificatePem(credential *msgraph.KeyCredential) (string, string, error) {
+	if credential == nil || credential.Key == nil {
+		return "", "", errors.New("credential has no key value")
+	}
+
+	der, err := base64.StdEncoding.DecodeString(*credential.Key)
+	if err != nil {
+		return "", "", fmt.Errorf("decoding certificate: %v", err)
+	}
+
+	certificate := pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: der,
+	})
+
+	thumbprint, err := helpers.GetTokenSigningCertificateThumbprint(certificate)
+	if err != nil {
+		return "", "", err
+	}
+
+	return string(certificate), thumbprint, nil
+}
+
+// servicePrincipalAssignablePolicyTypes are the types of policy which can be assigned to a service principal, with the
+// navigation property used to list assigned policies of each type
+var servicePrincipalAssignablePolicyTypes = []struct {
+	name       string
+	collection string
+}{
+	{name: "claimsMapping", collection: serviceprincipalsClient.AssignedPolicyCollectionClaimsMapping},
+	{name: "homeRealmDiscovery", collection: serviceprincipalsClient.AssignedPolicyCollectionHomeRealmDiscovery},
+	{name: "tokenIssuance", collection: serviceprincipalsClient.AssignedPolicyCollectionTokenIssuance},
+	{name: "tokenLifetime", collection: serviceprincipalsClient.AssignedPolicyCollectionTokenLifetime},
+}
+
+func flattenServicePrincipalKeyCredentials(in *[]msgraph.KeyCredential) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	for _, cred := range *in {
+		var startDate, endDate string
+		if cred.StartDateTime != nil {
+			startDate = cred.StartDateTime.Format(time.RFC3339)
+		}
+		if cred.EndDateTime != nil {
+			endDate = cred.EndDateTime.Format(time.RFC3339)
+		}
+
+		result = append(result, map[string]interface{}{
+			"display_name": pointer.From(cred.DisplayName),
+			"end_date":     endDate,
+			"key_id":       pointer.From(cred.KeyId),
+			"start_date":   startDate,
+			"type":         cred.Type,
+			"usage":        cred.Usage,
+		})
+	}
+
+	return result
+}
+
+func flattenServicePrincipalPasswordCredentials(in *[]msgraph.PasswordCredential) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	for _, cred := range *in {
+		var startDate, endDate string
+		if cred.StartDateTime != nil {
+			startDate = cred.StartDateTime.Format(time.RFC3339)
+		}
+		if cred.EndDateTime != nil {
+			endDate = cred.EndDateTime.Format(time.RFC3339)
+		}
+
+		result = append(result, map[string]interface{}{
+			"display_name": pointer.From(cred.DisplayName),
+			"end_date":     endDate,
+			"hint":         pointer.From(cred.Hint),
+			"key_id":       pointer.From(cred.KeyId),
+			"start_date":   startDate,
+		})
+	}
+
+	return result
+}